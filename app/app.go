@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -36,6 +37,7 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 	"github.com/spf13/cast"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
@@ -298,6 +300,9 @@ type WasmApp struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// wasmTracerProvider exports the wasm spans, when enabled in the wasm config
+	wasmTracerProvider *sdktrace.TracerProvider
 }
 
 // NewWasmApp returns a reference to an initialized WasmApp.
@@ -580,6 +585,13 @@ func NewWasmApp(
 	if err != nil {
 		panic(fmt.Sprintf("error while reading wasm config: %s", err))
 	}
+	app.wasmTracerProvider, err = wasmkeeper.NewTracerProvider(wasmConfig)
+	if err != nil {
+		panic(fmt.Sprintf("error while setting up wasm tracing: %s", err))
+	}
+	if app.wasmTracerProvider != nil {
+		wasmOpts = append(wasmOpts, wasmkeeper.WithTracerProvider(app.wasmTracerProvider))
+	}
 
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
@@ -834,10 +846,17 @@ func NewWasmApp(
 		}
 		ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
 
+		// Ensure the wasmvm supports all capabilities that are enabled on chain
+		if err := app.WasmKeeper.CheckVMCapabilities(ctx); err != nil {
+			tmos.Exit(fmt.Sprintf("unsupported wasm capabilities %s", err))
+		}
 		// Initialize pinned codes in wasmvm as they are not persisted there
 		if err := app.WasmKeeper.InitializePinnedCodes(ctx); err != nil {
 			tmos.Exit(fmt.Sprintf("failed initialize pinned codes %s", err))
 		}
+		// Compile the other codes into the wasmvm file cache in the background when enabled, so that
+		// the node serves blocks while warming
+		app.WasmKeeper.StartCacheWarmup(ctx)
 	}
 
 	return app
@@ -856,6 +875,7 @@ func (app *WasmApp) setAnteHandler(txConfig client.TxConfig, wasmConfig wasmtype
 			IBCKeeper:         app.IBCKeeper,
 			WasmConfig:        &wasmConfig,
 			TXCounterStoreKey: txCounterStoreKey,
+			WasmKeeper:        &app.WasmKeeper,
 		},
 	)
 	if err != nil {
@@ -878,6 +898,19 @@ func (app *WasmApp) setPostHandler() {
 // Name returns the name of the App
 func (app *WasmApp) Name() string { return app.BaseApp.Name() }
 
+// Close flushes the pending wasm spans and code usage counters and closes the underlying baseapp
+func (app *WasmApp) Close() error {
+	if app.wasmTracerProvider != nil {
+		if err := app.wasmTracerProvider.Shutdown(context.Background()); err != nil {
+			app.Logger().Error("failed to shut down wasm tracer provider", "err", err)
+		}
+	}
+	if err := app.WasmKeeper.CloseCodeUsage(); err != nil {
+		app.Logger().Error("failed to close wasm code usage db", "err", err)
+	}
+	return app.BaseApp.Close()
+}
+
 // BeginBlocker application updates every begin block
 func (app *WasmApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	return app.ModuleManager.BeginBlock(ctx, req)
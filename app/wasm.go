@@ -1,6 +1,7 @@
 package app
 
-// AllCapabilities returns all capabilities available with the current wasmvm
+// AllCapabilities returns all capabilities available with the current wasmvm.
+// The capabilities that contracts can use on chain are a subset defined by the wasm params.
 // See https://github.com/CosmWasm/cosmwasm/blob/main/docs/CAPABILITIES-BUILT-IN.md
 // This functionality is going to be moved upstream: https://github.com/CosmWasm/wasmvm/issues/425
 func AllCapabilities() []string {
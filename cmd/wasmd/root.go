@@ -2,6 +2,7 @@ package main
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"os"
 
@@ -248,7 +249,14 @@ func newApp(
 
 	var wasmOpts []wasmkeeper.Option
 	if cast.ToBool(appOpts.Get("telemetry.enabled")) {
-		wasmOpts = append(wasmOpts, wasmkeeper.WithVMCacheMetrics(prometheus.DefaultRegisterer))
+		wasmConfig, err := wasm.ReadWasmConfig(appOpts)
+		if err != nil {
+			panic(fmt.Sprintf("error while reading wasm config: %s", err))
+		}
+		wasmOpts = append(wasmOpts,
+			wasmkeeper.WithVMCacheMetrics(prometheus.DefaultRegisterer),
+			wasmkeeper.WithVMExecutionMetrics(prometheus.DefaultRegisterer, wasmConfig.MetricsMaxCodeIDLabels),
+		)
 	}
 
 	return app.NewWasmApp(
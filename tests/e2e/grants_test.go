@@ -16,6 +16,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 
+	"github.com/CosmWasm/wasmd/app"
 	"github.com/CosmWasm/wasmd/tests/e2e"
 	"github.com/CosmWasm/wasmd/x/wasm/ibctesting"
 	"github.com/CosmWasm/wasmd/x/wasm/types"
@@ -74,6 +75,19 @@ func TestGrants(t *testing.T) {
 			senderKey:      granteePrivKey,
 			expErr:         sdkerrors.ErrUnauthorized,
 		},
+		"in limits and message field filter": {
+			limit:          types.NewMaxFundsLimit(myAmount),
+			filter:         types.NewAcceptedMessageFieldsFilter(types.MessageFieldRule{Path: "/reflect_msg/msgs/0/bank/burn/amount/0/amount", LTE: myAmount.Amount.String()}),
+			transferAmount: myAmount,
+			senderKey:      granteePrivKey,
+		},
+		"not match message field filter": {
+			limit:          types.NewMaxFundsLimit(myAmount),
+			filter:         types.NewAcceptedMessageFieldsFilter(types.MessageFieldRule{Path: "/reflect_msg/msgs/0/bank/burn/amount/0/amount", LTE: "1"}),
+			transferAmount: sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt()),
+			senderKey:      granteePrivKey,
+			expErr:         sdkerrors.ErrUnauthorized,
+		},
 		"non authorized sender address": { // sanity check - testing sdk
 			limit:          types.NewMaxFundsLimit(myAmount),
 			filter:         types.NewAllowAllMessagesFilter(),
@@ -119,3 +133,189 @@ func TestGrants(t *testing.T) {
 		})
 	}
 }
+
+func TestWindowLimitGrant(t *testing.T) {
+	// Given a contract by address A
+	// And   a grant for address B by A with a window limit of one call per hour
+	// When  B sends executes within and after the window
+	// Then  only one execution per window is accepted
+	coord := ibctesting.NewCoordinator(t, 1)
+	chain := coord.GetChain(ibctesting.GetChainID(1))
+	contractAddr := e2e.InstantiateReflectContract(t, chain)
+	require.NotEmpty(t, contractAddr)
+
+	granterAddr := chain.SenderAccount.GetAddress()
+	limit, err := types.NewAllOfLimits(types.NewMaxCallsLimit(10), types.NewWindowLimit(time.Hour, true, 1))
+	require.NoError(t, err)
+	grant, err := types.NewContractGrant(contractAddr, limit, types.NewAllowAllMessagesFilter())
+	require.NoError(t, err)
+	granteePrivKey, granteeAddr := setupGrant(t, chain, types.NewContractExecutionAuthorization(*grant))
+
+	execMsg := newExecMsg(granteeAddr, &types.MsgExecuteContract{
+		Sender:   granterAddr.String(),
+		Contract: contractAddr.String(),
+		Msg:      []byte(fmt.Sprintf(`{"change_owner": {"owner": %q}}`, granterAddr.String())),
+	})
+
+	// when
+	_, err = chain.SendNonDefaultSenderMsgs(granteePrivKey, execMsg)
+	// then
+	require.NoError(t, err)
+
+	// when executed again within the window
+	_, err = chain.SendNonDefaultSenderMsgs(granteePrivKey, execMsg)
+	// then
+	require.Error(t, err)
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	// when executed in the next window
+	coord.IncrementTimeBy(time.Hour)
+	_, err = chain.SendNonDefaultSenderMsgs(granteePrivKey, execMsg)
+	// then
+	require.NoError(t, err)
+}
+
+func TestInstantiationGrant(t *testing.T) {
+	// Given a code stored by address A
+	// And   a grant for address B by A to instantiate the code once with A as admin
+	// When  B instantiates the code on behalf of A
+	// Then  only instantiations with A as admin are accepted
+	coord := ibctesting.NewCoordinator(t, 1)
+	chain := coord.GetChain(ibctesting.GetChainID(1))
+	codeID := chain.StoreCodeFile("../../x/wasm/keeper/testdata/reflect_1_1.wasm").CodeID
+
+	granterAddr := chain.SenderAccount.GetAddress()
+	grant, err := types.NewCodeGrant(codeID, types.NewMaxCallsLimit(1), types.NewAllowAllMessagesFilter(), &types.InstantiateConstraints{Admin: granterAddr.String()})
+	require.NoError(t, err)
+	granteePrivKey, granteeAddr := setupGrant(t, chain, types.NewContractInstantiationAuthorization(false, *grant))
+
+	instantiateMsg := &types.MsgInstantiateContract{
+		Sender: granterAddr.String(),
+		CodeID: codeID,
+		Label:  "granted",
+		Msg:    []byte(`{}`),
+	}
+
+	// when instantiated with another admin
+	instantiateMsg.Admin = granteeAddr.String()
+	_, err = chain.SendNonDefaultSenderMsgs(granteePrivKey, newExecMsg(granteeAddr, instantiateMsg))
+	// then
+	require.Error(t, err)
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	// when instantiated with the granter as admin
+	instantiateMsg.Admin = granterAddr.String()
+	_, err = chain.SendNonDefaultSenderMsgs(granteePrivKey, newExecMsg(granteeAddr, instantiateMsg))
+	// then
+	require.NoError(t, err)
+	wasmKeeper := chain.App.(*app.WasmApp).WasmKeeper
+	var contracts []sdk.AccAddress
+	wasmKeeper.IterateContractsByCode(chain.GetContext(), codeID, func(address sdk.AccAddress) bool {
+		contracts = append(contracts, address)
+		return false
+	})
+	require.Len(t, contracts, 1)
+	assert.Equal(t, granterAddr.String(), wasmKeeper.GetContractInfo(chain.GetContext(), contracts[0]).Admin)
+
+	// when instantiated again
+	_, err = chain.SendNonDefaultSenderMsgs(granteePrivKey, newExecMsg(granteeAddr, instantiateMsg))
+	// then the grant is used up
+	require.Error(t, err)
+}
+
+func TestCodeIDGrant(t *testing.T) {
+	// Given two contracts of the same code and a contract of another code by address A
+	// And   a grant for address B by A on all contracts of the first code
+	// When  B executes the contracts
+	// Then  only the contracts of the granted code are accepted
+	coord := ibctesting.NewCoordinator(t, 1)
+	chain := coord.GetChain(ibctesting.GetChainID(1))
+	codeID := chain.StoreCodeFile("../../x/wasm/keeper/testdata/reflect_1_1.wasm").CodeID
+	otherCodeID := chain.StoreCodeFile("../../x/wasm/keeper/testdata/reflect_1_1.wasm").CodeID
+	myContracts := []sdk.AccAddress{chain.InstantiateContract(codeID, []byte(`{}`)), chain.InstantiateContract(codeID, []byte(`{}`))}
+	otherContract := chain.InstantiateContract(otherCodeID, []byte(`{}`))
+
+	granterAddr := chain.SenderAccount.GetAddress()
+	grant, err := types.NewCodeIDContractGrant(codeID, types.NewMaxCallsLimit(10), types.NewAllowAllMessagesFilter())
+	require.NoError(t, err)
+	granteePrivKey, granteeAddr := setupGrant(t, chain, types.NewContractExecutionAuthorization(*grant))
+
+	execMsgFor := func(contract sdk.AccAddress) *authz.MsgExec {
+		return newExecMsg(granteeAddr, &types.MsgExecuteContract{
+			Sender:   granterAddr.String(),
+			Contract: contract.String(),
+			Msg:      []byte(fmt.Sprintf(`{"change_owner": {"owner": %q}}`, granterAddr.String())),
+		})
+	}
+	for _, contract := range myContracts {
+		// when
+		_, err = chain.SendNonDefaultSenderMsgs(granteePrivKey, execMsgFor(contract))
+		// then
+		require.NoError(t, err)
+	}
+
+	// when
+	_, err = chain.SendNonDefaultSenderMsgs(granteePrivKey, execMsgFor(otherContract))
+	// then
+	require.Error(t, err)
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err))
+}
+
+func TestUpdateAdminGrant(t *testing.T) {
+	// Given a contract with admin A
+	// And   a grant for address B by A to update the admin to address C only
+	// When  B updates the admin on behalf of A
+	// Then  only the update to C is accepted
+	coord := ibctesting.NewCoordinator(t, 1)
+	chain := coord.GetChain(ibctesting.GetChainID(1))
+	contractAddr := e2e.InstantiateReflectContract(t, chain)
+
+	granterAddr := chain.SenderAccount.GetAddress()
+	newAdminAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address().Bytes())
+	grant, err := types.NewContractGrant(contractAddr, types.NewMaxCallsLimit(1), types.NewAllowAllMessagesFilter())
+	require.NoError(t, err)
+	authorization := types.NewContractUpdateAdminAuthorization([]string{newAdminAddr.String()}, *grant)
+	granteePrivKey, granteeAddr := setupGrant(t, chain, authorization)
+
+	updateAdminMsg := func(newAdmin sdk.AccAddress) *authz.MsgExec {
+		return newExecMsg(granteeAddr, &types.MsgUpdateAdmin{
+			Sender:   granterAddr.String(),
+			NewAdmin: newAdmin.String(),
+			Contract: contractAddr.String(),
+		})
+	}
+
+	// when updated to another admin
+	_, err = chain.SendNonDefaultSenderMsgs(granteePrivKey, updateAdminMsg(granteeAddr))
+	// then
+	require.Error(t, err)
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	// when updated to the allowed admin
+	_, err = chain.SendNonDefaultSenderMsgs(granteePrivKey, updateAdminMsg(newAdminAddr))
+	// then
+	require.NoError(t, err)
+	wasmKeeper := chain.App.(*app.WasmApp).WasmKeeper
+	assert.Equal(t, newAdminAddr.String(), wasmKeeper.GetContractInfo(chain.GetContext(), contractAddr).Admin)
+}
+
+// setupGrant funds a new grantee account and grants it the authorization from the chain's sender account
+func setupGrant(t *testing.T, chain *ibctesting.TestChain, authorization authz.Authorization) (cryptotypes.PrivKey, sdk.AccAddress) {
+	t.Helper()
+	granteePrivKey := secp256k1.GenPrivKey()
+	granteeAddr := sdk.AccAddress(granteePrivKey.PubKey().Address().Bytes())
+	chain.Fund(granteeAddr, sdk.NewInt(1_000_000))
+
+	expiry := chain.CurrentHeader.Time.Add(24 * time.Hour)
+	grantMsg, err := authz.NewMsgGrant(chain.SenderAccount.GetAddress(), granteeAddr, authorization, &expiry)
+	require.NoError(t, err)
+	_, err = chain.SendMsgs(grantMsg)
+	require.NoError(t, err)
+	return granteePrivKey, granteeAddr
+}
+
+// newExecMsg wraps the msg into an authz exec msg of the grantee
+func newExecMsg(granteeAddr sdk.AccAddress, msg sdk.Msg) *authz.MsgExec {
+	execMsg := authz.NewMsgExec(granteeAddr, []sdk.Msg{msg})
+	return &execMsg
+}
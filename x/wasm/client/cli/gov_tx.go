@@ -132,7 +132,7 @@ func parseVerificationFlags(gzippedWasm []byte, flags *flag.FlagSet) (string, st
 		// wasm is gzipped in parseStoreCodeArgs
 		// checksum generation will be decoupled here
 		// reference https://github.com/CosmWasm/wasmvm/issues/359
-		raw, err := ioutils.Uncompress(gzippedWasm, int64(types.MaxWasmSize))
+		raw, err := ioutils.Uncompress(gzippedWasm, int64(types.DefaultMaxProposalWasmSize))
 		if err != nil {
 			return "", "", nil, fmt.Errorf("invalid zip: %w", err)
 		}
@@ -696,38 +696,33 @@ func ProposalUnpinCodesCmd() *cobra.Command {
 	return cmd
 }
 
-func parseAccessConfig(raw string) (c types.AccessConfig, err error) {
+func parseAccessConfig(raw string) (types.AccessConfig, error) {
 	switch raw {
 	case "nobody":
 		return types.AllowNobody, nil
 	case "everybody":
 		return types.AllowEverybody, nil
-	default:
-		parts := strings.Split(raw, ",")
-		addrs := make([]sdk.AccAddress, len(parts))
-		for i, v := range parts {
-			addr, err := sdk.AccAddressFromBech32(v)
+	}
+	if rawIDs, ok := strings.CutPrefix(raw, "code-ids:"); ok {
+		cfg := types.AccessConfig{Permission: types.AccessTypeAnyOfCodeIDs}
+		for _, v := range strings.Split(rawIDs, ",") {
+			id, err := strconv.ParseUint(v, 10, 64)
 			if err != nil {
-				return types.AccessConfig{}, fmt.Errorf("unable to parse address %q: %s", v, err)
+				return types.AccessConfig{}, fmt.Errorf("unable to parse code id %q: %s", v, err)
 			}
-			addrs[i] = addr
+			cfg.CodeIDs = append(cfg.CodeIDs, id)
 		}
-		defer func() { // convert panic in ".With" to error for better output
-			if r := recover(); r != nil {
-				err = r.(error)
-			}
-		}()
-		cfg := types.AccessTypeAnyOfAddresses.With(addrs...)
 		return cfg, cfg.ValidateBasic()
 	}
+	return parseAnyOfAddressesAccessConfig(strings.Split(raw, ","))
 }
 
 func parseAccessConfigUpdates(args []string) ([]types.AccessConfigUpdate, error) {
 	updates := make([]types.AccessConfigUpdate, len(args))
 	for i, c := range args {
 		// format: code_id:access_config
-		// access_config: nobody|everybody|address(es)
-		parts := strings.Split(c, ":")
+		// access_config: nobody|everybody|address(es) with optional @expiry|code-ids:id(s)
+		parts := strings.SplitN(c, ":", 2)
 		if len(parts) != 2 {
 			return nil, fmt.Errorf("invalid format")
 		}
@@ -757,9 +752,10 @@ func ProposalUpdateInstantiateConfigCmd() *cobra.Command {
 		Args:  cobra.MinimumNArgs(1),
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Submit an update instantiate config  proposal for multiple code ids.
+An address can expire with the suffix @<height> or @<RFC3339 time>. Contracts of code ids are authorized with code-ids:<id>,<id>.
 
 Example: 
-$ %s tx gov submit-proposal update-instantiate-config 1:nobody 2:everybody 3:%s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm,%s1vx8knpllrj7n963p9ttd80w47kpacrhuts497x
+$ %s tx gov submit-proposal update-instantiate-config 1:nobody 2:everybody 3:%s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm,%s1vx8knpllrj7n963p9ttd80w47kpacrhuts497x@2024-01-01T00:00:00Z
 `, version.AppName, bech32Prefix, bech32Prefix)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, proposalTitle, summary, deposit, err := getProposalInfo(cmd)
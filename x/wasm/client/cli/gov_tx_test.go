@@ -3,6 +3,7 @@ package cli
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -54,6 +55,37 @@ func TestParseAccessConfigUpdates(t *testing.T) {
 				},
 			},
 		},
+		"any of addresses - with expiry": {
+			src: []string{"1:cosmos1vx8knpllrj7n963p9ttd80w47kpacrhuts497x@2024-01-01T00:00:00Z,cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"},
+			exp: []types.AccessConfigUpdate{
+				{
+					CodeID: 1,
+					InstantiatePermission: types.AccessConfig{
+						Permission: types.AccessTypeAnyOfAddresses,
+						Addresses:  []string{"cosmos1vx8knpllrj7n963p9ttd80w47kpacrhuts497x", "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"},
+						Expiries: []types.AccessExpiry{{
+							Address: "cosmos1vx8knpllrj7n963p9ttd80w47kpacrhuts497x",
+							Time:    func() *time.Time { t := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC); return &t }(),
+						}},
+					},
+				},
+			},
+		},
+		"any of code ids": {
+			src: []string{"1:code-ids:2,3"},
+			exp: []types.AccessConfigUpdate{{
+				CodeID:                1,
+				InstantiatePermission: types.AccessConfig{Permission: types.AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{2, 3}},
+			}},
+		},
+		"any of code ids - invalid": {
+			src:    []string{"1:code-ids:2,foo"},
+			expErr: true,
+		},
+		"any of code ids - empty": {
+			src:    []string{"1:code-ids:"},
+			expErr: true,
+		},
 		"multiple code ids with different permissions": {
 			src: []string{"1:cosmos1vx8knpllrj7n963p9ttd80w47kpacrhuts497x,cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr", "2:nobody"},
 			exp: []types.AccessConfigUpdate{
@@ -142,6 +142,16 @@ func UpdateInstantiateConfigCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			constraints, err := parseInstantiateConstraintFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if constraints != nil {
+				if perm == nil {
+					return errorsmod.Wrap(types.ErrEmpty, "instantiate permission required with constraints")
+				}
+				perm.Constraints = constraints
+			}
 
 			msg := types.MsgUpdateInstantiateConfig{
 				Sender:                   clientCtx.GetFromAddress().String(),
@@ -157,6 +167,7 @@ func UpdateInstantiateConfigCmd() *cobra.Command {
 	}
 
 	addInstantiatePermissionFlags(cmd)
+	addInstantiateConstraintFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
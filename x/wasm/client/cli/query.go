@@ -1,22 +1,28 @@
 package cli
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"os"
 	"strconv"
 
 	wasmvm "github.com/CosmWasm/wasmvm"
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 
 	"github.com/CosmWasm/wasmd/x/wasm/keeper"
 	"github.com/CosmWasm/wasmd/x/wasm/types"
@@ -44,6 +50,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdBuildAddress(),
 		GetCmdListContractsByCreator(),
+		GetCmdPinRecommendations(),
+		GetCmdUploadQuota(),
 	)
 	return queryCmd
 }
@@ -325,7 +333,9 @@ func GetCmdGetContractState() *cobra.Command {
 	}
 	cmd.AddCommand(
 		GetCmdGetContractStateAll(),
+		GetCmdGetContractStateDiff(),
 		GetCmdGetContractStateRaw(),
+		GetCmdGetContractStateRange(),
 		GetCmdGetContractStateSmart(),
 	)
 	return cmd
@@ -414,6 +424,297 @@ func GetCmdGetContractStateRaw() *cobra.Command {
 	return cmd
 }
 
+const (
+	flagRangeStart        = "start"
+	flagRangeEnd          = "end"
+	flagRangePrefix       = "prefix"
+	flagRangeNamespace    = "namespace"
+	flagRangeNamespaceKey = "namespace-key"
+	flagRangeReverse      = "reverse"
+	flagRangeLimit        = "limit"
+)
+
+func GetCmdGetContractStateRange() *cobra.Command {
+	decoder := newArgDecoder(hex.DecodeString)
+	cmd := &cobra.Command{
+		Use:   "range [bech32_address]",
+		Short: "Prints out internal state of a contract within a key range",
+		Long: `Prints out internal state of a contract within a key range.
+Keys are relative to the prefix. The prefix is built from the optional cw-storage-plus namespace,
+the length prefixed namespace keys and the raw prefix bytes, in this order.
+
+Example: all entries of the cw20 "balance" map
+$ wasmd query wasm contract-state range [bech32_address] --namespace=balance`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			req, err := parseContractStateRangeArgs(args[0], cmd.Flags(), decoder)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractStateRange(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	decoder.RegisterFlags(cmd.PersistentFlags(), "keys")
+	cmd.Flags().String(flagRangeStart, "", "Inclusive start key of the range")
+	cmd.Flags().String(flagRangeEnd, "", "Exclusive end key of the range")
+	cmd.Flags().String(flagRangePrefix, "", "Raw key prefix")
+	cmd.Flags().String(flagRangeNamespace, "", "cw-storage-plus namespace (utf8) of a map or indexed map")
+	cmd.Flags().StringArray(flagRangeNamespaceKey, []string{}, "cw-storage-plus composite key element that is length prefixed, can be used multiple times")
+	cmd.Flags().Bool(flagRangeReverse, false, "Iterate in descending key order")
+	cmd.Flags().Uint32(flagRangeLimit, 0, "Max number of entries returned. The server default is used when not set")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseContractStateRangeArgs(contractAddr string, flagSet *flag.FlagSet, decoder *argumentDecoder) (*types.QueryContractStateRangeRequest, error) {
+	decodeFlag := func(name string) ([]byte, error) {
+		v, err := flagSet.GetString(name)
+		if err != nil || v == "" {
+			return nil, err
+		}
+		bz, err := decoder.DecodeString(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", name, err)
+		}
+		return bz, nil
+	}
+	start, err := decodeFlag(flagRangeStart)
+	if err != nil {
+		return nil, err
+	}
+	end, err := decodeFlag(flagRangeEnd)
+	if err != nil {
+		return nil, err
+	}
+	rawPrefix, err := decodeFlag(flagRangePrefix)
+	if err != nil {
+		return nil, err
+	}
+	namespace, err := flagSet.GetString(flagRangeNamespace)
+	if err != nil {
+		return nil, err
+	}
+	nsKeyArgs, err := flagSet.GetStringArray(flagRangeNamespaceKey)
+	if err != nil {
+		return nil, err
+	}
+	if namespace == "" && len(nsKeyArgs) != 0 {
+		return nil, errors.New("namespace keys require a namespace")
+	}
+	var prefix []byte
+	if namespace != "" {
+		nsKeys := make([][]byte, len(nsKeyArgs))
+		for i, v := range nsKeyArgs {
+			if nsKeys[i], err = decoder.DecodeString(v); err != nil {
+				return nil, fmt.Errorf("%s: %s", flagRangeNamespaceKey, err)
+			}
+		}
+		if prefix, err = cwStorageNamespacePrefix([]byte(namespace), nsKeys...); err != nil {
+			return nil, err
+		}
+	}
+	reverse, err := flagSet.GetBool(flagRangeReverse)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := flagSet.GetUint32(flagRangeLimit)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractStateRangeRequest{
+		Address:  contractAddr,
+		Prefix:   append(prefix, rawPrefix...),
+		StartKey: start,
+		EndKey:   end,
+		Reverse:  reverse,
+		Limit:    limit,
+	}, nil
+}
+
+// cwStorageNamespacePrefix builds the key prefix as cw-storage-plus does for maps: the namespace
+// and each composite key element are prefixed with their length as 2 bytes big endian.
+func cwStorageNamespacePrefix(namespace []byte, keys ...[]byte) ([]byte, error) {
+	var r []byte
+	for _, v := range append([][]byte{namespace}, keys...) {
+		if len(v) > math.MaxUint16 {
+			return nil, fmt.Errorf("namespace element exceeds max length: %d", len(v))
+		}
+		r = binary.BigEndian.AppendUint16(r, uint16(len(v)))
+		r = append(r, v...)
+	}
+	return r, nil
+}
+
+func GetCmdGetContractStateDiff() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [bech32_address] [from-height] [to-height]",
+		Short: "Prints out the keys of a contract that were added, removed or changed between two heights",
+		Long: `Prints out the keys of a contract that were added, removed or changed between two heights.
+The contract store is iterated at both heights so that the node must not have pruned them.
+Values that are valid json are printed decoded, all others base64 encoded.
+Use --output=json for scripting.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			fromHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("from-height: %s", err)
+			}
+			toHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("to-height: %s", err)
+			}
+			if fromHeight <= 0 || toHeight <= fromHeight {
+				return errors.New("heights must be positive and from-height before to-height")
+			}
+			res, err := queryContractStateDiff(cmd.Context(), clientCtx, args[0], fromHeight, toHeight)
+			if err != nil {
+				return err
+			}
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(bz)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// contractStateDiff is the result of a contract state comparison between two heights
+type contractStateDiff struct {
+	Address    string           `json:"address"`
+	FromHeight int64            `json:"from_height"`
+	ToHeight   int64            `json:"to_height"`
+	Added      []stateDiffEntry `json:"added"`
+	Removed    []stateDiffEntry `json:"removed"`
+	Changed    []stateDiffEntry `json:"changed"`
+}
+
+type stateDiffEntry struct {
+	Key      tmbytes.HexBytes `json:"key"`
+	OldValue decodedValue     `json:"old_value,omitempty"`
+	NewValue decodedValue     `json:"new_value,omitempty"`
+}
+
+// decodedValue is json encoded as is when it contains valid json, otherwise as base64 string
+type decodedValue []byte
+
+func (v decodedValue) MarshalJSON() ([]byte, error) {
+	if json.Valid(v) {
+		return v, nil
+	}
+	return json.Marshal([]byte(v))
+}
+
+// queryContractStateDiff iterates the contract store at both heights via grpc range queries
+func queryContractStateDiff(ctx context.Context, clientCtx client.Context, contractAddr string, fromHeight, toHeight int64) (*contractStateDiff, error) {
+	pagesAt := func(height int64) contractStatePageFn {
+		queryClient := types.NewQueryClient(clientCtx.WithHeight(height))
+		return func(startKey []byte) (*types.QueryContractStateRangeResponse, error) {
+			return queryClient.ContractStateRange(ctx, &types.QueryContractStateRangeRequest{
+				Address:  contractAddr,
+				StartKey: startKey,
+			})
+		}
+	}
+	r, err := diffContractState(pagesAt(fromHeight), pagesAt(toHeight))
+	if err != nil {
+		return nil, err
+	}
+	r.Address, r.FromHeight, r.ToHeight = contractAddr, fromHeight, toHeight
+	return r, nil
+}
+
+// contractStatePageFn returns the page of contract state models starting with the given key
+type contractStatePageFn func(startKey []byte) (*types.QueryContractStateRangeResponse, error)
+
+// diffContractState compares both sets of models in a single pass. Models must be ordered by key ascending.
+func diffContractState(from, to contractStatePageFn) (*contractStateDiff, error) {
+	r := contractStateDiff{Added: []stateDiffEntry{}, Removed: []stateDiffEntry{}, Changed: []stateDiffEntry{}}
+	fromIter, toIter := &contractStateIterator{page: from}, &contractStateIterator{page: to}
+	for {
+		oldModel, err := fromIter.Peek()
+		if err != nil {
+			return nil, fmt.Errorf("from-height: %w", err)
+		}
+		newModel, err := toIter.Peek()
+		if err != nil {
+			return nil, fmt.Errorf("to-height: %w", err)
+		}
+		switch {
+		case oldModel == nil && newModel == nil:
+			return &r, nil
+		case newModel == nil || (oldModel != nil && bytes.Compare(oldModel.Key, newModel.Key) < 0):
+			r.Removed = append(r.Removed, stateDiffEntry{Key: oldModel.Key, OldValue: oldModel.Value})
+			fromIter.Next()
+		case oldModel == nil || bytes.Compare(oldModel.Key, newModel.Key) > 0:
+			r.Added = append(r.Added, stateDiffEntry{Key: newModel.Key, NewValue: newModel.Value})
+			toIter.Next()
+		default:
+			if !bytes.Equal(oldModel.Value, newModel.Value) {
+				r.Changed = append(r.Changed, stateDiffEntry{Key: newModel.Key, OldValue: oldModel.Value, NewValue: newModel.Value})
+			}
+			fromIter.Next()
+			toIter.Next()
+		}
+	}
+}
+
+// contractStateIterator loads the pages of contract state lazily
+type contractStateIterator struct {
+	page    contractStatePageFn
+	models  []types.Model
+	nextKey []byte
+	started bool
+}
+
+// Peek returns the current model or nil when all pages were consumed
+func (i *contractStateIterator) Peek() (*types.Model, error) {
+	for len(i.models) == 0 {
+		if i.started && len(i.nextKey) == 0 {
+			return nil, nil
+		}
+		res, err := i.page(i.nextKey)
+		if err != nil {
+			return nil, err
+		}
+		i.models, i.nextKey, i.started = res.Models, res.NextKey, true
+	}
+	return &i.models[0], nil
+}
+
+// Next moves to the next model. Peek must be called before.
+func (i *contractStateIterator) Next() {
+	i.models = i.models[1:]
+}
+
 func GetCmdGetContractStateSmart() *cobra.Command {
 	decoder := newArgDecoder(asciiDecodeString)
 	cmd := &cobra.Command{
@@ -674,3 +975,122 @@ func GetCmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdPinRecommendations ranks the unpinned codes by estimated gas savings
+func GetCmdPinRecommendations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pin-recommendations",
+		Short: "List unpinned codes ranked by the estimated gas savings when pinned",
+		Long: `List unpinned codes ranked by the estimated gas savings when pinned.
+The usage data is recorded by the node queried and requires "code_usage_tracking" enabled in the wasm config.
+With the --proposal flag a pin-codes proposal is printed that can be submitted via "tx gov submit-proposal [file]".`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetUint32(flags.FlagLimit)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PinRecommendations(
+				context.Background(),
+				&types.QueryPinRecommendationsRequest{Limit: limit},
+			)
+			if err != nil {
+				return err
+			}
+			if asProposal, _ := cmd.Flags().GetBool(flagProposal); !asProposal {
+				return clientCtx.PrintProto(res)
+			}
+			if len(res.Recommendations) == 0 {
+				return errors.New("no codes to pin")
+			}
+			codeIDs := make([]uint64, len(res.Recommendations))
+			for i, r := range res.Recommendations {
+				codeIDs[i] = r.CodeID
+			}
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+			title, _ := cmd.Flags().GetString(govcli.FlagTitle)
+			summary, _ := cmd.Flags().GetString(govcli.FlagSummary)
+			deposit, _ := cmd.Flags().GetString(govcli.FlagDeposit)
+			bz, err := pinCodesProposalJSON(clientCtx.Codec, authority, codeIDs, title, summary, deposit)
+			if err != nil {
+				return err
+			}
+			// always JSON, independent of the output flag, so that it can be submitted as proposal file
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().Uint32(flags.FlagLimit, 0, "Max number of codes returned. All when 0")
+	cmd.Flags().Bool(flagProposal, false, "Print a pin-codes proposal for the recommended codes")
+	cmd.Flags().String(flagAuthority, DefaultGovAuthority.String(), "The address of the governance account used in the proposal")
+	cmd.Flags().String(govcli.FlagTitle, "Pin codes", "Title of the proposal")
+	cmd.Flags().String(govcli.FlagSummary, "Pin the most used codes to save gas on instantiation and execution", "Summary of the proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "Deposit of the proposal")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// pinCodesProposalJSON returns a proposal file for "tx gov submit-proposal" with a MsgPinCodes
+func pinCodesProposalJSON(cdc codec.Codec, authority string, codeIDs []uint64, title, summary, deposit string) ([]byte, error) {
+	msg := types.MsgPinCodes{Authority: authority, CodeIDs: codeIDs}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	msgJSON, err := cdc.MarshalInterfaceJSON(&msg)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(struct {
+		Messages []json.RawMessage `json:"messages"`
+		Metadata string            `json:"metadata"`
+		Deposit  string            `json:"deposit"`
+		Title    string            `json:"title"`
+		Summary  string            `json:"summary"`
+	}{
+		Messages: []json.RawMessage{msgJSON},
+		Deposit:  deposit,
+		Title:    title,
+		Summary:  summary,
+	}, "", "  ")
+}
+
+// GetCmdUploadQuota queries the remaining code upload quota of an address
+func GetCmdUploadQuota() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "upload-quota [address]",
+		Short:   "Query the remaining code upload quota of an address",
+		Long:    "Query the codes and bytes that an address uploaded within the current quota window and how many it can still upload",
+		Aliases: []string{"quota"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.UploadQuota(
+				context.Background(),
+				&types.QueryUploadQuotaRequest{Address: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,203 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestParseContractStateRangeArgs(t *testing.T) {
+	const myContract = "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"
+	specs := map[string]struct {
+		args   []string
+		expReq *types.QueryContractStateRangeRequest
+		expErr bool
+	}{
+		"hex default": {
+			args: []string{"--start=0102", "--end=0103", "--prefix=00", "--limit=10", "--reverse"},
+			expReq: &types.QueryContractStateRangeRequest{
+				Address:  myContract,
+				Prefix:   []byte{0x00},
+				StartKey: []byte{0x01, 0x02},
+				EndKey:   []byte{0x01, 0x03},
+				Reverse:  true,
+				Limit:    10,
+			},
+		},
+		"ascii keys": {
+			args: []string{"--ascii", "--start=alice", "--prefix=foo"},
+			expReq: &types.QueryContractStateRangeRequest{
+				Address:  myContract,
+				Prefix:   []byte("foo"),
+				StartKey: []byte("alice"),
+			},
+		},
+		"base64 keys": {
+			args: []string{"--b64", "--end=YWxpY2U="},
+			expReq: &types.QueryContractStateRangeRequest{
+				Address: myContract,
+				EndKey:  []byte("alice"),
+			},
+		},
+		"cw-storage-plus namespace": {
+			args: []string{"--namespace=balance"},
+			expReq: &types.QueryContractStateRangeRequest{
+				Address: myContract,
+				Prefix:  []byte("\x00\x07balance"),
+			},
+		},
+		"cw-storage-plus namespace with composite keys and raw prefix": {
+			args: []string{"--ascii", "--namespace=allowance", "--namespace-key=alice", "--prefix=b"},
+			expReq: &types.QueryContractStateRangeRequest{
+				Address: myContract,
+				Prefix:  []byte("\x00\x09allowance\x00\x05aliceb"),
+			},
+		},
+		"namespace key without namespace": {
+			args:   []string{"--namespace-key=01"},
+			expErr: true,
+		},
+		"invalid hex": {
+			args:   []string{"--start=xyz"},
+			expErr: true,
+		},
+		"multiple encodings": {
+			args:   []string{"--ascii", "--hex", "--start=01"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			decoder := newArgDecoder(hex.DecodeString)
+			cmd := GetCmdGetContractStateRange()
+			decoder.RegisterFlags(cmd.Flags(), "keys")
+			require.NoError(t, cmd.Flags().Parse(spec.args))
+			gotReq, gotErr := parseContractStateRangeArgs(myContract, cmd.Flags(), decoder)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expReq, gotReq)
+		})
+	}
+}
+
+func TestDiffContractState(t *testing.T) {
+	// pagesOf returns the models in pages of 2 elements
+	pagesOf := func(models ...types.Model) contractStatePageFn {
+		return func(startKey []byte) (*types.QueryContractStateRangeResponse, error) {
+			var pos int
+			for pos < len(models) && bytes.Compare(models[pos].Key, startKey) < 0 {
+				pos++
+			}
+			end := pos + 2
+			if end >= len(models) {
+				return &types.QueryContractStateRangeResponse{Models: models[pos:]}, nil
+			}
+			return &types.QueryContractStateRangeResponse{Models: models[pos:end], NextKey: models[end].Key}, nil
+		}
+	}
+	model := func(k, v string) types.Model {
+		return types.Model{Key: []byte(k), Value: []byte(v)}
+	}
+	specs := map[string]struct {
+		from, to   contractStatePageFn
+		expAdded   []stateDiffEntry
+		expRemoved []stateDiffEntry
+		expChanged []stateDiffEntry
+		expErr     bool
+	}{
+		"no changes": {
+			from: pagesOf(model("a", "1"), model("b", "2"), model("c", "3")),
+			to:   pagesOf(model("a", "1"), model("b", "2"), model("c", "3")),
+		},
+		"both empty": {
+			from: pagesOf(),
+			to:   pagesOf(),
+		},
+		"added": {
+			from:     pagesOf(model("b", "2")),
+			to:       pagesOf(model("a", "1"), model("b", "2"), model("c", "3"), model("d", "4")),
+			expAdded: []stateDiffEntry{{Key: []byte("a"), NewValue: []byte("1")}, {Key: []byte("c"), NewValue: []byte("3")}, {Key: []byte("d"), NewValue: []byte("4")}},
+		},
+		"removed": {
+			from:       pagesOf(model("a", "1"), model("b", "2"), model("c", "3")),
+			to:         pagesOf(model("b", "2")),
+			expRemoved: []stateDiffEntry{{Key: []byte("a"), OldValue: []byte("1")}, {Key: []byte("c"), OldValue: []byte("3")}},
+		},
+		"all": {
+			from:       pagesOf(model("a", "1"), model("b", "2"), model("c", "3")),
+			to:         pagesOf(model("b", "3"), model("c", "3"), model("d", "4")),
+			expAdded:   []stateDiffEntry{{Key: []byte("d"), NewValue: []byte("4")}},
+			expRemoved: []stateDiffEntry{{Key: []byte("a"), OldValue: []byte("1")}},
+			expChanged: []stateDiffEntry{{Key: []byte("b"), OldValue: []byte("2"), NewValue: []byte("3")}},
+		},
+		"query error": {
+			from: func([]byte) (*types.QueryContractStateRangeResponse, error) {
+				return nil, errors.New("testing")
+			},
+			to:     pagesOf(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := diffContractState(spec.from, spec.to)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expAdded, nilIfEmpty(got.Added))
+			assert.Equal(t, spec.expRemoved, nilIfEmpty(got.Removed))
+			assert.Equal(t, spec.expChanged, nilIfEmpty(got.Changed))
+		})
+	}
+}
+
+func TestDecodedValueMarshalJSON(t *testing.T) {
+	got, err := json.Marshal(stateDiffEntry{Key: []byte{0x1}, OldValue: []byte(`{"foo":"bar"}`), NewValue: []byte{0xff}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"key":"01","old_value":{"foo":"bar"},"new_value":"/w=="}`, string(got))
+}
+
+func nilIfEmpty[T any](s []T) []T {
+	if len(s) == 0 {
+		return nil
+	}
+	return s
+}
+
+func TestPinCodesProposalJSON(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	authority := DefaultGovAuthority.String()
+
+	got, err := pinCodesProposalJSON(cdc, authority, []uint64{2, 1}, "my title", "my summary", "100stake")
+	require.NoError(t, err)
+	exp := fmt.Sprintf(`{
+	"messages": [{"@type": "/cosmwasm.wasm.v1.MsgPinCodes", "authority": %q, "code_ids": ["2", "1"]}],
+	"metadata": "",
+	"deposit": "100stake",
+	"title": "my title",
+	"summary": "my summary"
+}`, authority)
+	assert.JSONEq(t, exp, string(got))
+
+	// invalid msg
+	_, err = pinCodesProposalJSON(cdc, "invalid", []uint64{1}, "my title", "my summary", "")
+	require.Error(t, err)
+}
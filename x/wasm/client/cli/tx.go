@@ -2,10 +2,12 @@ package cli
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -37,6 +39,11 @@ const (
 	flagInstantiateNobody         = "instantiate-nobody"
 	flagInstantiateByAddress      = "instantiate-only-address"
 	flagInstantiateByAnyOfAddress = "instantiate-anyof-addresses"
+	flagInstantiateByAnyOfCodeID  = "instantiate-anyof-code-ids"
+	flagRequireNoAdmin            = "require-no-admin"
+	flagRequireAdmin              = "require-admin"
+	flagMaxInstantiateFunds       = "max-instantiate-funds"
+	flagClearConstraints          = "clear-instantiate-constraints"
 	flagUnpinCode                 = "unpin-code"
 	flagAllowedMsgKeys            = "allow-msg-keys"
 	flagAllowedRawMsgs            = "allow-raw-msgs"
@@ -44,8 +51,18 @@ const (
 	flagMaxCalls                  = "max-calls"
 	flagMaxFunds                  = "max-funds"
 	flagAllowAllMsgs              = "allow-all-messages"
+	flagAllowMsgFieldEquals       = "allow-msg-field-equals"
+	flagAllowMsgFieldIn           = "allow-msg-field-in"
+	flagAllowMsgFieldLTE          = "allow-msg-field-lte"
+	flagAllowNewAdmins            = "allow-new-admins"
+	flagWindow                    = "window"
+	flagRollingWindow             = "rolling-window"
+	flagWindowMaxCalls            = "window-max-calls"
+	flagWindowMaxFunds            = "window-max-funds"
 	flagNoTokenTransfer           = "no-token-transfer"
+	flagInstantiate2              = "instantiate2"
 	flagAuthority                 = "authority"
+	flagProposal                  = "proposal"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -139,14 +156,24 @@ func parseAccessConfigFlags(flags *flag.FlagSet) (*types.AccessConfig, error) {
 		return nil, fmt.Errorf("flag any of: %s", err)
 	}
 	if len(addrs) != 0 {
-		acceptedAddrs := make([]sdk.AccAddress, len(addrs))
-		for i, v := range addrs {
-			acceptedAddrs[i], err = sdk.AccAddressFromBech32(v)
-			if err != nil {
-				return nil, fmt.Errorf("parse %q: %w", v, err)
-			}
+		x, err := parseAnyOfAddressesAccessConfig(addrs)
+		if err != nil {
+			return nil, err
+		}
+		return &x, nil
+	}
+	codeIDs, err := flags.GetUintSlice(flagInstantiateByAnyOfCodeID)
+	if err != nil {
+		return nil, fmt.Errorf("flag any of code ids: %s", err)
+	}
+	if len(codeIDs) != 0 {
+		x := types.AccessConfig{Permission: types.AccessTypeAnyOfCodeIDs, CodeIDs: make([]uint64, len(codeIDs))}
+		for i, v := range codeIDs {
+			x.CodeIDs[i] = uint64(v)
+		}
+		if err := x.ValidateBasic(); err != nil {
+			return nil, err
 		}
-		x := types.AccessTypeAnyOfAddresses.With(acceptedAddrs...)
 		return &x, nil
 	}
 
@@ -187,11 +214,86 @@ func parseAccessConfigFlags(flags *flag.FlagSet) (*types.AccessConfig, error) {
 	return nil, nil
 }
 
+// parseAnyOfAddressesAccessConfig parses the addresses with optional expiry. Format: `address[@expiry]`
+// where expiry is a block height or a RFC3339 block time.
+func parseAnyOfAddressesAccessConfig(addrs []string) (types.AccessConfig, error) {
+	cfg := types.AccessConfig{Permission: types.AccessTypeAnyOfAddresses, Addresses: make([]string, len(addrs))}
+	for i, v := range addrs {
+		addrStr, expiryStr, hasExpiry := strings.Cut(v, "@")
+		addr, err := sdk.AccAddressFromBech32(addrStr)
+		if err != nil {
+			return types.AccessConfig{}, fmt.Errorf("parse %q: %w", addrStr, err)
+		}
+		cfg.Addresses[i] = addr.String()
+		if !hasExpiry {
+			continue
+		}
+		expiry := types.AccessExpiry{Address: addr.String()}
+		if height, err := strconv.ParseUint(expiryStr, 10, 64); err == nil {
+			expiry.Height = height
+		} else {
+			t, err := time.Parse(time.RFC3339, expiryStr)
+			if err != nil {
+				return types.AccessConfig{}, fmt.Errorf("expiry %q: height or RFC3339 time expected", expiryStr)
+			}
+			expiry.Time = &t
+		}
+		cfg.Expiries = append(cfg.Expiries, expiry)
+	}
+	return cfg, cfg.ValidateBasic()
+}
+
 func addInstantiatePermissionFlags(cmd *cobra.Command) {
 	cmd.Flags().String(flagInstantiateByEverybody, "", "Everybody can instantiate a contract from the code, optional")
 	cmd.Flags().String(flagInstantiateNobody, "", "Nobody except the governance process can instantiate a contract from the code, optional")
 	cmd.Flags().String(flagInstantiateByAddress, "", fmt.Sprintf("Removed: use %s instead", flagInstantiateByAnyOfAddress))
-	cmd.Flags().StringSlice(flagInstantiateByAnyOfAddress, []string{}, "Any of the addresses can instantiate a contract from the code, optional")
+	cmd.Flags().StringSlice(flagInstantiateByAnyOfAddress, []string{}, "Any of the addresses can instantiate a contract from the code, optional. An address expires with the suffix @<height> or @<RFC3339 time>")
+	cmd.Flags().UintSlice(flagInstantiateByAnyOfCodeID, []uint{}, "Any contract of the code ids can instantiate a contract from the code, optional")
+}
+
+func addInstantiateConstraintFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(flagRequireNoAdmin, false, "Contracts must be instantiated without an admin, optional")
+	cmd.Flags().String(flagRequireAdmin, "", "Contracts must be instantiated with this admin address, optional")
+	cmd.Flags().String(flagMaxInstantiateFunds, "", "Max funds that can be sent to a contract on instantiation, optional")
+	cmd.Flags().Bool(flagClearConstraints, false, "Remove the existing constraints, optional. Existing constraints are kept otherwise")
+}
+
+// parseInstantiateConstraintFlags returns the constraints of the flags or nil when none is set. Empty constraints
+// are returned to clear the existing ones.
+func parseInstantiateConstraintFlags(flags *flag.FlagSet) (*types.InstantiateConstraints, error) {
+	clearConstraints, err := flags.GetBool(flagClearConstraints)
+	if err != nil {
+		return nil, fmt.Errorf("clear constraints: %s", err)
+	}
+	noAdmin, err := flags.GetBool(flagRequireNoAdmin)
+	if err != nil {
+		return nil, fmt.Errorf("require no admin: %s", err)
+	}
+	admin, err := flags.GetString(flagRequireAdmin)
+	if err != nil {
+		return nil, fmt.Errorf("require admin: %s", err)
+	}
+	maxFundsStr, err := flags.GetString(flagMaxInstantiateFunds)
+	if err != nil {
+		return nil, fmt.Errorf("max instantiate funds: %s", err)
+	}
+	maxFunds, err := sdk.ParseCoinsNormalized(maxFundsStr)
+	if err != nil {
+		return nil, fmt.Errorf("max instantiate funds: %s", err)
+	}
+	x := types.InstantiateConstraints{NoAdmin: noAdmin, Admin: admin, MaxFunds: maxFunds}
+	switch {
+	case clearConstraints && !x.IsEmpty():
+		return nil, errors.New("clear constraints can not be combined with other constraint flags")
+	case clearConstraints:
+		return &x, nil
+	case x.IsEmpty():
+		return nil, nil
+	}
+	if err := x.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return &x, nil
 }
 
 // InstantiateContractCmd will instantiate a contract from previously uploaded code.
@@ -367,8 +469,11 @@ func parseInstantiateArgs(rawCodeID, initMsg string, kr keyring.Keyring, sender
 // ExecuteContractCmd will instantiate a contract from previously uploaded code.
 func ExecuteContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "execute [contract_addr_bech32] [json_encoded_send_args] --amount [coins,optional]",
-		Short:   "Execute a command on a wasm contract",
+		Use:   "execute [contract_addr_bech32] [json_encoded_send_args] --amount [coins,optional]",
+		Short: "Execute a command on a wasm contract",
+		Long: fmt.Sprintf(`Execute a command on a wasm contract.
+With --%s the execution is simulated on the node without a signed tx. The result data, events, gas used,
+sub-messages and contract store changes are printed and nothing is broadcast.`, flags.FlagDryRun),
 		Aliases: []string{"run", "call", "exec", "ex", "e"},
 		Args:    cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -384,6 +489,19 @@ func ExecuteContractCmd() *cobra.Command {
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
+			if clientCtx.Simulate {
+				queryClient := types.NewQueryClient(clientCtx)
+				res, err := queryClient.SimulateExecute(cmd.Context(), &types.QuerySimulateExecuteRequest{
+					Sender:   msg.Sender,
+					Contract: msg.Contract,
+					Msg:      msg.Msg,
+					Funds:    msg.Funds,
+				})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 		SilenceUsage: true,
@@ -415,7 +533,7 @@ func parseExecuteArgs(contractAddr, execMsg string, sender sdk.AccAddress, flags
 
 func GrantAuthorizationCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant [grantee] [message_type=\"execution\"|\"migration\"] [contract_addr_bech32] --allow-raw-msgs [msg1,msg2,...] --allow-msg-keys [key1,key2,...] --allow-all-messages",
+		Use:   "grant [grantee] [message_type=\"execution\"|\"migration\"|\"instantiation\"|\"update-admin\"|\"clear-admin\"] [contract_addr_bech32|code-id:<code_id>|code-hash:<hex>|code_id] --allow-raw-msgs [msg1,msg2,...] --allow-msg-keys [key1,key2,...] --allow-all-messages --allow-msg-field-equals [path=json] --allow-msg-field-in [path=json_array] --allow-msg-field-lte [path=number] --allow-new-admins [addr1,addr2,...] --instantiate2",
 		Short: "Grant authorization to an address",
 		Long: fmt.Sprintf(`Grant authorization to an address.
 Examples:
@@ -424,7 +542,29 @@ $ %s tx grant <grantee_addr> execution <contract_addr> --allow-all-messages --ma
 $ %s tx grant <grantee_addr> execution <contract_addr> --allow-all-messages --max-funds 100000uwasm --expiration 1667979596
 
 $ %s tx grant <grantee_addr> execution <contract_addr> --allow-all-messages --max-calls 5 --max-funds 100000uwasm --expiration 1667979596
-`, version.AppName, version.AppName, version.AppName),
+
+A window limit applies again in each window of block time. It can be combined with the limits above:
+$ %s tx grant <grantee_addr> execution <contract_addr> --allow-all-messages --window 24h --window-max-calls 10 --window-max-funds 1000uwasm --expiration 1667979596
+
+The field rules reference a value of the json message with a JSON pointer path. A grant with field rules accepts
+only messages that match all of them:
+$ %s tx grant <grantee_addr> execution <contract_addr> --allow-msg-field-equals '/transfer/recipient="<addr>"' --allow-msg-field-lte '/transfer/amount=100' --max-calls 5 --no-token-transfer --expiration 1667979596
+
+Execution and migration grants can apply to all contracts of a code id or of codes with a checksum instead of a
+single contract. Contracts that were migrated from another code are not covered:
+$ %s tx grant <grantee_addr> execution code-id:<code_id> --allow-all-messages --max-calls 5 --no-token-transfer --expiration 1667979596
+
+Admin grants apply to updating or clearing the admin of a contract. They do not support message filters. Admin updates
+can be restricted to new admins:
+$ %s tx grant <grantee_addr> update-admin <contract_addr> --allow-new-admins <admin_addr1>,<admin_addr2> --max-calls 1 --no-token-transfer --expiration 1667979596
+
+An instantiation grant applies to the instantiations of a code without a predictable address, or with a predictable
+address only when --instantiate2 is set. Grant both separately to allow both, each with its own limits. The limits and
+filters apply to the funds and the init message. The new contracts can be required to use an admin:
+$ %s tx grant <grantee_addr> instantiation <code_id> --allow-all-messages --max-calls 5 --max-funds 100000uwasm --require-admin <admin_addr> --expiration 1667979596
+
+$ %s tx grant <grantee_addr> instantiation <code_id> --instantiate2 --allow-all-messages --max-calls 5 --expiration 1667979596
+`, version.AppName, version.AppName, version.AppName, version.AppName, version.AppName, version.AppName, version.AppName, version.AppName, version.AppName),
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -437,21 +577,29 @@ $ %s tx grant <grantee_addr> execution <contract_addr> --allow-all-messages --ma
 				return err
 			}
 
-			contract, err := sdk.AccAddressFromBech32(args[2])
+			msgKeys, err := cmd.Flags().GetStringSlice(flagAllowedMsgKeys)
 			if err != nil {
 				return err
 			}
 
-			msgKeys, err := cmd.Flags().GetStringSlice(flagAllowedMsgKeys)
+			rawMsgs, err := cmd.Flags().GetStringSlice(flagAllowedRawMsgs)
 			if err != nil {
 				return err
 			}
 
-			rawMsgs, err := cmd.Flags().GetStringSlice(flagAllowedRawMsgs)
+			fieldRules, err := parseMessageFieldRuleFlags(cmd.Flags())
 			if err != nil {
 				return err
 			}
 
+			newAdmins, err := parseAllowNewAdminsFlag(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if len(newAdmins) != 0 && args[1] != "update-admin" {
+				return fmt.Errorf("%s is only supported for update-admin", flagAllowNewAdmins)
+			}
+
 			maxFundsStr, err := cmd.Flags().GetString(flagMaxFunds)
 			if err != nil {
 				return fmt.Errorf("max funds: %s", err)
@@ -480,8 +628,15 @@ $ %s tx grant <grantee_addr> execution <contract_addr> --allow-all-messages --ma
 				return err
 			}
 
+			constraints, err := parseInstantiateConstraintFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			var limit types.ContractAuthzLimitX
 			switch {
+			case maxFundsStr == "" && maxCalls == 0 && !noTokenTransfer:
+				// no lifetime limit
 			case maxFundsStr != "" && maxCalls != 0 && !noTokenTransfer:
 				maxFunds, err := sdk.ParseCoinsNormalized(maxFundsStr)
 				if err != nil {
@@ -500,11 +655,36 @@ $ %s tx grant <grantee_addr> execution <contract_addr> --allow-all-messages --ma
 				return errors.New("invalid limit setup")
 			}
 
+			windowLimit, err := parseWindowLimitFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			switch {
+			case limit != nil && windowLimit != nil:
+				if limit, err = types.NewAllOfLimits(limit, windowLimit); err != nil {
+					return err
+				}
+			case windowLimit != nil:
+				limit = windowLimit
+			case limit == nil:
+				return errors.New("invalid limit setup")
+			}
+
+			var filterCount int
+			for _, isSet := range []bool{allowAllMsgs, len(msgKeys) != 0, len(rawMsgs) != 0, len(fieldRules) != 0} {
+				if isSet {
+					filterCount++
+				}
+			}
+
+			isAdminGrant := args[1] == "update-admin" || args[1] == "clear-admin"
 			var filter types.ContractAuthzFilterX
 			switch {
-			case allowAllMsgs && len(msgKeys) != 0 || allowAllMsgs && len(rawMsgs) != 0 || len(msgKeys) != 0 && len(rawMsgs) != 0:
+			case filterCount > 1:
 				return errors.New("cannot set more than one filter within one grant")
-			case allowAllMsgs:
+			case isAdminGrant && filterCount != 0 && !allowAllMsgs:
+				return errors.New("message filters are not supported for admin grants")
+			case allowAllMsgs, isAdminGrant:
 				filter = types.NewAllowAllMessagesFilter()
 			case len(msgKeys) != 0:
 				filter = types.NewAcceptedMessageKeysFilter(msgKeys...)
@@ -514,21 +694,50 @@ $ %s tx grant <grantee_addr> execution <contract_addr> --allow-all-messages --ma
 					msgs[i] = types.RawContractMessage(msg)
 				}
 				filter = types.NewAcceptedMessagesFilter(msgs...)
+			case len(fieldRules) != 0:
+				filter = types.NewAcceptedMessageFieldsFilter(fieldRules...)
 			default:
 				return errors.New("invalid filter setup")
 			}
 
-			grant, err := types.NewContractGrant(contract, limit, filter)
+			instantiate2, err := cmd.Flags().GetBool(flagInstantiate2)
 			if err != nil {
-				return err
+				return fmt.Errorf("instantiate2: %s", err)
 			}
 
 			var authorization authz.Authorization
 			switch args[1] {
-			case "execution":
-				authorization = types.NewContractExecutionAuthorization(*grant)
-			case "migration":
-				authorization = types.NewContractMigrationAuthorization(*grant)
+			case "execution", "migration", "update-admin", "clear-admin":
+				if constraints != nil {
+					return errors.New("instantiate constraints are only supported for instantiation")
+				}
+				if instantiate2 {
+					return fmt.Errorf("%s is only supported for instantiation", flagInstantiate2)
+				}
+				grant, err := newContractGrant(args[2], limit, filter)
+				if err != nil {
+					return err
+				}
+				switch args[1] {
+				case "execution":
+					authorization = types.NewContractExecutionAuthorization(*grant)
+				case "migration":
+					authorization = types.NewContractMigrationAuthorization(*grant)
+				case "update-admin":
+					authorization = types.NewContractUpdateAdminAuthorization(newAdmins, *grant)
+				default:
+					authorization = types.NewContractClearAdminAuthorization(*grant)
+				}
+			case "instantiation":
+				codeID, err := strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return fmt.Errorf("code id: %s", err)
+				}
+				grant, err := types.NewCodeGrant(codeID, limit, filter, constraints)
+				if err != nil {
+					return err
+				}
+				authorization = types.NewContractInstantiationAuthorization(instantiate2, *grant)
 			default:
 				return fmt.Errorf("%s authorization type not supported", args[1])
 			}
@@ -552,10 +761,130 @@ $ %s tx grant <grantee_addr> execution <contract_addr> --allow-all-messages --ma
 	cmd.Flags().String(flagMaxFunds, "", "Maximal amount of tokens transferable to the contract.")
 	cmd.Flags().Int64(flagExpiration, 0, "The Unix timestamp.")
 	cmd.Flags().Bool(flagAllowAllMsgs, false, "Allow all messages")
+	cmd.Flags().Duration(flagWindow, 0, "Duration of the window for the window limit")
+	cmd.Flags().Bool(flagRollingWindow, false, "Use a rolling window that ends with the current block time instead of fixed windows")
+	cmd.Flags().Uint64(flagWindowMaxCalls, 0, "Maximal number of calls to the contract within a window")
+	cmd.Flags().String(flagWindowMaxFunds, "", "Maximal amount of tokens transferable to the contract within a window")
+	cmd.Flags().StringArray(flagAllowMsgFieldEquals, []string{}, "Allow messages where the field at the JSON pointer path equals the json value: <path>=<json>")
+	cmd.Flags().StringArray(flagAllowMsgFieldIn, []string{}, "Allow messages where the field at the JSON pointer path equals one of the json array elements: <path>=<json array>")
+	cmd.Flags().StringArray(flagAllowMsgFieldLTE, []string{}, "Allow messages where the number at the JSON pointer path is less or equal: <path>=<decimal>")
+	cmd.Flags().StringSlice(flagAllowNewAdmins, []string{}, "Allow admin updates to these new admin addresses only")
 	cmd.Flags().Bool(flagNoTokenTransfer, false, "Don't allow token transfer")
+	cmd.Flags().Bool(flagInstantiate2, false, "Grant instantiations with a predictable address instead of the classic ones")
+	addInstantiateConstraintFlags(cmd)
 	return cmd
 }
 
+// newContractGrant returns a grant for the target contract address or for all contracts of the code in a
+// "code-id:<code_id>" or "code-hash:<hex>" target
+func newContractGrant(target string, limit types.ContractAuthzLimitX, filter types.ContractAuthzFilterX) (*types.ContractGrant, error) {
+	if s, ok := strings.CutPrefix(target, "code-id:"); ok {
+		codeID, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("code id: %s", err)
+		}
+		return types.NewCodeIDContractGrant(codeID, limit, filter)
+	}
+	if s, ok := strings.CutPrefix(target, "code-hash:"); ok {
+		codeHash, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("code hash: %s", err)
+		}
+		return types.NewCodeHashContractGrant(codeHash, limit, filter)
+	}
+	contract, err := sdk.AccAddressFromBech32(target)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewContractGrant(contract, limit, filter)
+}
+
+// parseAllowNewAdminsFlag returns the validated new admin addresses of admin updates
+func parseAllowNewAdminsFlag(flags *flag.FlagSet) ([]string, error) {
+	admins, err := flags.GetStringSlice(flagAllowNewAdmins)
+	if err != nil {
+		return nil, fmt.Errorf("allow new admins: %s", err)
+	}
+	for _, admin := range admins {
+		if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+			return nil, fmt.Errorf("allow new admins: %s", err)
+		}
+	}
+	return admins, nil
+}
+
+// parseWindowLimitFlags returns the validated window limit or nil when no window is set
+func parseWindowLimitFlags(flags *flag.FlagSet) (*types.WindowLimit, error) {
+	window, err := flags.GetDuration(flagWindow)
+	if err != nil {
+		return nil, fmt.Errorf("window: %s", err)
+	}
+	rolling, err := flags.GetBool(flagRollingWindow)
+	if err != nil {
+		return nil, fmt.Errorf("rolling window: %s", err)
+	}
+	maxCalls, err := flags.GetUint64(flagWindowMaxCalls)
+	if err != nil {
+		return nil, fmt.Errorf("window max calls: %s", err)
+	}
+	maxFundsStr, err := flags.GetString(flagWindowMaxFunds)
+	if err != nil {
+		return nil, fmt.Errorf("window max funds: %s", err)
+	}
+	if window == 0 {
+		if rolling || maxCalls != 0 || maxFundsStr != "" {
+			return nil, fmt.Errorf("%s must be set for a window limit", flagWindow)
+		}
+		return nil, nil
+	}
+	maxFunds, err := sdk.ParseCoinsNormalized(maxFundsStr)
+	if err != nil {
+		return nil, fmt.Errorf("window max funds: %s", err)
+	}
+	x := types.NewWindowLimit(window, rolling, maxCalls, maxFunds...)
+	if err := x.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// parseMessageFieldRuleFlags returns the validated rules of all message field flags
+func parseMessageFieldRuleFlags(flags *flag.FlagSet) ([]types.MessageFieldRule, error) {
+	var rules []types.MessageFieldRule
+	for _, name := range []string{flagAllowMsgFieldEquals, flagAllowMsgFieldIn, flagAllowMsgFieldLTE} {
+		values, err := flags.GetStringArray(name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", name, err)
+		}
+		for _, v := range values {
+			path, value, ok := strings.Cut(v, "=")
+			if !ok {
+				return nil, fmt.Errorf("%s: expected <path>=<value> but got %q", name, v)
+			}
+			rule := types.MessageFieldRule{Path: path}
+			switch name {
+			case flagAllowMsgFieldEquals:
+				rule.Equals = types.RawContractMessage(value)
+			case flagAllowMsgFieldIn:
+				var elems []json.RawMessage
+				if err := json.Unmarshal([]byte(value), &elems); err != nil {
+					return nil, fmt.Errorf("%s: json array expected: %s", name, err)
+				}
+				for _, e := range elems {
+					rule.In = append(rule.In, types.RawContractMessage(e))
+				}
+			case flagAllowMsgFieldLTE:
+				rule.LTE = value
+			}
+			if err := rule.ValidateBasic(); err != nil {
+				return nil, fmt.Errorf("%s: %s", name, err)
+			}
+			rules = append(rules, rule)
+		}
+	}
+	return rules, nil
+}
+
 func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
 	exp, err := cmd.Flags().GetInt64(flagExpiration)
 	if err != nil {
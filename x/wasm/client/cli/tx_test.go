@@ -3,6 +3,7 @@ package cli
 import (
 	"encoding/hex"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -101,10 +102,30 @@ func TestParseAccessConfigFlags(t *testing.T) {
 			args:   []string{"--instantiate-anyof-addresses=cosmos1vx8knpllrj7n963p9ttd80w47kpacrhuts497x,cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"},
 			expCfg: &types.AccessConfig{Permission: types.AccessTypeAnyOfAddresses, Addresses: []string{"cosmos1vx8knpllrj7n963p9ttd80w47kpacrhuts497x", "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"}},
 		},
+		"any of address - with height expiry": {
+			args: []string{"--instantiate-anyof-addresses=cosmos1vx8knpllrj7n963p9ttd80w47kpacrhuts497x@100,cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"},
+			expCfg: &types.AccessConfig{
+				Permission: types.AccessTypeAnyOfAddresses,
+				Addresses:  []string{"cosmos1vx8knpllrj7n963p9ttd80w47kpacrhuts497x", "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"},
+				Expiries:   []types.AccessExpiry{{Address: "cosmos1vx8knpllrj7n963p9ttd80w47kpacrhuts497x", Height: 100}},
+			},
+		},
+		"any of address - invalid expiry": {
+			args:   []string{"--instantiate-anyof-addresses=cosmos1vx8knpllrj7n963p9ttd80w47kpacrhuts497x@tomorrow"},
+			expErr: true,
+		},
 		"any of address - invalid": {
 			args:   []string{"--instantiate-anyof-addresses=cosmos1vx8knpllrj7n963p9ttd80w47kpacrhuts497x,foo"},
 			expErr: true,
 		},
+		"any of code ids": {
+			args:   []string{"--instantiate-anyof-code-ids=1,2"},
+			expCfg: &types.AccessConfig{Permission: types.AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{1, 2}},
+		},
+		"any of code ids - invalid": {
+			args:   []string{"--instantiate-anyof-code-ids=0"},
+			expErr: true,
+		},
 		"not set": {
 			args: []string{},
 		},
@@ -123,3 +144,260 @@ func TestParseAccessConfigFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestParseInstantiateConstraintFlags(t *testing.T) {
+	specs := map[string]struct {
+		args   []string
+		exp    *types.InstantiateConstraints
+		expErr bool
+	}{
+		"no admin": {
+			args: []string{"--require-no-admin"},
+			exp:  &types.InstantiateConstraints{NoAdmin: true},
+		},
+		"admin": {
+			args: []string{"--require-admin=cosmos1vx8knpllrj7n963p9ttd80w47kpacrhuts497x"},
+			exp:  &types.InstantiateConstraints{Admin: "cosmos1vx8knpllrj7n963p9ttd80w47kpacrhuts497x"},
+		},
+		"max funds": {
+			args: []string{"--max-instantiate-funds=1denom,2other"},
+			exp:  &types.InstantiateConstraints{MaxFunds: sdk.NewCoins(sdk.NewInt64Coin("denom", 1), sdk.NewInt64Coin("other", 2))},
+		},
+		"no admin and admin": {
+			args:   []string{"--require-no-admin", "--require-admin=cosmos1vx8knpllrj7n963p9ttd80w47kpacrhuts497x"},
+			expErr: true,
+		},
+		"invalid admin": {
+			args:   []string{"--require-admin=foo"},
+			expErr: true,
+		},
+		"invalid max funds": {
+			args:   []string{"--max-instantiate-funds=foo"},
+			expErr: true,
+		},
+		"clear": {
+			args: []string{"--clear-instantiate-constraints"},
+			exp:  &types.InstantiateConstraints{},
+		},
+		"clear and no admin": {
+			args:   []string{"--clear-instantiate-constraints", "--require-no-admin"},
+			expErr: true,
+		},
+		"not set": {
+			args: []string{},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			flags := UpdateInstantiateConfigCmd().Flags()
+			require.NoError(t, flags.Parse(spec.args))
+			got, gotErr := parseInstantiateConstraintFlags(flags)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestParseMessageFieldRuleFlags(t *testing.T) {
+	specs := map[string]struct {
+		args   []string
+		exp    []types.MessageFieldRule
+		expErr bool
+	}{
+		"equals": {
+			args: []string{`--allow-msg-field-equals=/transfer/recipient="foo"`},
+			exp:  []types.MessageFieldRule{{Path: "/transfer/recipient", Equals: []byte(`"foo"`)}},
+		},
+		"in": {
+			args: []string{`--allow-msg-field-in=/transfer/recipient=["foo", {"a":1}]`},
+			exp:  []types.MessageFieldRule{{Path: "/transfer/recipient", In: []types.RawContractMessage{[]byte(`"foo"`), []byte(`{"a":1}`)}}},
+		},
+		"lte": {
+			args: []string{`--allow-msg-field-lte=/transfer/amount=100`},
+			exp:  []types.MessageFieldRule{{Path: "/transfer/amount", LTE: "100"}},
+		},
+		"multiple": {
+			args: []string{`--allow-msg-field-equals=/a="x,y"`, `--allow-msg-field-equals=/b=1`, `--allow-msg-field-lte=/c=2`},
+			exp: []types.MessageFieldRule{
+				{Path: "/a", Equals: []byte(`"x,y"`)},
+				{Path: "/b", Equals: []byte(`1`)},
+				{Path: "/c", LTE: "2"},
+			},
+		},
+		"without value": {
+			args:   []string{`--allow-msg-field-equals=/transfer`},
+			expErr: true,
+		},
+		"invalid json": {
+			args:   []string{`--allow-msg-field-equals=/transfer=foo`},
+			expErr: true,
+		},
+		"in without array": {
+			args:   []string{`--allow-msg-field-in=/transfer="foo"`},
+			expErr: true,
+		},
+		"invalid number": {
+			args:   []string{`--allow-msg-field-lte=/transfer=foo`},
+			expErr: true,
+		},
+		"invalid path": {
+			args:   []string{`--allow-msg-field-lte=transfer=1`},
+			expErr: true,
+		},
+		"not set": {
+			args: []string{},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			flags := GrantAuthorizationCmd().Flags()
+			require.NoError(t, flags.Parse(spec.args))
+			got, gotErr := parseMessageFieldRuleFlags(flags)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestParseWindowLimitFlags(t *testing.T) {
+	specs := map[string]struct {
+		args   []string
+		exp    *types.WindowLimit
+		expErr bool
+	}{
+		"fixed window": {
+			args: []string{"--window=24h", "--window-max-calls=10"},
+			exp:  types.NewWindowLimit(24*time.Hour, false, 10),
+		},
+		"rolling window with funds": {
+			args: []string{"--window=1h", "--rolling-window", "--window-max-calls=1", "--window-max-funds=1denom"},
+			exp:  types.NewWindowLimit(time.Hour, true, 1, sdk.NewInt64Coin("denom", 1)),
+		},
+		"without max calls": {
+			args:   []string{"--window=1h"},
+			expErr: true,
+		},
+		"without window": {
+			args:   []string{"--window-max-calls=1"},
+			expErr: true,
+		},
+		"invalid max funds": {
+			args:   []string{"--window=1h", "--window-max-calls=1", "--window-max-funds=foo"},
+			expErr: true,
+		},
+		"not set": {
+			args: []string{},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			flags := GrantAuthorizationCmd().Flags()
+			require.NoError(t, flags.Parse(spec.args))
+			got, gotErr := parseWindowLimitFlags(flags)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestNewContractGrant(t *testing.T) {
+	myContract := "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"
+	myCodeHash := "13a1fc994cc6d1c81b746ee0c0ff6f90043875e0bf1d9be6b7d779fc978dc2a5"
+	myCodeHashBz, err := hex.DecodeString(myCodeHash)
+	require.NoError(t, err)
+	specs := map[string]struct {
+		target string
+		exp    types.ContractGrant
+		expErr bool
+	}{
+		"contract": {
+			target: myContract,
+			exp:    types.ContractGrant{Contract: myContract},
+		},
+		"code id": {
+			target: "code-id:1",
+			exp:    types.ContractGrant{CodeID: 1},
+		},
+		"code hash": {
+			target: "code-hash:" + myCodeHash,
+			exp:    types.ContractGrant{CodeHash: myCodeHashBz},
+		},
+		"invalid code id": {
+			target: "code-id:foo",
+			expErr: true,
+		},
+		"invalid code hash": {
+			target: "code-hash:foo",
+			expErr: true,
+		},
+		"invalid contract": {
+			target: "foo",
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := newContractGrant(spec.target, types.NewMaxCallsLimit(1), types.NewAllowAllMessagesFilter())
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp.Contract, got.Contract)
+			assert.Equal(t, spec.exp.CodeID, got.CodeID)
+			assert.Equal(t, spec.exp.CodeHash, got.CodeHash)
+		})
+	}
+}
+
+func TestParseAllowNewAdminsFlag(t *testing.T) {
+	myAdmin := "cosmos1vx8knpllrj7n963p9ttd80w47kpacrhuts497x"
+	otherAdmin := "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"
+	specs := map[string]struct {
+		args   []string
+		exp    []string
+		expErr bool
+	}{
+		"one admin": {
+			args: []string{"--allow-new-admins=" + myAdmin},
+			exp:  []string{myAdmin},
+		},
+		"multiple admins": {
+			args: []string{"--allow-new-admins=" + myAdmin + "," + otherAdmin},
+			exp:  []string{myAdmin, otherAdmin},
+		},
+		"invalid admin": {
+			args:   []string{"--allow-new-admins=foo"},
+			expErr: true,
+		},
+		"not set": {
+			args: []string{},
+			exp:  []string{},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			flags := GrantAuthorizationCmd().Flags()
+			require.NoError(t, flags.Parse(spec.args))
+			got, gotErr := parseAllowNewAdminsFlag(flags)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package ioutils
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// wasm section id of the exports
+	// See https://webassembly.github.io/spec/core/binary/modules.html#export-section
+	wasmExportSectionID = 7
+	// wasm export description of a function
+	wasmExportKindFunc = 0
+)
+
+// WasmFunctionExports returns the names of the functions exported by the wasm binary in the order of the
+// export section. Only the module header and the section layout are validated.
+func WasmFunctionExports(wasmCode []byte) ([]string, error) {
+	if len(wasmCode) < 8 || !IsWasm(wasmCode) {
+		return nil, errors.New("not a wasm binary")
+	}
+	r := wasmReader{bz: wasmCode, pos: 8} // skip magic number and version
+	for !r.done() {
+		id, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		section, err := r.bytes()
+		if err != nil {
+			return nil, fmt.Errorf("section %d: %w", id, err)
+		}
+		if id == wasmExportSectionID {
+			return parseFunctionExports(section)
+		}
+	}
+	return nil, nil
+}
+
+func parseFunctionExports(section []byte) ([]string, error) {
+	r := wasmReader{bz: section}
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for i := uint32(0); i < n; i++ {
+		name, err := r.bytes()
+		if err != nil {
+			return nil, fmt.Errorf("export name: %w", err)
+		}
+		kind, err := r.byte()
+		if err != nil {
+			return nil, fmt.Errorf("export kind: %w", err)
+		}
+		if _, err := r.u32(); err != nil {
+			return nil, fmt.Errorf("export index: %w", err)
+		}
+		if kind == wasmExportKindFunc {
+			names = append(names, string(name))
+		}
+	}
+	return names, nil
+}
+
+// wasmReader reads the primitives of the wasm binary format
+type wasmReader struct {
+	bz  []byte
+	pos int
+}
+
+func (r *wasmReader) done() bool {
+	return r.pos >= len(r.bz)
+}
+
+func (r *wasmReader) byte() (byte, error) {
+	if r.done() {
+		return 0, errors.New("unexpected end")
+	}
+	b := r.bz[r.pos]
+	r.pos++
+	return b, nil
+}
+
+// u32 reads an unsigned LEB128 encoded integer
+func (r *wasmReader) u32() (uint32, error) {
+	var result uint32
+	for shift := uint(0); shift < 35; shift += 7 {
+		b, err := r.byte()
+		if err != nil {
+			return 0, err
+		}
+		result |= uint32(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return result, nil
+		}
+	}
+	return 0, errors.New("integer too large")
+}
+
+// bytes reads a length prefixed byte vector
+func (r *wasmReader) bytes() ([]byte, error) {
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	if uint64(r.pos)+uint64(n) > uint64(len(r.bz)) {
+		return nil, errors.New("unexpected end")
+	}
+	bz := r.bz[r.pos : r.pos+int(n)]
+	r.pos += int(n)
+	return bz, nil
+}
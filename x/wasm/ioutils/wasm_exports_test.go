@@ -0,0 +1,73 @@
+package ioutils
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWasmFunctionExports(t *testing.T) {
+	hackatom, err := os.ReadFile("../keeper/testdata/hackatom.wasm")
+	require.NoError(t, err)
+	ibcReflect, err := os.ReadFile("../keeper/testdata/ibc_reflect.wasm")
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		src         []byte
+		expContains []string
+		expNot      []string
+		expErr      bool
+	}{
+		"hackatom": {
+			src:         hackatom,
+			expContains: []string{"instantiate", "execute", "migrate", "sudo", "query", "allocate"},
+			expNot:      []string{"ibc_channel_open", "memory"},
+		},
+		"ibc reflect": {
+			src:         ibcReflect,
+			expContains: []string{"instantiate", "reply", "ibc_channel_open", "ibc_packet_receive"},
+		},
+		"header only": {
+			src: []byte("\x00asm\x01\x00\x00\x00"),
+		},
+		"export section only": {
+			// one export "a" of kind func with index 0 and one of kind memory
+			src:         []byte("\x00asm\x01\x00\x00\x00\x07\x09\x02\x01a\x00\x00\x01b\x02\x00"),
+			expContains: []string{"a"},
+			expNot:      []string{"b"},
+		},
+		"truncated section": {
+			src:    []byte("\x00asm\x01\x00\x00\x00\x07\x09\x02"),
+			expErr: true,
+		},
+		"truncated export": {
+			src:    []byte("\x00asm\x01\x00\x00\x00\x07\x03\x02\x01a"),
+			expErr: true,
+		},
+		"not wasm": {
+			src:    []byte("hello world"),
+			expErr: true,
+		},
+		"empty": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := WasmFunctionExports(spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			for _, v := range spec.expContains {
+				assert.Contains(t, got, v)
+			}
+			for _, v := range spec.expNot {
+				assert.NotContains(t, got, v)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestCreateWithExpiringUploadAccess(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	partner, other := RandomAccountAddress(t), RandomAccountAddress(t)
+	expiryHeight := uint64(ctx.BlockHeight() + 1)
+	params := types.DefaultParams()
+	params.CodeUploadAccess = types.AccessConfig{
+		Permission: types.AccessTypeAnyOfAddresses,
+		Addresses:  []string{partner.String(), other.String()},
+		Expiries:   []types.AccessExpiry{{Address: partner.String(), Height: expiryHeight}},
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+	contractKeeper := NewDefaultPermissionKeeper(k)
+
+	// before expiry
+	_, _, err := contractKeeper.Create(ctx, partner, testdata.HackatomContractWasm(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, params.CodeUploadAccess, k.GetParams(ctx).CodeUploadAccess)
+
+	// when expired
+	ctx = ctx.WithBlockHeight(int64(expiryHeight))
+	_, _, err = contractKeeper.Create(ctx, partner, testdata.HackatomContractWasm(), nil)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// and the params are not modified by an upload
+	_, _, err = contractKeeper.Create(ctx, other, testdata.HackatomContractWasm(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, params.CodeUploadAccess, k.GetParams(ctx).CodeUploadAccess)
+
+	// then the expired address is removed at the end of the block
+	require.NoError(t, k.PruneExpiredUploadAccess(ctx))
+	exp := types.AccessConfig{Permission: types.AccessTypeAnyOfAddresses, Addresses: []string{other.String()}}
+	assert.Equal(t, exp, k.GetParams(ctx).CodeUploadAccess)
+}
+
+func TestInstantiateWithExpiringAccess(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 1_000_000))
+	partner := RandomAccountAddress(t)
+	expiryTime := ctx.BlockTime().Add(1)
+	instantiateConfig := types.AccessConfig{
+		Permission: types.AccessTypeAnyOfAddresses,
+		Addresses:  []string{creator.String(), partner.String()},
+		Expiries:   []types.AccessExpiry{{Address: partner.String(), Time: &expiryTime}},
+	}
+	contractKeeper := NewDefaultPermissionKeeper(k)
+	codeID, _, err := contractKeeper.Create(ctx, creator, testdata.ReflectContractWasm(), &instantiateConfig)
+	require.NoError(t, err)
+
+	// before expiry
+	_, _, err = contractKeeper.Instantiate(ctx, codeID, partner, nil, []byte("{}"), "before", nil)
+	require.NoError(t, err)
+	assert.Equal(t, instantiateConfig, k.GetCodeInfo(ctx, codeID).InstantiateConfig)
+
+	// when expired
+	ctx = ctx.WithBlockTime(expiryTime)
+	_, _, err = contractKeeper.Instantiate(ctx, codeID, partner, nil, []byte("{}"), "expired", nil)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// then the expired address is removed with the next instantiation
+	_, _, err = contractKeeper.Instantiate(ctx, codeID, creator, nil, []byte("{}"), "after", nil)
+	require.NoError(t, err)
+	exp := types.AccessConfig{Permission: types.AccessTypeAnyOfAddresses, Addresses: []string{creator.String()}}
+	assert.Equal(t, exp, k.GetCodeInfo(ctx, codeID).InstantiateConfig)
+}
@@ -95,3 +95,23 @@ func (d LimitSimulationGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 	}
 	return next(ctx, tx, simulate)
 }
+
+// ContractCodeLookupDecorator ante decorator that provides the contract code details to authz grants that
+// target contracts by code id or code hash.
+type ContractCodeLookupDecorator struct {
+	lookup types.ContractCodeLookup
+}
+
+// NewContractCodeLookupDecorator constructor
+func NewContractCodeLookupDecorator(lookup types.ContractCodeLookup) *ContractCodeLookupDecorator {
+	if lookup == nil {
+		panic("lookup must not be nil")
+	}
+	return &ContractCodeLookupDecorator{lookup: lookup}
+}
+
+// AnteHandle stores the contract code lookup in the context. See `types.ContractCodeLookupFromContext(ctx)` to read
+// the value.
+func (d ContractCodeLookupDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return next(types.WithContractCodeLookup(ctx, d.lookup), tx, simulate)
+}
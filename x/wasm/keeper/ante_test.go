@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -193,3 +197,109 @@ func consumeGasAnteHandler(gasToConsume sdk.Gas) sdk.AnteHandler {
 		return ctx, nil
 	}
 }
+
+func TestGasTraceDecorator(t *testing.T) {
+	specs := map[string]struct {
+		tracing    bool
+		traceFile  bool
+		simulation bool
+		checkTx    bool
+		expTracer  bool
+		expEvent   bool
+		expLines   int
+	}{
+		"simulation with tracing": {
+			tracing:    true,
+			simulation: true,
+			expTracer:  true,
+			expEvent:   true,
+		},
+		"simulation without tracing": {
+			traceFile:  true,
+			simulation: true,
+		},
+		"deliver tx with trace file": {
+			tracing:   true,
+			traceFile: true,
+			expTracer: true,
+			expLines:  1,
+		},
+		"deliver tx without trace file": {
+			tracing: true,
+		},
+		"check tx with trace file": {
+			traceFile: true,
+			checkTx:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cfg := types.WasmConfig{GasTracing: spec.tracing}
+			if spec.traceFile {
+				cfg.GasTraceFile = filepath.Join(t.TempDir(), "trace.jsonl")
+			}
+			ante, err := keeper.NewGasTraceDecorator(cfg)
+			require.NoError(t, err)
+			em := sdk.NewEventManager()
+			ctx := sdk.Context{}.
+				WithContext(context.Background()).
+				WithGasMeter(sdk.NewInfiniteGasMeter()).
+				WithEventManager(em).
+				WithIsCheckTx(spec.checkTx).
+				WithTxBytes([]byte("my tx")).
+				WithLogger(log.TestingLogger())
+
+			// when
+			var gotTracer bool
+			_, err = ante.AnteHandle(ctx, nil, spec.simulation, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				var tracer *types.GasTracer
+				tracer, gotTracer = types.GasTracerFromContext(ctx)
+				if gotTracer {
+					tracer.Start(ctx, "testing", nil).End()
+				}
+				return ctx, nil
+			})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, spec.expTracer, gotTracer)
+			if spec.expEvent {
+				require.Len(t, em.Events(), 1)
+				assert.Equal(t, types.EventTypeGasTrace, em.Events()[0].Type)
+				assert.JSONEq(t, `{"name":"testing","gas_used":0}`, em.Events()[0].Attributes[0].Value)
+			} else {
+				assert.Empty(t, em.Events())
+			}
+			if !spec.traceFile {
+				return
+			}
+			bz, err := os.ReadFile(cfg.GasTraceFile)
+			require.NoError(t, err)
+			lines := strings.Split(strings.TrimSpace(string(bz)), "\n")
+			if spec.expLines == 0 {
+				assert.Empty(t, strings.TrimSpace(string(bz)))
+				return
+			}
+			require.Len(t, lines, spec.expLines)
+			assert.JSONEq(t, `{"height":0,"tx_hash":"F194506A9A6D8D596CB399CD3DD9AD6338CB133E154E18B3EA6402C35E5ABA25","trace":{"name":"testing","gas_used":0}}`, lines[0])
+		})
+	}
+}
+
+func TestContractCodeLookupDecorator(t *testing.T) {
+	ctx, keepers := keeper.CreateTestInput(t, false, keeper.AvailableCapabilities)
+	ante := keeper.NewContractCodeLookupDecorator(keepers.WasmKeeper)
+
+	// when
+	var gotLookup types.ContractCodeLookup
+	_, err := ante.AnteHandle(ctx, nil, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		gotLookup, _ = types.ContractCodeLookupFromContext(ctx)
+		return ctx, nil
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, keepers.WasmKeeper, gotLookup)
+	_, found := types.ContractCodeLookupFromContext(ctx)
+	assert.False(t, found)
+}
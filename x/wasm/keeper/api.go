@@ -17,28 +17,41 @@ const (
 	DefaultDeserializationCostPerByte = 1
 )
 
-var (
-	costHumanize            = DefaultGasCostHumanAddress * DefaultGasMultiplier
-	costCanonical           = DefaultGasCostCanonicalAddress * DefaultGasMultiplier
-	costJSONDeserialization = wasmvmtypes.UFraction{
-		Numerator:   DefaultDeserializationCostPerByte * DefaultGasMultiplier,
-		Denominator: 1,
-	}
-)
-
-func humanAddress(canon []byte) (string, uint64, error) {
-	if err := sdk.VerifyAddressFormat(canon); err != nil {
-		return "", costHumanize, err
-	}
-	return sdk.AccAddress(canon).String(), costHumanize, nil
+// apiCosts are custom costs for the address conversions in cosmwasm gas. See WithAPICosts
+type apiCosts struct {
+	human, canonical uint64
 }
 
-func canonicalAddress(human string) ([]byte, uint64, error) {
-	bz, err := sdk.AccAddressFromBech32(human)
-	return bz, costCanonical, err
+// wasmVMAPI returns the api for the contract calls. The address conversion costs are converted from SDK gas
+// with the current gas multiplier unless custom costs were set.
+func (k Keeper) wasmVMAPI(ctx sdk.Context) wasmvm.GoAPI {
+	var costHumanize, costCanonical uint64
+	if k.apiCosts != nil {
+		costHumanize, costCanonical = k.apiCosts.human, k.apiCosts.canonical
+	} else {
+		gasRegister := k.loadGasRegister(ctx)
+		costHumanize = gasRegister.ToWasmVMGas(DefaultGasCostHumanAddress)
+		costCanonical = gasRegister.ToWasmVMGas(DefaultGasCostCanonicalAddress)
+	}
+	return wasmvm.GoAPI{
+		HumanAddress: func(canon []byte) (string, uint64, error) {
+			if err := sdk.VerifyAddressFormat(canon); err != nil {
+				return "", costHumanize, err
+			}
+			return sdk.AccAddress(canon).String(), costHumanize, nil
+		},
+		CanonicalAddress: func(human string) ([]byte, uint64, error) {
+			bz, err := sdk.AccAddressFromBech32(human)
+			return bz, costCanonical, err
+		},
+	}
 }
 
-var cosmwasmAPI = wasmvm.GoAPI{
-	HumanAddress:     humanAddress,
-	CanonicalAddress: canonicalAddress,
+// deserializationCosts returns the json deserialization costs per byte in cosmwasm gas for the current gas
+// multiplier
+func (k Keeper) deserializationCosts(ctx sdk.Context) wasmvmtypes.UFraction {
+	return wasmvmtypes.UFraction{
+		Numerator:   k.loadGasRegister(ctx).ToWasmVMGas(DefaultDeserializationCostPerByte),
+		Denominator: 1,
+	}
 }
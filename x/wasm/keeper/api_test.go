@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestWasmVMAPICostsFromParams(t *testing.T) {
+	specs := map[string]struct {
+		srcOpts      []Option
+		multiplier   uint64
+		expHuman     uint64
+		expCanonical uint64
+		expDeser     uint64
+	}{
+		"default multiplier": {
+			multiplier:   types.DefaultGasMultiplier,
+			expHuman:     DefaultGasCostHumanAddress * types.DefaultGasMultiplier,
+			expCanonical: DefaultGasCostCanonicalAddress * types.DefaultGasMultiplier,
+			expDeser:     DefaultDeserializationCostPerByte * types.DefaultGasMultiplier,
+		},
+		"custom multiplier": {
+			multiplier:   10,
+			expHuman:     DefaultGasCostHumanAddress * 10,
+			expCanonical: DefaultGasCostCanonicalAddress * 10,
+			expDeser:     DefaultDeserializationCostPerByte * 10,
+		},
+		"custom api costs": {
+			srcOpts:      []Option{WithAPICosts(1, 2)},
+			multiplier:   10,
+			expHuman:     1,
+			expCanonical: 2,
+			expDeser:     DefaultDeserializationCostPerByte * 10,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, spec.srcOpts...)
+			k := keepers.WasmKeeper
+			params := k.GetParams(ctx)
+			gasRegister := types.DefaultGasRegisterParams()
+			gasRegister.GasMultiplier = spec.multiplier
+			params.GasRegister = &gasRegister
+			require.NoError(t, k.SetParams(ctx, params))
+			addr := RandomAccountAddress(t)
+
+			// when
+			api := k.wasmVMAPI(ctx)
+			_, gotHuman, err := api.HumanAddress(addr)
+			require.NoError(t, err)
+			_, gotCanonical, err := api.CanonicalAddress(addr.String())
+			require.NoError(t, err)
+
+			// then
+			assert.Equal(t, spec.expHuman, gotHuman)
+			assert.Equal(t, spec.expCanonical, gotCanonical)
+			assert.Equal(t, wasmvmtypes.UFraction{Numerator: spec.expDeser, Denominator: 1}, k.deserializationCosts(ctx))
+		})
+	}
+}
@@ -10,13 +10,13 @@ var _ types.AuthorizationPolicy = DefaultAuthorizationPolicy{}
 
 type DefaultAuthorizationPolicy struct{}
 
-func (p DefaultAuthorizationPolicy) CanCreateCode(chainConfigs types.ChainAccessConfigs, actor sdk.AccAddress, contractConfig types.AccessConfig) bool {
-	return chainConfigs.Upload.Allowed(actor) &&
-		contractConfig.IsSubset(chainConfigs.Instantiate)
+func (p DefaultAuthorizationPolicy) CanCreateCode(ctx sdk.Context, chainConfigs types.ChainAccessConfigs, actor sdk.AccAddress, contractConfig types.AccessConfig) bool {
+	return chainConfigs.Upload.Allowed(ctx, actor) &&
+		contractConfig.IsSubset(ctx, chainConfigs.Instantiate)
 }
 
-func (p DefaultAuthorizationPolicy) CanInstantiateContract(config types.AccessConfig, actor sdk.AccAddress) bool {
-	return config.Allowed(actor)
+func (p DefaultAuthorizationPolicy) CanInstantiateContract(ctx sdk.Context, config types.AccessConfig, actor sdk.AccAddress) bool {
+	return config.Allowed(ctx, actor)
 }
 
 func (p DefaultAuthorizationPolicy) CanModifyContract(admin, actor sdk.AccAddress) bool {
@@ -27,6 +27,22 @@ func (p DefaultAuthorizationPolicy) CanModifyCodeAccessConfig(creator, actor sdk
 	return creator != nil && creator.Equals(actor) && isSubset
 }
 
+// IsFeeExempt implements AuthorizationPolicy.IsFeeExempt. Fees are always charged.
+func (p DefaultAuthorizationPolicy) IsFeeExempt() bool {
+	return false
+}
+
+// IsUploadQuotaExempt implements AuthorizationPolicy.IsUploadQuotaExempt. Only the exempt addresses of the quota
+// are not limited.
+func (p DefaultAuthorizationPolicy) IsUploadQuotaExempt(quota types.UploadQuotaParams, actor sdk.AccAddress) bool {
+	return quota.IsExempt(actor)
+}
+
+// MaxWasmSize implements AuthorizationPolicy.MaxWasmSize. Returns the max wasm size.
+func (p DefaultAuthorizationPolicy) MaxWasmSize(limits types.SizeLimitParams) uint64 {
+	return limits.MaxWasmSize
+}
+
 // SubMessageAuthorizationPolicy always returns the default policy
 func (p DefaultAuthorizationPolicy) SubMessageAuthorizationPolicy(_ types.AuthorizationPolicyAction) types.AuthorizationPolicy {
 	return p
@@ -55,11 +71,11 @@ func newGovAuthorizationPolicy(propagate map[types.AuthorizationPolicyAction]str
 }
 
 // CanCreateCode implements AuthorizationPolicy.CanCreateCode to allow gov actions. Always returns true.
-func (p GovAuthorizationPolicy) CanCreateCode(types.ChainAccessConfigs, sdk.AccAddress, types.AccessConfig) bool {
+func (p GovAuthorizationPolicy) CanCreateCode(sdk.Context, types.ChainAccessConfigs, sdk.AccAddress, types.AccessConfig) bool {
 	return true
 }
 
-func (p GovAuthorizationPolicy) CanInstantiateContract(types.AccessConfig, sdk.AccAddress) bool {
+func (p GovAuthorizationPolicy) CanInstantiateContract(sdk.Context, types.AccessConfig, sdk.AccAddress) bool {
 	return true
 }
 
@@ -71,6 +87,21 @@ func (p GovAuthorizationPolicy) CanModifyCodeAccessConfig(sdk.AccAddress, sdk.Ac
 	return true
 }
 
+// IsFeeExempt implements AuthorizationPolicy.IsFeeExempt to exempt gov actions. Always returns true.
+func (p GovAuthorizationPolicy) IsFeeExempt() bool {
+	return true
+}
+
+// IsUploadQuotaExempt implements AuthorizationPolicy.IsUploadQuotaExempt to exempt gov actions. Always returns true.
+func (p GovAuthorizationPolicy) IsUploadQuotaExempt(types.UploadQuotaParams, sdk.AccAddress) bool {
+	return true
+}
+
+// MaxWasmSize implements AuthorizationPolicy.MaxWasmSize. Returns the max proposal wasm size.
+func (p GovAuthorizationPolicy) MaxWasmSize(limits types.SizeLimitParams) uint64 {
+	return limits.MaxProposalWasmSize
+}
+
 // SubMessageAuthorizationPolicy returns new policy with fine-grained gov permission for given action only
 func (p GovAuthorizationPolicy) SubMessageAuthorizationPolicy(action types.AuthorizationPolicyAction) types.AuthorizationPolicy {
 	defaultPolicy := DefaultAuthorizationPolicy{}
@@ -96,15 +127,15 @@ func NewPartialGovAuthorizationPolicy(defaultPolicy types.AuthorizationPolicy, e
 	return PartialGovAuthorizationPolicy{action: entrypoint, defaultPolicy: defaultPolicy}
 }
 
-func (p PartialGovAuthorizationPolicy) CanCreateCode(chainConfigs types.ChainAccessConfigs, actor sdk.AccAddress, contractConfig types.AccessConfig) bool {
-	return p.defaultPolicy.CanCreateCode(chainConfigs, actor, contractConfig)
+func (p PartialGovAuthorizationPolicy) CanCreateCode(ctx sdk.Context, chainConfigs types.ChainAccessConfigs, actor sdk.AccAddress, contractConfig types.AccessConfig) bool {
+	return p.defaultPolicy.CanCreateCode(ctx, chainConfigs, actor, contractConfig)
 }
 
-func (p PartialGovAuthorizationPolicy) CanInstantiateContract(c types.AccessConfig, actor sdk.AccAddress) bool {
+func (p PartialGovAuthorizationPolicy) CanInstantiateContract(ctx sdk.Context, c types.AccessConfig, actor sdk.AccAddress) bool {
 	if p.action == types.AuthZActionInstantiate {
 		return true
 	}
-	return p.defaultPolicy.CanInstantiateContract(c, actor)
+	return p.defaultPolicy.CanInstantiateContract(ctx, c, actor)
 }
 
 func (p PartialGovAuthorizationPolicy) CanModifyContract(admin, actor sdk.AccAddress) bool {
@@ -118,6 +149,21 @@ func (p PartialGovAuthorizationPolicy) CanModifyCodeAccessConfig(creator, actor
 	return p.defaultPolicy.CanModifyCodeAccessConfig(creator, actor, isSubset)
 }
 
+// IsFeeExempt delegates to the default policy as the fees are charged to the contract
+func (p PartialGovAuthorizationPolicy) IsFeeExempt() bool {
+	return p.defaultPolicy.IsFeeExempt()
+}
+
+// IsUploadQuotaExempt delegates to the default policy as there is no fine-grained gov permission for uploads
+func (p PartialGovAuthorizationPolicy) IsUploadQuotaExempt(quota types.UploadQuotaParams, actor sdk.AccAddress) bool {
+	return p.defaultPolicy.IsUploadQuotaExempt(quota, actor)
+}
+
+// MaxWasmSize delegates to the default policy as there is no fine-grained gov permission for uploads
+func (p PartialGovAuthorizationPolicy) MaxWasmSize(limits types.SizeLimitParams) uint64 {
+	return p.defaultPolicy.MaxWasmSize(limits)
+}
+
 // SubMessageAuthorizationPolicy always returns self
 func (p PartialGovAuthorizationPolicy) SubMessageAuthorizationPolicy(_ types.AuthorizationPolicyAction) types.AuthorizationPolicy {
 	return p
@@ -60,12 +60,12 @@ func TestDefaultAuthzPolicyCanCreateCode(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			policy := DefaultAuthorizationPolicy{}
 			if !spec.panics {
-				got := policy.CanCreateCode(spec.chainConfigs, myActorAddress, spec.contractInstConf)
+				got := policy.CanCreateCode(sdk.Context{}, spec.chainConfigs, myActorAddress, spec.contractInstConf)
 				assert.Equal(t, spec.exp, got)
 				return
 			}
 			assert.Panics(t, func() {
-				policy.CanCreateCode(spec.chainConfigs, myActorAddress, spec.contractInstConf)
+				policy.CanCreateCode(sdk.Context{}, spec.chainConfigs, myActorAddress, spec.contractInstConf)
 			})
 		})
 	}
@@ -105,12 +105,12 @@ func TestDefaultAuthzPolicyCanInstantiateContract(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			policy := DefaultAuthorizationPolicy{}
 			if !spec.panics {
-				got := policy.CanInstantiateContract(spec.config, myActorAddress)
+				got := policy.CanInstantiateContract(sdk.Context{}, spec.config, myActorAddress)
 				assert.Equal(t, spec.exp, got)
 				return
 			}
 			assert.Panics(t, func() {
-				policy.CanInstantiateContract(spec.config, myActorAddress)
+				policy.CanInstantiateContract(sdk.Context{}, spec.config, myActorAddress)
 			})
 		})
 	}
@@ -189,6 +189,33 @@ func TestDefaultAuthzPolicySubMessageAuthorizationPolicy(t *testing.T) {
 	}
 }
 
+func TestAuthzPolicyIsFeeExempt(t *testing.T) {
+	assert.False(t, DefaultAuthorizationPolicy{}.IsFeeExempt())
+	assert.True(t, newGovAuthorizationPolicy(nil).IsFeeExempt())
+	assert.False(t, NewPartialGovAuthorizationPolicy(DefaultAuthorizationPolicy{}, types.AuthZActionInstantiate).IsFeeExempt())
+}
+
+func TestAuthzPolicyIsUploadQuotaExempt(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+	quota := types.UploadQuotaParams{MaxCodes: 1, ExemptAddresses: []string{myActorAddress.String()}}
+
+	assert.True(t, DefaultAuthorizationPolicy{}.IsUploadQuotaExempt(quota, myActorAddress))
+	assert.False(t, DefaultAuthorizationPolicy{}.IsUploadQuotaExempt(quota, otherAddress))
+	assert.True(t, newGovAuthorizationPolicy(nil).IsUploadQuotaExempt(quota, otherAddress))
+	partialGov := NewPartialGovAuthorizationPolicy(DefaultAuthorizationPolicy{}, types.AuthZActionInstantiate)
+	assert.True(t, partialGov.IsUploadQuotaExempt(quota, myActorAddress))
+	assert.False(t, partialGov.IsUploadQuotaExempt(quota, otherAddress))
+}
+
+func TestAuthzPolicyMaxWasmSize(t *testing.T) {
+	limits := types.SizeLimitParams{MaxWasmSize: 1, MaxProposalWasmSize: 2}
+
+	assert.Equal(t, uint64(1), DefaultAuthorizationPolicy{}.MaxWasmSize(limits))
+	assert.Equal(t, uint64(2), newGovAuthorizationPolicy(nil).MaxWasmSize(limits))
+	assert.Equal(t, uint64(1), NewPartialGovAuthorizationPolicy(DefaultAuthorizationPolicy{}, types.AuthZActionInstantiate).MaxWasmSize(limits))
+}
+
 func TestGovAuthzPolicyCanCreateCode(t *testing.T) {
 	myActorAddress := RandomAccountAddress(t)
 	otherAddress := RandomAccountAddress(t)
@@ -229,7 +256,7 @@ func TestGovAuthzPolicyCanCreateCode(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			policy := GovAuthorizationPolicy{}
-			got := policy.CanCreateCode(spec.chainConfigs, myActorAddress, spec.contractInstConf)
+			got := policy.CanCreateCode(sdk.Context{}, spec.chainConfigs, myActorAddress, spec.contractInstConf)
 			assert.True(t, got)
 		})
 	}
@@ -261,7 +288,7 @@ func TestGovAuthzPolicyCanInstantiateContract(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			policy := GovAuthorizationPolicy{}
-			got := policy.CanInstantiateContract(spec.config, myActorAddress)
+			got := policy.CanInstantiateContract(sdk.Context{}, spec.config, myActorAddress)
 			assert.True(t, got)
 		})
 	}
@@ -373,7 +400,7 @@ func TestPartialGovAuthorizationPolicyCanInstantiateContract(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			policy := NewPartialGovAuthorizationPolicy(AlwaysRejectTestAuthZPolicy{}, spec.allowedAction)
-			got := policy.CanInstantiateContract(types.AccessConfig{}, nil)
+			got := policy.CanInstantiateContract(sdk.Context{}, types.AccessConfig{}, nil)
 			assert.Equal(t, spec.exp, got)
 		})
 	}
@@ -409,8 +436,8 @@ func TestPartialGovAuthorizationPolicyDelegatedOnly(t *testing.T) {
 	for _, v := range []types.AuthorizationPolicy{AlwaysRejectTestAuthZPolicy{}, NewGovAuthorizationPolicy()} {
 		policy := NewPartialGovAuthorizationPolicy(v, types.AuthZActionInstantiate)
 
-		got := policy.CanCreateCode(types.ChainAccessConfigs{}, nil, types.AccessConfig{})
-		exp := v.CanCreateCode(types.ChainAccessConfigs{}, nil, types.AccessConfig{})
+		got := policy.CanCreateCode(sdk.Context{}, types.ChainAccessConfigs{}, nil, types.AccessConfig{})
+		exp := v.CanCreateCode(sdk.Context{}, types.ChainAccessConfigs{}, nil, types.AccessConfig{})
 		assert.Equal(t, exp, got)
 
 		got = policy.CanModifyCodeAccessConfig(nil, nil, false)
@@ -431,11 +458,11 @@ var _ types.AuthorizationPolicy = AlwaysRejectTestAuthZPolicy{}
 
 type AlwaysRejectTestAuthZPolicy struct{}
 
-func (a AlwaysRejectTestAuthZPolicy) CanCreateCode(chainConfigs types.ChainAccessConfigs, actor sdk.AccAddress, contractConfig types.AccessConfig) bool {
+func (a AlwaysRejectTestAuthZPolicy) CanCreateCode(ctx sdk.Context, chainConfigs types.ChainAccessConfigs, actor sdk.AccAddress, contractConfig types.AccessConfig) bool {
 	return false
 }
 
-func (a AlwaysRejectTestAuthZPolicy) CanInstantiateContract(c types.AccessConfig, actor sdk.AccAddress) bool {
+func (a AlwaysRejectTestAuthZPolicy) CanInstantiateContract(ctx sdk.Context, c types.AccessConfig, actor sdk.AccAddress) bool {
 	return false
 }
 
@@ -447,6 +474,18 @@ func (a AlwaysRejectTestAuthZPolicy) CanModifyCodeAccessConfig(creator, actor sd
 	return false
 }
 
+func (a AlwaysRejectTestAuthZPolicy) IsFeeExempt() bool {
+	return false
+}
+
+func (a AlwaysRejectTestAuthZPolicy) IsUploadQuotaExempt(types.UploadQuotaParams, sdk.AccAddress) bool {
+	return false
+}
+
+func (a AlwaysRejectTestAuthZPolicy) MaxWasmSize(types.SizeLimitParams) uint64 {
+	return 0
+}
+
 func (a AlwaysRejectTestAuthZPolicy) SubMessageAuthorizationPolicy(entrypoint types.AuthorizationPolicyAction) types.AuthorizationPolicy {
 	return a
 }
@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	wasmvm "github.com/CosmWasm/wasmvm"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// StartCacheWarmup compiles all unpinned codes into the wasmvm file system cache with the configured
+// number of workers so that the first contract calls after a restart or state sync do not pay for the
+// compilation. Pinned codes are skipped as they are loaded by InitializePinnedCodes already, same as codes
+// that are in the cache warm-up record. The codes are read from state before this method returns and are
+// compiled in the background, so that the node serves blocks while warming. The number of workers limits
+// the competition with the block execution. The returned channel is closed when the warm-up is completed.
+// Nothing is done when no warm-up workers are configured.
+func (k Keeper) StartCacheWarmup(ctx sdk.Context) <-chan struct{} {
+	done := make(chan struct{})
+	if k.cacheWarmupWorkers == 0 {
+		close(done)
+		return done
+	}
+	seen := make(map[string]struct{})
+	var checksums []wasmvm.Checksum
+	k.IterateCodeInfos(ctx, func(codeID uint64, info types.CodeInfo) bool {
+		if _, exists := seen[string(info.CodeHash)]; exists || k.IsPinnedCode(ctx, codeID) || k.cacheWarmupRecord.Has(info.CodeHash) {
+			return false
+		}
+		seen[string(info.CodeHash)] = struct{}{}
+		checksums = append(checksums, info.CodeHash)
+		return false
+	})
+	logger := k.Logger(ctx)
+	logger.Info("starting wasm cache warm-up", "codes", len(checksums), "workers", k.cacheWarmupWorkers)
+
+	go func() {
+		defer close(done)
+		defer telemetry.MeasureSince(time.Now(), "wasm", "cache", "warmup")
+
+		var processed, failed atomic.Uint64
+		total := uint64(len(checksums))
+		logStep := total / 10
+		if logStep == 0 {
+			logStep = 1
+		}
+		queue := make(chan wasmvm.Checksum)
+		var wg sync.WaitGroup
+		for i := uint32(0); i < k.cacheWarmupWorkers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for checksum := range queue {
+					if k.cacheWarmupRecord.Has(checksum) { // compiled on upload in the meantime
+						telemetry.IncrCounter(1, "wasm", "cache", "warmup", "skipped")
+					} else if err := k.warmupCode(checksum); err != nil {
+						failed.Add(1)
+						telemetry.IncrCounter(1, "wasm", "cache", "warmup", "failed")
+						logger.Error("failed to warm up wasm cache", "checksum", checksum, "error", err)
+					} else {
+						telemetry.IncrCounter(1, "wasm", "cache", "warmup", "compiled")
+					}
+					if n := processed.Add(1); n%logStep == 0 || n == total {
+						telemetry.SetGauge(float32(total-n), "wasm", "cache", "warmup", "pending")
+						logger.Info("wasm cache warm-up progress", "processed", n, "total", total)
+					}
+				}
+			}()
+		}
+		for _, c := range checksums {
+			queue <- c
+		}
+		close(queue)
+		wg.Wait()
+		logger.Info("completed wasm cache warm-up", "codes", total, "failed", failed.Load())
+	}()
+	return done
+}
+
+// warmupCode compiles the stored code into the file system cache. Storing an existing code is
+// idempotent in wasmvm and the compilation is done outside of the vm lock. The code was
+// checked on upload already, same as on import.
+func (k Keeper) warmupCode(checksum wasmvm.Checksum) error {
+	wasmCode, err := k.wasmVM.GetCode(checksum)
+	if err != nil {
+		return err
+	}
+	if _, err = k.wasmVM.StoreCodeUnchecked(wasmCode); err != nil {
+		return err
+	}
+	return k.cacheWarmupRecord.Add(checksum)
+}
+
+// recordCompiledCode adds a code that was compiled on upload or import to the cache warm-up record.
+// Errors are logged only as the record is node local.
+func (k Keeper) recordCompiledCode(ctx sdk.Context, checksum wasmvm.Checksum) {
+	if err := k.cacheWarmupRecord.Add(checksum); err != nil {
+		k.Logger(ctx).Error("failed to update wasm cache warm-up record", "error", err)
+	}
+}
+
+const cacheWarmupRecordPrefix = "cache_warmup_"
+
+// cacheWarmupRecord keeps the checksums of the codes that were compiled into the wasmvm file system
+// cache in a node local file, so that they are not compiled again on the next warm-up. wasmvm does
+// not expose the content of its cache and invalidates it on version changes, so that a record is
+// kept per libwasmvm version. Delete the file to force the compilation of all codes.
+type cacheWarmupRecord struct {
+	mu       sync.Mutex
+	filename string
+	known    map[string]struct{}
+}
+
+// newCacheWarmupRecord loads the record for the libwasmvm version from the directory. Records of
+// other versions are removed.
+func newCacheWarmupRecord(dir, libwasmvmVersion string) (*cacheWarmupRecord, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	filename := filepath.Join(dir, cacheWarmupRecordPrefix+libwasmvmVersion)
+	others, err := filepath.Glob(filepath.Join(dir, cacheWarmupRecordPrefix+"*"))
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range others {
+		if f == filename {
+			continue
+		}
+		if err := os.Remove(f); err != nil {
+			return nil, err
+		}
+	}
+	r := &cacheWarmupRecord{filename: filename, known: make(map[string]struct{})}
+	bz, err := os.ReadFile(filename)
+	switch {
+	case os.IsNotExist(err):
+		return r, nil
+	case err != nil:
+		return nil, err
+	}
+	for _, line := range strings.Fields(string(bz)) {
+		r.known[line] = struct{}{}
+	}
+	return r, nil
+}
+
+// Has returns true when the code is in the record. Safe to call on a nil instance.
+func (r *cacheWarmupRecord) Has(checksum wasmvm.Checksum) bool {
+	if r == nil {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.known[hex.EncodeToString(checksum)]
+	return ok
+}
+
+// Add appends the code to the record. Safe to call on a nil instance.
+func (r *cacheWarmupRecord) Add(checksum wasmvm.Checksum) error {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := hex.EncodeToString(checksum)
+	if _, ok := r.known[key]; ok {
+		return nil
+	}
+	f, err := os.OpenFile(r.filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := f.WriteString(key + "\n"); err != nil {
+		return err
+	}
+	r.known[key] = struct{}{}
+	return nil
+}
@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	wasmvm "github.com/CosmWasm/wasmvm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+)
+
+func TestStartCacheWarmup(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+
+	var (
+		mu       sync.Mutex
+		compiled []wasmvm.Checksum
+	)
+	mock := wasmtesting.MockWasmer{
+		GetCodeFn: func(checksum wasmvm.Checksum) (wasmvm.WasmCode, error) {
+			return wasmvm.WasmCode(checksum), nil
+		},
+		StoreCodeUncheckedFn: func(code wasmvm.WasmCode) (wasmvm.Checksum, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			compiled = append(compiled, wasmvm.Checksum(code))
+			return wasmvm.Checksum(code), nil
+		},
+		PinFn: func(checksum wasmvm.Checksum) error { return nil },
+	}
+	wasmtesting.MakeInstantiable(&mock)
+	myCodes := make([]ExampleContract, 3)
+	for i := range myCodes {
+		myCodes[i] = StoreRandomContract(t, ctx, keepers, &mock)
+	}
+	require.NoError(t, k.pinCode(ctx, myCodes[0].CodeID))
+
+	specs := map[string]struct {
+		workers uint32
+		exp     []wasmvm.Checksum
+	}{
+		"disabled": {},
+		"single worker": {
+			workers: 1,
+			exp:     []wasmvm.Checksum{myCodes[1].Checksum, myCodes[2].Checksum},
+		},
+		"multiple workers": {
+			workers: 4,
+			exp:     []wasmvm.Checksum{myCodes[1].Checksum, myCodes[2].Checksum},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			compiled = nil
+			k.cacheWarmupWorkers = spec.workers
+			// when
+			done := k.StartCacheWarmup(ctx)
+			// then
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatal("warm-up not completed")
+			}
+			assert.ElementsMatch(t, spec.exp, compiled)
+		})
+	}
+}
+
+func TestStartCacheWarmupFailures(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+
+	var calls int
+	mock := wasmtesting.MockWasmer{
+		GetCodeFn: func(checksum wasmvm.Checksum) (wasmvm.WasmCode, error) {
+			calls++
+			return nil, errors.New("testing")
+		},
+	}
+	wasmtesting.MakeInstantiable(&mock)
+	StoreRandomContract(t, ctx, keepers, &mock)
+	StoreRandomContract(t, ctx, keepers, &mock)
+	k.cacheWarmupWorkers = 1
+
+	// when
+	done := k.StartCacheWarmup(ctx)
+	// then all codes were processed
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("warm-up not completed")
+	}
+	assert.Equal(t, 2, calls)
+}
+
+func TestStartCacheWarmupSkipsRecordedCodes(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+
+	var compiled []wasmvm.Checksum
+	mock := wasmtesting.MockWasmer{
+		GetCodeFn: func(checksum wasmvm.Checksum) (wasmvm.WasmCode, error) {
+			return wasmvm.WasmCode(checksum), nil
+		},
+		StoreCodeUncheckedFn: func(code wasmvm.WasmCode) (wasmvm.Checksum, error) {
+			compiled = append(compiled, wasmvm.Checksum(code))
+			return wasmvm.Checksum(code), nil
+		},
+	}
+	wasmtesting.MakeInstantiable(&mock)
+	recordedCode := StoreRandomContract(t, ctx, keepers, &mock)
+	newCode := StoreRandomContract(t, ctx, keepers, &mock)
+
+	record, err := newCacheWarmupRecord(t.TempDir(), "1.0.0")
+	require.NoError(t, err)
+	require.NoError(t, record.Add(recordedCode.Checksum))
+	k.cacheWarmupRecord = record
+	k.cacheWarmupWorkers = 1
+
+	// when
+	<-k.StartCacheWarmup(ctx)
+	// then
+	assert.Equal(t, []wasmvm.Checksum{newCode.Checksum}, compiled)
+	assert.True(t, record.Has(newCode.Checksum))
+
+	// and when started again
+	compiled = nil
+	<-k.StartCacheWarmup(ctx)
+	// then nothing is compiled
+	assert.Empty(t, compiled)
+}
+
+func TestCacheWarmupRecord(t *testing.T) {
+	dir := t.TempDir()
+	myChecksum := wasmvm.Checksum(bytes.Repeat([]byte{1}, 32))
+
+	record, err := newCacheWarmupRecord(dir, "1.0.0")
+	require.NoError(t, err)
+	assert.False(t, record.Has(myChecksum))
+	require.NoError(t, record.Add(myChecksum))
+	require.NoError(t, record.Add(myChecksum))
+	assert.True(t, record.Has(myChecksum))
+
+	// when loaded again
+	record, err = newCacheWarmupRecord(dir, "1.0.0")
+	require.NoError(t, err)
+	// then
+	assert.True(t, record.Has(myChecksum))
+
+	// when loaded with a different version
+	record, err = newCacheWarmupRecord(dir, "1.1.0")
+	require.NoError(t, err)
+	// then
+	assert.False(t, record.Has(myChecksum))
+	files, err := filepath.Glob(filepath.Join(dir, cacheWarmupRecordPrefix+"*"))
+	require.NoError(t, err)
+	assert.Empty(t, files)
+
+	// nil record is a noop
+	var nilRecord *cacheWarmupRecord
+	assert.False(t, nilRecord.Has(myChecksum))
+	require.NoError(t, nilRecord.Add(myChecksum))
+}
+
+func TestStartCacheWarmupWithWasmVM(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	StoreHackatomExampleContract(t, ctx, keepers)
+	StoreHackatomExampleContract(t, ctx, keepers)
+	StoreReflectContract(t, ctx, keepers)
+	k.cacheWarmupWorkers = 2
+
+	select {
+	case <-k.StartCacheWarmup(ctx):
+	case <-time.After(time.Minute):
+		t.Fatal("warm-up not completed")
+	}
+	// the codes are still usable
+	InstantiateHackatomExampleContract(t, ctx, keepers)
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// assertCapabilitiesEnabled returns an error when the code requires a capability that is not enabled
+// by the params. This can happen for codes that were stored before a capability was disabled.
+// The state is read without gas consumption.
+func (k Keeper) assertCapabilitiesEnabled(ctx sdk.Context, codeID uint64) error {
+	bz := ctx.MultiStore().GetKVStore(k.storeKey).Get(types.GetCodeAnalysisKey(codeID))
+	if bz == nil {
+		return nil
+	}
+	var analysis types.CodeAnalysis
+	k.cdc.MustUnmarshal(bz, &analysis)
+	if len(analysis.RequiredCapabilities) == 0 {
+		return nil
+	}
+	if missing := k.enabledCapabilities(k.getParamsNoGas(ctx)).Missing(analysis.RequiredCapabilities); len(missing) != 0 {
+		return types.ErrCapabilityDisabled.Wrapf("code id %d requires: %s", codeID, strings.Join(missing, ","))
+	}
+	return nil
+}
+
+// CheckVMCapabilities returns an error when the params enable a capability that the wasmvm of
+// this node does not support. Nodes must not start in this case as they can not process the
+// contracts that other nodes accept.
+func (k Keeper) CheckVMCapabilities(ctx sdk.Context) error {
+	if missing := k.unsupportedCapabilities(k.GetParams(ctx)); len(missing) != 0 {
+		return fmt.Errorf("capabilities not supported by wasmvm: %s", strings.Join(missing, ","))
+	}
+	return nil
+}
+
+// VMCapabilityParams returns the capabilities that the wasmvm of this node supports as
+// capability params.
+func (k Keeper) VMCapabilityParams() types.CapabilityParams {
+	return types.CapabilityParams{Enabled: append([]string{}, k.vmCapabilities...)}
+}
+
+// enabledCapabilities returns the capabilities enabled by the params. The capabilities of the
+// wasmvm are returned for params without capabilities.
+func (k Keeper) enabledCapabilities(params types.Params) types.CapabilityParams {
+	return params.CapabilitiesOr(k.VMCapabilityParams())
+}
+
+// unsupportedCapabilities returns the capabilities enabled by the params that the wasmvm of this
+// node does not support
+func (k Keeper) unsupportedCapabilities(params types.Params) []string {
+	return k.VMCapabilityParams().Missing(k.enabledCapabilities(params).Enabled)
+}
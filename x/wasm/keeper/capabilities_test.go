@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestCreateWithDisabledCapabilities(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	creator := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		enabled []string
+		expErr  bool
+	}{
+		"all required enabled": {
+			enabled: []string{"iterator", "staking", "stargate", "cosmwasm_1_1"},
+		},
+		"required capability disabled": {
+			enabled: []string{"iterator", "staking", "cosmwasm_1_1"},
+			expErr:  true,
+		},
+		"none enabled": {
+			enabled: []string{},
+			expErr:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			setCapabilities(t, ctx, k, spec.enabled...)
+			// when
+			codeID, _, gotErr := keepers.ContractKeeper.Create(ctx, creator, testdata.ReflectContractWasm(), nil)
+			// then
+			if spec.expErr {
+				require.ErrorIs(t, gotErr, types.ErrCapabilityDisabled)
+				assert.Contains(t, gotErr.Error(), "stargate")
+				assert.Nil(t, k.GetCodeInfo(ctx, 1))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.NotNil(t, k.GetCodeInfo(ctx, codeID))
+		})
+	}
+}
+
+func TestExecuteWithDisabledCapabilities(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateReflectExampleContract(t, ctx, keepers)
+
+	// when the capability is disabled after the code was stored
+	setCapabilities(t, ctx, k, "iterator", "staking", "cosmwasm_1_1")
+
+	// then
+	_, err := keepers.ContractKeeper.Execute(ctx, example.Contract, example.CreatorAddr, []byte(`{}`), nil)
+	require.ErrorIs(t, err, types.ErrCapabilityDisabled)
+	assert.Contains(t, err.Error(), "stargate")
+
+	_, err = k.QuerySmart(ctx, example.Contract, []byte(`{}`))
+	require.ErrorIs(t, err, types.ErrCapabilityDisabled)
+
+	_, _, err = keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, []byte("{}"), "other", nil)
+	require.ErrorIs(t, err, types.ErrCapabilityDisabled)
+
+	// and codes without requirements are not affected
+	InstantiateHackatomExampleContract(t, ctx, keepers)
+}
+
+func TestCheckVMCapabilities(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+
+	specs := map[string]struct {
+		enabled []string
+		expErr  bool
+	}{
+		"subset": {
+			enabled: []string{"iterator", "stargate"},
+		},
+		"all": {
+			enabled: []string{"iterator", "staking", "stargate", "cosmwasm_1_1"},
+		},
+		"none": {
+			enabled: []string{},
+		},
+		"not supported by vm": {
+			enabled: []string{"iterator", "cosmwasm_9_9"},
+			expErr:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			setCapabilities(t, ctx, k, spec.enabled...)
+			gotErr := k.CheckVMCapabilities(ctx)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.Contains(t, gotErr.Error(), "cosmwasm_9_9")
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestUpdateParamsWithUnsupportedCapabilities(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	msgServer := NewMsgServerImpl(k)
+
+	specs := map[string]struct {
+		enabled []string
+		expErr  bool
+	}{
+		"supported by vm": {
+			enabled: []string{"iterator", "stargate"},
+		},
+		"not supported by vm": {
+			enabled: []string{"iterator", "cosmwasm_9_9"},
+			expErr:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			params := types.DefaultParams()
+			params.Capabilities = &types.CapabilityParams{Enabled: spec.enabled}
+
+			// when
+			_, gotErr := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+			// then
+			if spec.expErr {
+				require.ErrorIs(t, gotErr, types.ErrInvalid)
+				assert.Contains(t, gotErr.Error(), "cosmwasm_9_9")
+				assert.NotEqual(t, params.Capabilities, k.GetParams(ctx).Capabilities)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, params.Capabilities, k.GetParams(ctx).Capabilities)
+		})
+	}
+}
+
+func TestInitGenesisSetsVMCapabilities(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+
+	// when
+	_, err := InitGenesis(ctx, k, types.GenesisState{Params: types.DefaultParams()})
+	// then
+	require.NoError(t, err)
+	exp := types.CapabilityParams{Enabled: types.ParseCapabilities(AvailableCapabilities)}
+	assert.Equal(t, &exp, k.GetParams(ctx).Capabilities)
+}
+
+func setCapabilities(t *testing.T, ctx sdk.Context, k *Keeper, enabled ...string) {
+	t.Helper()
+	params := k.GetParams(ctx)
+	params.Capabilities = &types.CapabilityParams{Enabled: enabled}
+	require.NoError(t, k.SetParams(ctx, params))
+}
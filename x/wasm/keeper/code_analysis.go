@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmvm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// contractEntrypoints are the wasm function exports that are called by wasmd
+var contractEntrypoints = map[string]struct{}{
+	entrypointInstantiate:       {},
+	entrypointExecute:           {},
+	entrypointMigrate:           {},
+	entrypointSudo:              {},
+	entrypointReply:             {},
+	entrypointQuery:             {},
+	entrypointIBCChannelOpen:    {},
+	entrypointIBCChannelConnect: {},
+	entrypointIBCChannelClose:   {},
+	entrypointIBCPacketReceive:  {},
+	entrypointIBCPacketAck:      {},
+	entrypointIBCPacketTimeout:  {},
+}
+
+// newCodeAnalysis combines the wasmvm analysis report with the exported contract entry points of the
+// uncompressed wasm code. The code was validated by wasmvm before so that a malformed export section
+// can only come from a mocked vm. No entry points are set in this case.
+func newCodeAnalysis(report *wasmvmtypes.AnalysisReport, wasmCode []byte) *types.CodeAnalysis {
+	exports, _ := ioutils.WasmFunctionExports(wasmCode)
+	r := &types.CodeAnalysis{
+		HasIBCEntryPoints: report.HasIBCEntryPoints,
+		CodeSize:          uint64(len(wasmCode)),
+	}
+	r.RequiredCapabilities = types.ParseCapabilities(report.RequiredCapabilities)
+	sort.Strings(r.RequiredCapabilities)
+	for _, e := range exports {
+		if _, ok := contractEntrypoints[e]; ok {
+			r.Entrypoints = append(r.Entrypoints, e)
+		}
+	}
+	sort.Strings(r.Entrypoints)
+	return r
+}
+
+// GetCodeAnalysis returns the static analysis result for the code or nil when not found
+func (k Keeper) GetCodeAnalysis(ctx sdk.Context, codeID uint64) *types.CodeAnalysis {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCodeAnalysisKey(codeID))
+	if bz == nil {
+		return nil
+	}
+	var r types.CodeAnalysis
+	k.cdc.MustUnmarshal(bz, &r)
+	return &r
+}
+
+func (k Keeper) storeCodeAnalysis(ctx sdk.Context, codeID uint64, analysis types.CodeAnalysis) {
+	ctx.KVStore(k.storeKey).Set(types.GetCodeAnalysisKey(codeID), k.cdc.MustMarshal(&analysis))
+}
+
+// analyzeCode runs the wasmvm code analysis for the uncompressed wasm code
+func (k Keeper) analyzeCode(checksum wasmvm.Checksum, wasmCode []byte) (*types.CodeAnalysis, error) {
+	report, err := k.wasmVM.AnalyzeCode(checksum)
+	if err != nil {
+		return nil, err
+	}
+	return newCodeAnalysis(report, wasmCode), nil
+}
+
+// AnalyzeStoredCode analyzes a code that is persisted in wasmvm already and stores the result.
+// Used to backfill the codes that were stored before the analysis was introduced.
+func (k Keeper) AnalyzeStoredCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeInfo) error {
+	wasmCode, err := k.wasmVM.GetCode(codeInfo.CodeHash)
+	if err != nil {
+		return errorsmod.Wrapf(err, "code id %d", codeID)
+	}
+	analysis, err := k.analyzeCode(codeInfo.CodeHash, wasmCode)
+	if err != nil {
+		return errorsmod.Wrapf(err, "code id %d", codeID)
+	}
+	k.storeCodeAnalysis(ctx, codeID, *analysis)
+	return nil
+}
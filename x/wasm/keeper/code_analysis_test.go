@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestNewCodeAnalysis(t *testing.T) {
+	specs := map[string]struct {
+		report wasmvmtypes.AnalysisReport
+		code   []byte
+		exp    *types.CodeAnalysis
+	}{
+		"hackatom": {
+			report: wasmvmtypes.AnalysisReport{},
+			code:   testdata.HackatomContractWasm(),
+			exp: &types.CodeAnalysis{
+				Entrypoints: []string{"execute", "instantiate", "migrate", "query", "sudo"},
+				CodeSize:    uint64(len(testdata.HackatomContractWasm())),
+			},
+		},
+		"ibc reflect": {
+			report: wasmvmtypes.AnalysisReport{HasIBCEntryPoints: true, RequiredCapabilities: "stargate,iterator"},
+			code:   testdata.IBCReflectContractWasm(),
+			exp: &types.CodeAnalysis{
+				HasIBCEntryPoints:    true,
+				RequiredCapabilities: []string{"iterator", "stargate"},
+				Entrypoints: []string{
+					"ibc_channel_close", "ibc_channel_connect", "ibc_channel_open", "ibc_packet_ack", "ibc_packet_receive", "ibc_packet_timeout", "instantiate", "migrate", "query", "reply",
+				},
+				CodeSize: uint64(len(testdata.IBCReflectContractWasm())),
+			},
+		},
+		"invalid exports": {
+			report: wasmvmtypes.AnalysisReport{RequiredCapabilities: " staking "},
+			code:   []byte("\x00asm\x01\x00\x00\x00\x07\x09"),
+			exp: &types.CodeAnalysis{
+				RequiredCapabilities: []string{"staking"},
+				CodeSize:             10,
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got := newCodeAnalysis(&spec.report, spec.code)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestStoreCodeAnalysis(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+
+	example := StoreIBCReflectContract(t, ctx, keepers)
+	got := k.GetCodeAnalysis(ctx, example.CodeID)
+	require.NotNil(t, got)
+	assert.True(t, got.HasIBCEntryPoints)
+	assert.Contains(t, got.Entrypoints, "ibc_packet_receive")
+	assert.Contains(t, got.RequiredCapabilities, "stargate")
+	assert.Equal(t, uint64(len(testdata.IBCReflectContractWasm())), got.CodeSize)
+
+	// and unknown code
+	assert.Nil(t, k.GetCodeAnalysis(ctx, example.CodeID+1))
+}
@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"sort"
+	"sync"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// codeUsage are the counters for a code
+type codeUsage struct {
+	Instantiations uint64
+	Executions     uint64
+}
+
+func (u codeUsage) calls() uint64 {
+	return u.Instantiations + u.Executions
+}
+
+// CodeUsageTracker counts the contract instantiations and executions per code in a node local database.
+// Counters are kept in memory and persisted with Flush. The data does not affect consensus.
+type CodeUsageTracker struct {
+	db dbm.DB
+
+	mu      sync.Mutex
+	pending map[uint64]codeUsage
+}
+
+// NewCodeUsageTracker constructor
+func NewCodeUsageTracker(db dbm.DB) *CodeUsageTracker {
+	if db == nil {
+		panic("db must not be nil")
+	}
+	return &CodeUsageTracker{db: db, pending: make(map[uint64]codeUsage)}
+}
+
+// RecordInstantiation counts a contract instantiation for the code. Safe to call on a nil instance.
+func (t *CodeUsageTracker) RecordInstantiation(codeID uint64) {
+	t.record(codeID, codeUsage{Instantiations: 1})
+}
+
+// RecordExecution counts a contract execution for the code. Safe to call on a nil instance.
+func (t *CodeUsageTracker) RecordExecution(codeID uint64) {
+	t.record(codeID, codeUsage{Executions: 1})
+}
+
+func (t *CodeUsageTracker) record(codeID uint64, delta codeUsage) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	u := t.pending[codeID]
+	u.Instantiations += delta.Instantiations
+	u.Executions += delta.Executions
+	t.pending[codeID] = u
+}
+
+// Flush persists the counters recorded in memory. Safe to call on a nil instance.
+func (t *CodeUsageTracker) Flush() error {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.pending) == 0 {
+		return nil
+	}
+	batch := t.db.NewBatch()
+	defer batch.Close()
+	for codeID, delta := range t.pending {
+		u, err := t.load(codeID)
+		if err != nil {
+			return err
+		}
+		u.Instantiations += delta.Instantiations
+		u.Executions += delta.Executions
+		if err := batch.Set(sdk.Uint64ToBigEndian(codeID), encodeCodeUsage(u)); err != nil {
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	t.pending = make(map[uint64]codeUsage)
+	return nil
+}
+
+// Close persists the counters recorded in memory and closes the database. Safe to call on a nil instance.
+func (t *CodeUsageTracker) Close() error {
+	if t == nil {
+		return nil
+	}
+	flushErr := t.Flush()
+	if err := t.db.Close(); err != nil {
+		return err
+	}
+	return flushErr
+}
+
+// all returns the persisted and pending counters of all codes
+func (t *CodeUsageTracker) all() (map[uint64]codeUsage, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	r := make(map[uint64]codeUsage)
+	iter, err := t.db.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		r[sdk.BigEndianToUint64(iter.Key())] = decodeCodeUsage(iter.Value())
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	for codeID, delta := range t.pending {
+		u := r[codeID]
+		u.Instantiations += delta.Instantiations
+		u.Executions += delta.Executions
+		r[codeID] = u
+	}
+	return r, nil
+}
+
+func (t *CodeUsageTracker) load(codeID uint64) (codeUsage, error) {
+	bz, err := t.db.Get(sdk.Uint64ToBigEndian(codeID))
+	if err != nil || bz == nil {
+		return codeUsage{}, err
+	}
+	return decodeCodeUsage(bz), nil
+}
+
+func encodeCodeUsage(u codeUsage) []byte {
+	r := make([]byte, 16)
+	binary.BigEndian.PutUint64(r, u.Instantiations)
+	binary.BigEndian.PutUint64(r[8:], u.Executions)
+	return r
+}
+
+func decodeCodeUsage(bz []byte) codeUsage {
+	if len(bz) != 16 {
+		return codeUsage{}
+	}
+	return codeUsage{
+		Instantiations: binary.BigEndian.Uint64(bz),
+		Executions:     binary.BigEndian.Uint64(bz[8:]),
+	}
+}
+
+// recordCodeUsage counts the instantiation or execution on delivered transactions only
+func (k Keeper) recordCodeUsage(ctx sdk.Context, codeID uint64, entrypoint string) {
+	if k.codeUsage == nil || ctx.IsCheckTx() {
+		return
+	}
+	switch entrypoint {
+	case entrypointInstantiate:
+		k.codeUsage.RecordInstantiation(codeID)
+	case entrypointExecute:
+		k.codeUsage.RecordExecution(codeID)
+	}
+}
+
+// FlushCodeUsage persists the code usage counters when tracking is enabled. Errors are logged only as
+// the data is node local.
+func (k Keeper) FlushCodeUsage(ctx sdk.Context) {
+	if err := k.codeUsage.Flush(); err != nil {
+		k.Logger(ctx).Error("failed to persist code usage", "error", err)
+	}
+}
+
+// CloseCodeUsage persists the pending code usage counters and closes the node local database.
+// To be called on node shutdown.
+func (k Keeper) CloseCodeUsage() error {
+	return k.codeUsage.Close()
+}
+
+// pinRecommendations ranks the unpinned codes by the gas that would have been saved on instantiation and
+// execution when pinned. All are returned when limit is 0.
+func (k Keeper) pinRecommendations(ctx sdk.Context, limit uint32) ([]types.PinRecommendation, error) {
+	usage, err := k.codeUsage.all()
+	if err != nil {
+		return nil, err
+	}
+	gasRegister := k.loadGasRegister(ctx)
+	costsPerCall := gasRegister.NewContractInstanceCosts(false, 0) - gasRegister.NewContractInstanceCosts(true, 0)
+
+	r := make([]types.PinRecommendation, 0, len(usage))
+	for codeID, u := range usage {
+		if u.calls() == 0 || k.IsPinnedCode(ctx, codeID) || k.GetCodeInfo(ctx, codeID) == nil {
+			continue
+		}
+		r = append(r, types.PinRecommendation{
+			CodeID:              codeID,
+			Instantiations:      u.Instantiations,
+			Executions:          u.Executions,
+			EstimatedGasSavings: u.calls() * costsPerCall,
+		})
+	}
+	sort.Slice(r, func(i, j int) bool {
+		if r[i].EstimatedGasSavings != r[j].EstimatedGasSavings {
+			return r[i].EstimatedGasSavings > r[j].EstimatedGasSavings
+		}
+		return r[i].CodeID < r[j].CodeID
+	})
+	if limit != 0 && len(r) > int(limit) {
+		r = r[:limit]
+	}
+	return r, nil
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestCodeUsageTracker(t *testing.T) {
+	db := dbm.NewMemDB()
+	tracker := NewCodeUsageTracker(db)
+	tracker.RecordInstantiation(1)
+	tracker.RecordExecution(1)
+	tracker.RecordExecution(2)
+
+	// pending counters are included
+	got, err := tracker.all()
+	require.NoError(t, err)
+	assert.Equal(t, map[uint64]codeUsage{1: {Instantiations: 1, Executions: 1}, 2: {Executions: 1}}, got)
+
+	// when flushed
+	require.NoError(t, tracker.Flush())
+	// then counters are persisted
+	got, err = NewCodeUsageTracker(db).all()
+	require.NoError(t, err)
+	assert.Equal(t, map[uint64]codeUsage{1: {Instantiations: 1, Executions: 1}, 2: {Executions: 1}}, got)
+
+	// and when recorded again
+	tracker.RecordExecution(1)
+	require.NoError(t, tracker.Flush())
+	// then added to the persisted counters
+	got, err = tracker.all()
+	require.NoError(t, err)
+	assert.Equal(t, map[uint64]codeUsage{1: {Instantiations: 1, Executions: 2}, 2: {Executions: 1}}, got)
+
+	// nil tracker is a noop
+	var nilTracker *CodeUsageTracker
+	nilTracker.RecordExecution(1)
+	require.NoError(t, nilTracker.Flush())
+	require.NoError(t, nilTracker.Close())
+}
+
+func TestCodeUsageTrackerClose(t *testing.T) {
+	dir := t.TempDir()
+	db, err := dbm.NewGoLevelDB("code_usage", dir)
+	require.NoError(t, err)
+	tracker := NewCodeUsageTracker(db)
+	tracker.RecordExecution(1)
+
+	// when
+	require.NoError(t, tracker.Close())
+	// then pending counters are persisted and the db can be opened again
+	db, err = dbm.NewGoLevelDB("code_usage", dir)
+	require.NoError(t, err)
+	defer db.Close()
+	got, err := NewCodeUsageTracker(db).all()
+	require.NoError(t, err)
+	assert.Equal(t, map[uint64]codeUsage{1: {Executions: 1}}, got)
+}
+
+func TestPinRecommendations(t *testing.T) {
+	tracker := NewCodeUsageTracker(dbm.NewMemDB())
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithCodeUsageTracker(tracker))
+	k := keepers.WasmKeeper
+
+	mostUsed := InstantiateHackatomExampleContract(t, ctx, keepers)
+	_, err := keepers.ContractKeeper.Execute(ctx, mostUsed.Contract, mostUsed.VerifierAddr, []byte(`{"release":{}}`), nil)
+	require.NoError(t, err)
+	lessUsed := InstantiateHackatomExampleContract(t, ctx, keepers)
+	pinned := InstantiateHackatomExampleContract(t, ctx, keepers)
+	require.NoError(t, k.pinCode(ctx, pinned.CodeID))
+	// not counted outside of block execution
+	_, err = keepers.ContractKeeper.Execute(ctx.WithIsCheckTx(true), lessUsed.Contract, lessUsed.VerifierAddr, []byte(`{"release":{}}`), nil)
+	require.NoError(t, err)
+	require.NoError(t, tracker.Flush())
+
+	gasRegister := k.loadGasRegister(ctx)
+	costsPerCall := gasRegister.NewContractInstanceCosts(false, 0) - gasRegister.NewContractInstanceCosts(true, 0)
+	require.NotZero(t, costsPerCall)
+
+	specs := map[string]struct {
+		limit uint32
+		exp   []types.PinRecommendation
+	}{
+		"all": {
+			exp: []types.PinRecommendation{
+				{CodeID: mostUsed.CodeID, Instantiations: 1, Executions: 1, EstimatedGasSavings: 2 * costsPerCall},
+				{CodeID: lessUsed.CodeID, Instantiations: 1, EstimatedGasSavings: costsPerCall},
+			},
+		},
+		"with limit": {
+			limit: 1,
+			exp: []types.PinRecommendation{
+				{CodeID: mostUsed.CodeID, Instantiations: 1, Executions: 1, EstimatedGasSavings: 2 * costsPerCall},
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			q := Querier(k)
+			got, gotErr := q.PinRecommendations(sdk.WrapSDKContext(ctx), &types.QueryPinRecommendationsRequest{Limit: spec.limit})
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got.Recommendations)
+		})
+	}
+}
+
+func TestPinRecommendationsDisabled(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	q := Querier(keepers.WasmKeeper)
+	_, gotErr := q.PinRecommendations(sdk.WrapSDKContext(ctx), &types.QueryPinRecommendationsRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(gotErr))
+}
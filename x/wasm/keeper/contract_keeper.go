@@ -23,6 +23,18 @@ type decoratedKeeper interface {
 		authZ types.AuthorizationPolicy,
 	) (sdk.AccAddress, []byte, error)
 
+	instantiate2(
+		ctx sdk.Context,
+		codeID uint64,
+		creator, admin sdk.AccAddress,
+		initMsg []byte,
+		label string,
+		deposit sdk.Coins,
+		salt []byte,
+		fixMsg bool,
+		authZ types.AuthorizationPolicy,
+	) (sdk.AccAddress, []byte, error)
+
 	migrate(ctx sdk.Context, contractAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ types.AuthorizationPolicy) ([]byte, error)
 	setContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ types.AuthorizationPolicy) error
 	pinCode(ctx sdk.Context, codeID uint64) error
@@ -78,7 +90,7 @@ func (p PermissionedKeeper) Instantiate2(
 	salt []byte,
 	fixMsg bool,
 ) (sdk.AccAddress, []byte, error) {
-	return p.nested.instantiate(
+	return p.nested.instantiate2(
 		ctx,
 		codeID,
 		creator,
@@ -86,7 +98,8 @@ func (p PermissionedKeeper) Instantiate2(
 		initMsg,
 		label,
 		deposit,
-		PredicableAddressGenerator(creator, salt, initMsg, fixMsg),
+		salt,
+		fixMsg,
 		p.authZPolicy,
 	)
 }
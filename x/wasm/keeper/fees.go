@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// FeeCollector collects the upload and instantiate fees from the payer
+type FeeCollector interface {
+	CollectFees(ctx sdk.Context, payer sdk.AccAddress, amount sdk.Coins, destination types.FeeDestination) error
+}
+
+var _ FeeCollector = DistributionFeeCollector{}
+
+// DistributionFeeCollector sends the fees to the community pool or burns them
+type DistributionFeeCollector struct {
+	bank  types.Burner
+	distr types.DistributionKeeper
+}
+
+// NewDistributionFeeCollector constructor
+func NewDistributionFeeCollector(bank types.Burner, distr types.DistributionKeeper) DistributionFeeCollector {
+	return DistributionFeeCollector{bank: bank, distr: distr}
+}
+
+// CollectFees transfers the amount from the payer to the destination
+func (c DistributionFeeCollector) CollectFees(ctx sdk.Context, payer sdk.AccAddress, amount sdk.Coins, destination types.FeeDestination) error {
+	switch destination {
+	case types.FeeDestinationCommunityPool:
+		return c.distr.FundCommunityPool(ctx, amount, payer)
+	case types.FeeDestinationBurn:
+		if err := c.bank.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, amount); err != nil {
+			return err
+		}
+		return c.bank.BurnCoins(ctx, types.ModuleName, amount)
+	default:
+		return errorsmod.Wrapf(types.ErrInvalid, "fee destination: %s", destination)
+	}
+}
+
+// chargeFees collects the fee from the payer unless it is zero or the authorization policy exempts the actor.
+// Returns the charged amount.
+func (k Keeper) chargeFees(ctx sdk.Context, payer sdk.AccAddress, authZ types.AuthorizationPolicy, feeFn func(types.FeeParams) sdk.Coins) (sdk.Coins, error) {
+	if authZ.IsFeeExempt() {
+		return nil, nil
+	}
+	params := k.getParamsNoGas(ctx).FeesOrDefault()
+	fee := feeFn(params)
+	if fee.IsZero() {
+		return nil, nil
+	}
+	if err := k.feeCollector.CollectFees(ctx, payer, fee, params.Destination); err != nil {
+		return nil, err
+	}
+	return fee, nil
+}
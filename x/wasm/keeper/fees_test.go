@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestCreateWithFees(t *testing.T) {
+	wasmCode := testdata.HackatomContractWasm()
+	codeSize := int64(len(wasmCode))
+
+	specs := map[string]struct {
+		fees       *types.FeeParams
+		policy     types.AuthorizationPolicy
+		expFee     sdk.Coins
+		expBurned  bool
+		expErr     bool
+		expNoEvent bool
+	}{
+		"no fees set": {
+			policy:     DefaultAuthorizationPolicy{},
+			expNoEvent: true,
+		},
+		"flat fee to community pool": {
+			fees:   &types.FeeParams{UploadFlat: sdk.NewCoins(sdk.NewInt64Coin("denom", 100))},
+			policy: DefaultAuthorizationPolicy{},
+			expFee: sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+		},
+		"flat and per byte fee burned": {
+			fees: &types.FeeParams{
+				UploadFlat:    sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+				UploadPerByte: sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom", sdk.NewDecWithPrec(1, 3))),
+				Destination:   types.FeeDestinationBurn,
+			},
+			policy:    DefaultAuthorizationPolicy{},
+			expFee:    sdk.NewCoins(sdk.NewInt64Coin("denom", 100+(codeSize+999)/1000)),
+			expBurned: true,
+		},
+		"insufficient funds": {
+			fees:   &types.FeeParams{UploadFlat: sdk.NewCoins(sdk.NewInt64Coin("denom", 1_000_001))},
+			policy: DefaultAuthorizationPolicy{},
+			expErr: true,
+		},
+		"gov exempt": {
+			fees:       &types.FeeParams{UploadFlat: sdk.NewCoins(sdk.NewInt64Coin("denom", 100))},
+			policy:     GovAuthorizationPolicy{},
+			expNoEvent: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			creator := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 1_000_000))
+			params := types.DefaultParams()
+			params.Fees = spec.fees
+			require.NoError(t, keepers.WasmKeeper.SetParams(ctx, params))
+			poolBefore := keepers.DistKeeper.GetFeePoolCommunityCoins(ctx)
+			supplyBefore := keepers.BankKeeper.GetSupply(ctx, "denom")
+			em := sdk.NewEventManager()
+
+			// when
+			_, _, gotErr := NewPermissionedKeeper(keepers.WasmKeeper, spec.policy).Create(ctx.WithEventManager(em), creator, wasmCode, nil)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			expBalance := sdk.NewCoins(sdk.NewInt64Coin("denom", 1_000_000)).Sub(spec.expFee...)
+			assert.Equal(t, expBalance, keepers.BankKeeper.GetAllBalances(ctx, creator))
+			gotFeeAttr, hasFeeAttr := findStoreCodeAttribute(em.Events(), types.AttributeKeyFee)
+			if spec.expNoEvent {
+				assert.False(t, hasFeeAttr)
+				return
+			}
+			assert.Equal(t, spec.expFee.String(), gotFeeAttr)
+			if spec.expBurned {
+				assert.Equal(t, supplyBefore.Sub(spec.expFee[0]), keepers.BankKeeper.GetSupply(ctx, "denom"))
+				assert.Equal(t, poolBefore, keepers.DistKeeper.GetFeePoolCommunityCoins(ctx))
+				return
+			}
+			assert.Equal(t, poolBefore.Add(sdk.NewDecCoinsFromCoins(spec.expFee...)...), keepers.DistKeeper.GetFeePoolCommunityCoins(ctx))
+		})
+	}
+}
+
+func TestInstantiateWithFees(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 1_000))
+	params := types.DefaultParams()
+	params.Fees = &types.FeeParams{
+		UploadFlat:  sdk.NewCoins(sdk.NewInt64Coin("denom", 999)),
+		Instantiate: sdk.NewCoins(sdk.NewInt64Coin("denom", 300)),
+		Destination: types.FeeDestinationBurn,
+	}
+	require.NoError(t, keepers.WasmKeeper.SetParams(ctx, params))
+	initMsg := HackatomExampleInitMsg{Verifier: RandomAccountAddress(t), Beneficiary: RandomAccountAddress(t)}.GetBytes(t)
+	em := sdk.NewEventManager()
+
+	// when
+	_, _, err := keepers.ContractKeeper.Instantiate(ctx.WithEventManager(em), example.CodeID, creator, nil, initMsg, "fees", nil)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 700)), keepers.BankKeeper.GetAllBalances(ctx, creator))
+	var gotFee string
+	for _, e := range em.Events() {
+		if e.Type != types.EventTypeInstantiate {
+			continue
+		}
+		for _, a := range e.Attributes {
+			if a.Key == types.AttributeKeyFee {
+				gotFee = a.Value
+			}
+		}
+	}
+	assert.Equal(t, "300denom", gotFee)
+
+	// and gov is exempt
+	_, _, err = NewGovPermissionKeeper(keepers.WasmKeeper).Instantiate(ctx, example.CodeID, creator, nil, initMsg, "gov", nil)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 700)), keepers.BankKeeper.GetAllBalances(ctx, creator))
+
+	// and fails without sufficient funds
+	for _, label := range []string{"other", "another"} {
+		_, _, err = keepers.ContractKeeper.Instantiate(ctx, example.CodeID, creator, nil, initMsg, label, nil)
+		require.NoError(t, err)
+	}
+	_, _, err = keepers.ContractKeeper.Instantiate(ctx, example.CodeID, creator, nil, initMsg, "last", nil)
+	require.Error(t, err)
+}
+
+func findStoreCodeAttribute(events sdk.Events, key string) (string, bool) {
+	for _, e := range events {
+		if e.Type != types.EventTypeStoreCode {
+			continue
+		}
+		for _, a := range e.Attributes {
+			if a.Key == key {
+				return a.Value, true
+			}
+		}
+	}
+	return "", false
+}
@@ -14,59 +14,30 @@ import (
 
 const (
 	// DefaultGasMultiplier is how many CosmWasm gas points = 1 Cosmos SDK gas point.
-	//
-	// CosmWasm gas strategy is documented in https://github.com/CosmWasm/cosmwasm/blob/v1.0.0-beta/docs/GAS.md.
-	// Cosmos SDK reference costs can be found here: https://github.com/cosmos/cosmos-sdk/blob/v0.42.10/store/types/gas.go#L198-L209.
-	//
-	// The original multiplier of 100 up to CosmWasm 0.16 was based on
-	//     "A write at ~3000 gas and ~200us = 10 gas per us (microsecond) cpu/io
-	//     Rough timing have 88k gas at 90us, which is equal to 1k sdk gas... (one read)"
-	// as well as manual Wasmer benchmarks from 2019. This was then multiplied by 150_000
-	// in the 0.16 -> 1.0 upgrade (https://github.com/CosmWasm/cosmwasm/pull/1120).
-	//
-	// The multiplier deserves more reproducible benchmarking and a strategy that allows easy adjustments.
-	// This is tracked in https://github.com/CosmWasm/wasmd/issues/566 and https://github.com/CosmWasm/wasmd/issues/631.
-	// Gas adjustments are consensus breaking but may happen in any release marked as consensus breaking.
-	// Do not make assumptions on how much gas an operation will consume in places that are hard to adjust,
-	// such as hardcoding them in contracts.
-	//
-	// Please note that all gas prices returned to wasmvm should have this multiplied.
-	// Benchmarks and numbers were discussed in: https://github.com/CosmWasm/wasmd/pull/634#issuecomment-938055852
-	DefaultGasMultiplier uint64 = 140_000_000
+	// See types.DefaultGasMultiplier for details.
+	DefaultGasMultiplier = types.DefaultGasMultiplier
 	// DefaultInstanceCost is how much SDK gas we charge each time we load a WASM instance.
-	// Creating a new instance is costly, and this helps put a recursion limit to contracts calling contracts.
-	// Benchmarks and numbers were discussed in: https://github.com/CosmWasm/wasmd/pull/634#issuecomment-938056803
-	DefaultInstanceCost uint64 = 60_000
+	DefaultInstanceCost = types.DefaultInstanceCost
 	// DefaultCompileCost is how much SDK gas is charged *per byte* for compiling WASM code.
-	// Benchmarks and numbers were discussed in: https://github.com/CosmWasm/wasmd/pull/634#issuecomment-938056803
-	DefaultCompileCost uint64 = 3
+	DefaultCompileCost = types.DefaultCompileCost
 	// DefaultEventAttributeDataCost is how much SDK gas is charged *per byte* for attribute data in events.
-	// This is used with len(key) + len(value)
-	DefaultEventAttributeDataCost uint64 = 1
+	DefaultEventAttributeDataCost = types.DefaultEventAttributeDataCost
 	// DefaultContractMessageDataCost is how much SDK gas is charged *per byte* of the message that goes to the contract
-	// This is used with len(msg). Note that the message is deserialized in the receiving contract and this is charged
-	// with wasm gas already. The derserialization of results is also charged in wasmvm. I am unsure if we need to add
-	// additional costs here.
-	// Note: also used for error fields on reply, and data on reply. Maybe these should be pulled out to a different (non-zero) field
-	DefaultContractMessageDataCost uint64 = 0
+	DefaultContractMessageDataCost = types.DefaultContractMessageDataCost
 	// DefaultPerAttributeCost is how much SDK gas we charge per attribute count.
-	DefaultPerAttributeCost uint64 = 10
+	DefaultPerAttributeCost = types.DefaultPerAttributeCost
 	// DefaultPerCustomEventCost is how much SDK gas we charge per event count.
-	DefaultPerCustomEventCost uint64 = 20
+	DefaultPerCustomEventCost = types.DefaultPerCustomEventCost
 	// DefaultEventAttributeDataFreeTier number of bytes of total attribute data we do not charge.
-	DefaultEventAttributeDataFreeTier = 100
+	DefaultEventAttributeDataFreeTier = types.DefaultEventAttributeDataFreeTier
 )
 
-// default: 0.15 gas.
-// see https://github.com/CosmWasm/wasmd/pull/898#discussion_r937727200
-var defaultPerByteUncompressCost = wasmvmtypes.UFraction{
-	Numerator:   15,
-	Denominator: 100,
-}
-
 // DefaultPerByteUncompressCost is how much SDK gas we charge per source byte to unpack
 func DefaultPerByteUncompressCost() wasmvmtypes.UFraction {
-	return defaultPerByteUncompressCost
+	return wasmvmtypes.UFraction{
+		Numerator:   types.DefaultPerByteUncompressCostNumerator,
+		Denominator: types.DefaultPerByteUncompressCostDenominator,
+	}
 }
 
 // GasRegister abstract source for gas costs
@@ -134,6 +105,24 @@ func DefaultGasRegisterConfig() WasmGasRegisterConfig {
 	}
 }
 
+// NewGasRegisterConfig creates the config from the on-chain params
+func NewGasRegisterConfig(p types.GasRegisterParams) WasmGasRegisterConfig {
+	return WasmGasRegisterConfig{
+		InstanceCost:               p.InstanceCost,
+		CompileCost:                p.CompileCost,
+		GasMultiplier:              p.GasMultiplier,
+		EventPerAttributeCost:      p.EventPerAttributeCost,
+		CustomEventCost:            p.CustomEventCost,
+		EventAttributeDataCost:     p.EventAttributeDataCost,
+		EventAttributeDataFreeTier: p.EventAttributeDataFreeTier,
+		ContractMessageDataCost:    p.ContractMessageDataCost,
+		UncompressCost: wasmvmtypes.UFraction{
+			Numerator:   p.UncompressCostNumerator,
+			Denominator: p.UncompressCostDenominator,
+		},
+	}
+}
+
 // WasmGasRegister implements GasRegister interface
 type WasmGasRegister struct {
 	c WasmGasRegisterConfig
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"bytes"
+	"sync"
+)
+
+// gasRegisterCache keeps the gas register of the last seen params so that the params are not
+// unmarshalled for every gas charge. The register is keyed by the raw params bytes and not by
+// block height so that the result does not depend on the context that filled the cache, for
+// example a query or a check tx, or on param updates within a block.
+type gasRegisterCache struct {
+	mu       sync.Mutex
+	paramsBz []byte
+	register GasRegister
+}
+
+// GetOrLoad returns the cached register for the params bytes or loads and caches a new one.
+// Safe to call on a nil instance.
+func (c *gasRegisterCache) GetOrLoad(paramsBz []byte, load func() GasRegister) GasRegister {
+	if c == nil {
+		return load()
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.register != nil && bytes.Equal(c.paramsBz, paramsBz) {
+		return c.register
+	}
+	c.register = load()
+	c.paramsBz = append([]byte{}, paramsBz...)
+	return c.register
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGasRegisterCache(t *testing.T) {
+	var loads int
+	loader := func(r GasRegister) func() GasRegister {
+		return func() GasRegister {
+			loads++
+			return r
+		}
+	}
+	myRegister := NewDefaultWasmGasRegister()
+	otherRegister := NewWasmGasRegister(WasmGasRegisterConfig{GasMultiplier: 1})
+
+	c := &gasRegisterCache{}
+	// when
+	got := c.GetOrLoad([]byte("params"), loader(myRegister))
+	// then
+	assert.Equal(t, myRegister, got)
+	assert.Equal(t, 1, loads)
+
+	// when same params
+	got = c.GetOrLoad([]byte("params"), loader(otherRegister))
+	// then cached
+	assert.Equal(t, myRegister, got)
+	assert.Equal(t, 1, loads)
+
+	// when params changed
+	got = c.GetOrLoad([]byte("other params"), loader(otherRegister))
+	// then reloaded
+	assert.Equal(t, otherRegister, got)
+	assert.Equal(t, 2, loads)
+
+	// when nil cache
+	var nilCache *gasRegisterCache
+	got = nilCache.GetOrLoad([]byte("params"), loader(myRegister))
+	// then always loaded
+	assert.Equal(t, myRegister, got)
+	assert.Equal(t, 3, loads)
+}
@@ -7,6 +7,7 @@ import (
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -452,7 +453,7 @@ func TestUncompressCosts(t *testing.T) {
 			exp:   2,
 		},
 		"max len": {
-			lenIn: types.MaxWasmSize,
+			lenIn: int(types.DefaultMaxWasmSize),
 			exp:   122880,
 		},
 		"invalid len": {
@@ -471,3 +472,60 @@ func TestUncompressCosts(t *testing.T) {
 		})
 	}
 }
+
+func TestGasRegisterFromParams(t *testing.T) {
+	customGasRegister := types.DefaultGasRegisterParams()
+	customGasRegister.CompileCost = 1
+	specs := map[string]struct {
+		srcOpts     []Option
+		srcRegister *types.GasRegisterParams
+		expConfig   WasmGasRegisterConfig
+	}{
+		"defaults when not set": {
+			expConfig: DefaultGasRegisterConfig(),
+		},
+		"from params": {
+			srcRegister: &customGasRegister,
+			expConfig:   NewGasRegisterConfig(customGasRegister),
+		},
+		"custom register option ignores params": {
+			srcOpts:     []Option{WithGasRegister(NewWasmGasRegister(DefaultGasRegisterConfig()))},
+			srcRegister: &customGasRegister,
+			expConfig:   DefaultGasRegisterConfig(),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, spec.srcOpts...)
+			params := keepers.WasmKeeper.GetParams(ctx)
+			params.GasRegister = spec.srcRegister
+			require.NoError(t, keepers.WasmKeeper.SetParams(ctx, params))
+
+			// when
+			gasBefore := ctx.GasMeter().GasConsumed()
+			got := keepers.WasmKeeper.getGasRegister(ctx)
+
+			// then
+			assert.Equal(t, NewWasmGasRegister(spec.expConfig), got)
+			assert.Equal(t, gasBefore, ctx.GasMeter().GasConsumed(), "params must be read without gas")
+		})
+	}
+}
+
+func TestStoreCodeChargesGasFromParams(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 100000))
+	wasmCode := hackatomWasm
+
+	storeGas := func(compileCost uint64) sdk.Gas {
+		ctx, _ := ctx.CacheContext()
+		params := keepers.WasmKeeper.GetParams(ctx)
+		params.GasRegister.CompileCost = compileCost
+		require.NoError(t, keepers.WasmKeeper.SetParams(ctx, params))
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, _, err := keepers.ContractKeeper.Create(ctx, creator, wasmCode, nil)
+		require.NoError(t, err)
+		return ctx.GasMeter().GasConsumed()
+	}
+	assert.Equal(t, sdk.Gas(len(wasmCode)), storeGas(2)-storeGas(1))
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"strings"
+	"sync"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// startGasTrace adds a new operation to the gas trace when a tracer is set in the context.
+// The node returned can be nil and must be closed with `End`.
+func (k Keeper) startGasTrace(ctx sdk.Context, name string, contractAddr sdk.AccAddress) *types.GasTraceNode {
+	return startGasTrace(ctx, name, contractAddr)
+}
+
+func startGasTrace(ctx sdk.Context, name string, contractAddr sdk.AccAddress) *types.GasTraceNode {
+	t, ok := types.GasTracerFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return t.Start(ctx, name, contractAddr)
+}
+
+var _ GasRegister = tracingGasRegister{}
+
+// tracingGasRegister decorates a gas register to record all charges in the gas trace
+type tracingGasRegister struct {
+	GasRegister
+	tracer *types.GasTracer
+}
+
+func (g tracingGasRegister) NewContractInstanceCosts(pinned bool, msgLen int) sdk.Gas {
+	return g.record("new-contract-instance-costs", g.GasRegister.NewContractInstanceCosts(pinned, msgLen))
+}
+
+func (g tracingGasRegister) CompileCosts(byteLength int) sdk.Gas {
+	return g.record("compile-costs", g.GasRegister.CompileCosts(byteLength))
+}
+
+func (g tracingGasRegister) UncompressCosts(byteLength int) sdk.Gas {
+	return g.record("uncompress-costs", g.GasRegister.UncompressCosts(byteLength))
+}
+
+func (g tracingGasRegister) InstantiateContractCosts(pinned bool, msgLen int) sdk.Gas {
+	return g.record("instantiate-contract-costs", g.GasRegister.InstantiateContractCosts(pinned, msgLen))
+}
+
+func (g tracingGasRegister) ReplyCosts(pinned bool, reply wasmvmtypes.Reply) sdk.Gas {
+	return g.record("reply-costs", g.GasRegister.ReplyCosts(pinned, reply))
+}
+
+func (g tracingGasRegister) EventCosts(attrs []wasmvmtypes.EventAttribute, events wasmvmtypes.Events) sdk.Gas {
+	return g.record("event-costs", g.GasRegister.EventCosts(attrs, events))
+}
+
+func (g tracingGasRegister) record(name string, gas sdk.Gas) sdk.Gas {
+	g.tracer.Record("gas-register/"+name, gas)
+	return gas
+}
+
+// queryRequestType returns a short name for the type of the query request
+func queryRequestType(request wasmvmtypes.QueryRequest) string {
+	switch {
+	case request.Bank != nil:
+		return "bank"
+	case request.Custom != nil:
+		return "custom"
+	case request.IBC != nil:
+		return "ibc"
+	case request.Staking != nil:
+		return "staking"
+	case request.Stargate != nil:
+		return "stargate"
+	case request.Wasm != nil:
+		return "wasm"
+	case request.Distribution != nil:
+		return "distribution"
+	default:
+		return "unknown"
+	}
+}
+
+// GasTraceDecorator ante decorator that sets up the node local gas tracing for a tx.
+type GasTraceDecorator struct {
+	simulations bool
+	writer      *gasTraceFileWriter
+}
+
+// NewGasTraceDecorator constructor. Traces of simulations are returned as events when tracing is enabled
+// in the config. Traces of all other txs are appended as json lines to the trace file, when set.
+func NewGasTraceDecorator(wasmConfig types.WasmConfig) (*GasTraceDecorator, error) {
+	d := &GasTraceDecorator{simulations: wasmConfig.GasTracing}
+	if wasmConfig.GasTraceFile != "" {
+		f, err := os.OpenFile(wasmConfig.GasTraceFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, err
+		}
+		d.writer = &gasTraceFileWriter{file: f}
+	}
+	return d, nil
+}
+
+// AnteHandle sets a new gas tracer in the context. Tracing has no impact on consensus as it does not
+// modify state, consume gas or emit events outside simulations.
+func (d GasTraceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	switch {
+	case simulate && d.simulations:
+		return next(types.WithGasTracer(ctx, types.NewGasTracer(emitGasTraceEvent)), tx, simulate)
+	case !simulate && !ctx.IsCheckTx() && d.writer != nil:
+		return next(types.WithGasTracer(ctx, types.NewGasTracer(d.writer.Write)), tx, simulate)
+	default:
+		return next(ctx, tx, simulate)
+	}
+}
+
+// emitGasTraceEvent adds the trace as json to the events
+func emitGasTraceEvent(ctx sdk.Context, root *types.GasTraceNode) {
+	bz, err := json.Marshal(root)
+	if err != nil {
+		moduleLogger(ctx).Error("gas trace", "err", err)
+		return
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeGasTrace,
+		sdk.NewAttribute(types.AttributeKeyGasTrace, string(bz)),
+	))
+}
+
+// gasTraceFileWriter appends the traces as json lines to a file
+type gasTraceFileWriter struct {
+	mx   sync.Mutex
+	file *os.File
+}
+
+// gasTraceLine is a line in the gas trace file
+type gasTraceLine struct {
+	Height int64               `json:"height"`
+	TxHash string              `json:"tx_hash"`
+	Trace  *types.GasTraceNode `json:"trace"`
+}
+
+func (w *gasTraceFileWriter) Write(ctx sdk.Context, root *types.GasTraceNode) {
+	bz, err := json.Marshal(gasTraceLine{
+		Height: ctx.BlockHeight(),
+		TxHash: strings.ToUpper(hex.EncodeToString(tmhash.Sum(ctx.TxBytes()))),
+		Trace:  root,
+	})
+	if err != nil {
+		moduleLogger(ctx).Error("gas trace", "err", err)
+		return
+	}
+	w.mx.Lock()
+	defer w.mx.Unlock()
+	if _, err := w.file.Write(append(bz, '\n')); err != nil {
+		moduleLogger(ctx).Error("write gas trace", "err", err)
+	}
+}
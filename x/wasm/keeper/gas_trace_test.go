@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestGasTraceCallTree(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, ReflectFeatures)
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+	codeID, _, err := keepers.ContractKeeper.Create(ctx, creator, testdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+	reflectAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, creator, nil, []byte("{}"), "reflect", deposit)
+	require.NoError(t, err)
+
+	// reflect a bank send and query the contract balance
+	_, receiver := keyPubAddr()
+	reflectMsg := mustMarshal(t, testdata.ReflectHandleMsg{Reflect: &testdata.ReflectPayload{Msgs: []wasmvmtypes.CosmosMsg{{
+		Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{ToAddress: receiver.String(), Amount: wasmvmtypes.Coins{{Denom: "denom", Amount: "1"}}}},
+	}}}})
+
+	var roots []*types.GasTraceNode
+	ctx = types.WithGasTracer(ctx, types.NewGasTracer(func(_ sdk.Context, root *types.GasTraceNode) {
+		roots = append(roots, root)
+	}))
+	gasBefore := ctx.GasMeter().GasConsumed()
+
+	// when
+	_, err = keepers.ContractKeeper.Execute(ctx, reflectAddr, creator, reflectMsg, nil)
+	require.NoError(t, err)
+
+	// then
+	require.Len(t, roots, 1)
+	root := roots[0]
+	assert.Equal(t, "execute", root.Name)
+	assert.Equal(t, reflectAddr.String(), root.Contract)
+	assert.Equal(t, ctx.GasMeter().GasConsumed()-gasBefore, root.GasUsed)
+	assert.NotZero(t, root.GasMeterReads)
+
+	var gotNames []string
+	for _, c := range root.Children {
+		gotNames = append(gotNames, c.Name)
+	}
+	assert.Contains(t, gotNames, "gas-register/instantiate-contract-costs")
+	assert.Contains(t, gotNames, "wasmvm")
+	assert.Contains(t, gotNames, "gas-register/event-costs")
+	assert.Contains(t, gotNames, "submsg")
+	for _, c := range root.Children {
+		switch c.Name {
+		case "submsg":
+			assert.Equal(t, map[string]string{"id": "0", "reply_on": "never"}, c.Attributes)
+			assert.NotZero(t, c.GasUsed)
+		case "wasmvm":
+			assert.NotEmpty(t, c.Attributes["wasmvm_gas"])
+		}
+	}
+}
+
+func TestGasTraceNotConsensusRelevant(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	execute := func(ctx sdk.Context) sdk.Gas {
+		ctx, _ = ctx.CacheContext()
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, err := keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+		require.NoError(t, err)
+		return ctx.GasMeter().GasConsumed()
+	}
+	var traced bool
+	tracingCtx := types.WithGasTracer(ctx, types.NewGasTracer(func(sdk.Context, *types.GasTraceNode) { traced = true }))
+	assert.Equal(t, execute(ctx), execute(tracingCtx))
+	assert.True(t, traced)
+}
@@ -20,6 +20,10 @@ type ValidatorSetSource interface {
 // CONTRACT: all types of accounts must have been already initialized/created
 func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState) ([]abci.ValidatorUpdate, error) {
 	contractKeeper := NewGovPermissionKeeper(keeper)
+	if data.Params.Capabilities == nil {
+		capabilities := keeper.VMCapabilityParams()
+		data.Params.Capabilities = &capabilities
+	}
 	err := keeper.SetParams(ctx, data.Params)
 	if err != nil {
 		return nil, errorsmod.Wrapf(err, "set params")
@@ -61,6 +65,12 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState) ([]ab
 		}
 	}
 
+	for i, record := range data.UploadRecords {
+		if err := keeper.importUploadRecord(ctx, record); err != nil {
+			return nil, errorsmod.Wrapf(err, "upload record %d", i)
+		}
+	}
+
 	// sanity check seq values
 	seqVal := keeper.PeekAutoIncrementID(ctx, types.KeyLastCodeID)
 	if seqVal <= maxCodeID {
@@ -118,5 +128,10 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 		})
 	}
 
+	keeper.IterateUploadRecords(ctx, func(record types.UploadRecord) bool {
+		genState.UploadRecords = append(genState.UploadRecords, record)
+		return false
+	})
+
 	return &genState
 }
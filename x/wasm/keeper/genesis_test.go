@@ -90,6 +90,8 @@ func TestGenesisExportImport(t *testing.T) {
 	}
 	var wasmParams types.Params
 	f.NilChance(0).Fuzz(&wasmParams)
+	// the import must not be restricted by the size limits
+	wasmParams.SizeLimits.MaxWasmSize = 1
 	err = wasmKeeper.SetParams(srcCtx, wasmParams)
 	require.NoError(t, err)
 
@@ -121,9 +123,6 @@ func TestGenesisExportImport(t *testing.T) {
 		return false
 	})
 
-	originalMaxWasmSize := types.MaxWasmSize
-	types.MaxWasmSize = 1
-
 	// re-import
 	var importState types.GenesisState
 	err = dstKeeper.cdc.UnmarshalJSON(exportedGenesis, &importState)
@@ -137,7 +136,6 @@ func TestGenesisExportImport(t *testing.T) {
 	dstIT := dstCtx.KVStore(dstKeeper.storeKey).Iterator(nil, nil)
 
 	t.Cleanup(func() {
-		types.MaxWasmSize = originalMaxWasmSize
 		srcIT.Close()
 		dstIT.Close()
 	})
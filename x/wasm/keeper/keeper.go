@@ -14,6 +14,7 @@ import (
 	wasmvm "github.com/CosmWasm/wasmvm"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	"github.com/cometbft/cometbft/libs/log"
+	"go.opentelemetry.io/otel/trace"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -86,15 +87,29 @@ type Keeper struct {
 	portKeeper            types.PortKeeper
 	capabilityKeeper      types.CapabilityKeeper
 	wasmVM                types.WasmerEngine
+	queryWasmVM           types.WasmerEngine
+	queryVMCodes          *queryVMCodes
 	wasmVMQueryHandler    WasmVMQueryHandler
 	wasmVMResponseHandler WasmVMResponseHandler
 	messenger             Messenger
 	// queryGasLimit is the max wasmvm gas that can be spent on executing a query with a contract
 	queryGasLimit        uint64
+	smartQueryLimiter    *smartQueryLimiter
+	smartQueryCache      *smartQueryCache
+	codeUsage            *CodeUsageTracker
+	cacheWarmupWorkers   uint32
+	cacheWarmupRecord    *cacheWarmupRecord
 	gasRegister          GasRegister
+	gasRegisterCache     *gasRegisterCache
+	apiCosts             *apiCosts
 	maxQueryStackSize    uint32
 	acceptedAccountTypes map[reflect.Type]struct{}
 	accountPruner        AccountPruner
+	feeCollector         FeeCollector
+	metrics              *ContractExecutionMetrics
+	tracer               trace.Tracer
+	// vmCapabilities are the capabilities supported by the wasmvm of this node
+	vmCapabilities []string
 	// propagate gov authZ to sub-messages
 	propagateGovAuthorization map[types.AuthorizationPolicyAction]struct{}
 
@@ -107,6 +122,19 @@ func (k Keeper) getUploadAccessConfig(ctx sdk.Context) types.AccessConfig {
 	return k.GetParams(ctx).CodeUploadAccess
 }
 
+// PruneExpiredUploadAccess removes the expired addresses from the code upload access params.
+// Expired addresses are not allowed to upload anymore already. This is called in the end blocker
+// so that the params are not written within a user's transaction.
+func (k Keeper) PruneExpiredUploadAccess(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	config, pruned := params.CodeUploadAccess.WithoutExpired(ctx)
+	if !pruned {
+		return nil
+	}
+	params.CodeUploadAccess = config
+	return k.SetParams(ctx, params)
+}
+
 func (k Keeper) getInstantiateAccessConfig(ctx sdk.Context) types.AccessType {
 	return k.GetParams(ctx).InstantiateDefaultPermission
 }
@@ -140,15 +168,57 @@ func (k Keeper) SetParams(ctx sdk.Context, ps types.Params) error {
 	return nil
 }
 
+// getGasRegister returns the gas register for the context. All charges are recorded when a
+// gas tracer is set in the context.
+func (k Keeper) getGasRegister(ctx sdk.Context) GasRegister {
+	if t, ok := types.GasTracerFromContext(ctx); ok {
+		return tracingGasRegister{GasRegister: k.loadGasRegister(ctx), tracer: t}
+	}
+	return k.loadGasRegister(ctx)
+}
+
+// loadGasRegister returns the gas register that was set with an option or the one
+// configured by the on-chain params. Params are read without gas consumption and the
+// register is only rebuilt when they have changed.
+func (k Keeper) loadGasRegister(ctx sdk.Context) GasRegister {
+	if k.gasRegister != nil {
+		return k.gasRegister
+	}
+	bz := ctx.MultiStore().GetKVStore(k.storeKey).Get(types.ParamsKey)
+	return k.gasRegisterCache.GetOrLoad(bz, func() GasRegister {
+		var params types.Params
+		if bz != nil {
+			k.cdc.MustUnmarshal(bz, &params)
+		}
+		return NewWasmGasRegister(NewGasRegisterConfig(params.GasRegisterOrDefault()))
+	})
+}
+
+// getParamsNoGas returns the wasm params without gas consumption
+func (k Keeper) getParamsNoGas(ctx sdk.Context) types.Params {
+	var params types.Params
+	if bz := ctx.MultiStore().GetKVStore(k.storeKey).Get(types.ParamsKey); bz != nil {
+		k.cdc.MustUnmarshal(bz, &params)
+	}
+	return params
+}
+
 // GetAuthority returns the x/wasm module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
 func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig, authZ types.AuthorizationPolicy) (codeID uint64, checksum []byte, err error) {
+	defer k.startGasTrace(ctx, "store-code", nil).End()
+	ctx, span := k.startSpan(ctx, "store-code", nil)
+	defer span.End()
 	if creator == nil {
 		return 0, checksum, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot be nil")
 	}
+	maxWasmSize := authZ.MaxWasmSize(k.getParamsNoGas(ctx).SizeLimitsOrDefault())
+	if err := types.ValidateWasmSize(wasmCode, maxWasmSize); err != nil {
+		return 0, checksum, errorsmod.Wrap(err, "code bytes")
+	}
 
 	// figure out proper instantiate access
 	defaultAccessConfig := k.getInstantiateAccessConfig(ctx).With(creator)
@@ -160,31 +230,54 @@ func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 		Upload:      k.getUploadAccessConfig(ctx),
 	}
 
-	if !authZ.CanCreateCode(chainConfigs, creator, *instantiateAccess) {
+	if !authZ.CanCreateCode(ctx, chainConfigs, creator, *instantiateAccess) {
 		return 0, checksum, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not create code")
 	}
+	if err := k.assertCodeIDsExist(ctx, *instantiateAccess); err != nil {
+		return 0, checksum, err
+	}
 
 	if ioutils.IsGzip(wasmCode) {
-		ctx.GasMeter().ConsumeGas(k.gasRegister.UncompressCosts(len(wasmCode)), "Uncompress gzip bytecode")
-		wasmCode, err = ioutils.Uncompress(wasmCode, int64(types.MaxWasmSize))
+		ctx.GasMeter().ConsumeGas(k.getGasRegister(ctx).UncompressCosts(len(wasmCode)), "Uncompress gzip bytecode")
+		wasmCode, err = ioutils.Uncompress(wasmCode, int64(maxWasmSize))
 		if err != nil {
 			return 0, checksum, types.ErrCreateFailed.Wrap(errorsmod.Wrap(err, "uncompress wasm archive").Error())
 		}
 	}
 
-	ctx.GasMeter().ConsumeGas(k.gasRegister.CompileCosts(len(wasmCode)), "Compiling wasm bytecode")
+	trackUpload, err := k.checkUploadQuota(ctx, creator, len(wasmCode), authZ)
+	if err != nil {
+		return 0, checksum, err
+	}
+
+	uploadFee, err := k.chargeFees(ctx, creator, authZ, func(p types.FeeParams) sdk.Coins { return p.UploadFee(len(wasmCode)) })
+	if err != nil {
+		return 0, checksum, errorsmod.Wrap(err, "upload fee")
+	}
+
+	ctx.GasMeter().ConsumeGas(k.getGasRegister(ctx).CompileCosts(len(wasmCode)), "Compiling wasm bytecode")
 	checksum, err = k.wasmVM.StoreCode(wasmCode)
 	if err != nil {
 		return 0, checksum, errorsmod.Wrap(types.ErrCreateFailed, err.Error())
 	}
+	k.recordCompiledCode(ctx, checksum)
 	report, err := k.wasmVM.AnalyzeCode(checksum)
 	if err != nil {
 		return 0, checksum, errorsmod.Wrap(types.ErrCreateFailed, err.Error())
 	}
+	analysis := newCodeAnalysis(report, wasmCode)
+	if missing := k.enabledCapabilities(k.getParamsNoGas(ctx)).Missing(analysis.RequiredCapabilities); len(missing) != 0 {
+		return 0, checksum, types.ErrCapabilityDisabled.Wrapf("code requires: %s", strings.Join(missing, ","))
+	}
 	codeID = k.autoIncrementID(ctx, types.KeyLastCodeID)
+	span.SetCodeID(codeID)
 	k.Logger(ctx).Debug("storing new contract", "capabilities", report.RequiredCapabilities, "code_id", codeID)
 	codeInfo := types.NewCodeInfo(checksum, creator, *instantiateAccess)
 	k.storeCodeInfo(ctx, codeID, codeInfo)
+	k.storeCodeAnalysis(ctx, codeID, *analysis)
+	if trackUpload {
+		k.storeUploadRecord(ctx, creator, codeID, len(wasmCode))
+	}
 
 	evt := sdk.NewEvent(
 		types.EventTypeStoreCode,
@@ -194,6 +287,9 @@ func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 	for _, f := range strings.Split(report.RequiredCapabilities, ",") {
 		evt.AppendAttributes(sdk.NewAttribute(types.AttributeKeyRequiredCapability, strings.TrimSpace(f)))
 	}
+	if !uploadFee.IsZero() {
+		evt = evt.AppendAttributes(sdk.NewAttribute(types.AttributeKeyFee, uploadFee.String()))
+	}
 	ctx.EventManager().EmitEvent(evt)
 
 	return codeID, checksum, nil
@@ -220,6 +316,11 @@ func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeIn
 	if !bytes.Equal(codeInfo.CodeHash, newCodeHash) {
 		return errorsmod.Wrap(types.ErrInvalid, "code hashes not same")
 	}
+	k.recordCompiledCode(ctx, newCodeHash)
+	analysis, err := k.analyzeCode(newCodeHash, wasmCode)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrCreateFailed, err.Error())
+	}
 
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetCodeKey(codeID)
@@ -228,9 +329,28 @@ func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeIn
 	}
 	// 0x01 | codeID (uint64) -> ContractInfo
 	store.Set(key, k.cdc.MustMarshal(&codeInfo))
+	k.storeCodeAnalysis(ctx, codeID, *analysis)
 	return nil
 }
 
+// instantiate2 ensures the salt size limit and instantiates the contract with the predictable address generator
+func (k Keeper) instantiate2(
+	ctx sdk.Context,
+	codeID uint64,
+	creator, admin sdk.AccAddress,
+	initMsg []byte,
+	label string,
+	deposit sdk.Coins,
+	salt []byte,
+	fixMsg bool,
+	authPolicy types.AuthorizationPolicy,
+) (sdk.AccAddress, []byte, error) {
+	if err := k.getParamsNoGas(ctx).SizeLimitsOrDefault().ValidateSaltSize(salt); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "salt")
+	}
+	return k.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, PredicableAddressGenerator(creator, salt, initMsg, fixMsg), authPolicy)
+}
+
 func (k Keeper) instantiate(
 	ctx sdk.Context,
 	codeID uint64,
@@ -242,21 +362,48 @@ func (k Keeper) instantiate(
 	authPolicy types.AuthorizationPolicy,
 ) (sdk.AccAddress, []byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "instantiate")
+	traceNode := k.startGasTrace(ctx, "instantiate", nil)
+	defer traceNode.End()
+	traceNode.SetAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10))
+	ctx, span := k.startSpan(ctx, "instantiate", nil)
+	defer span.End()
+	span.SetCodeID(codeID)
 
 	if creator == nil {
 		return nil, nil, types.ErrEmpty.Wrap("creator")
 	}
-	instanceCosts := k.gasRegister.NewContractInstanceCosts(k.IsPinnedCode(ctx, codeID), len(initMsg))
+	if err := k.getParamsNoGas(ctx).SizeLimitsOrDefault().ValidateLabelSize(label); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "label")
+	}
+	instanceCosts := k.getGasRegister(ctx).NewContractInstanceCosts(k.IsPinnedCode(ctx, codeID), len(initMsg))
 	ctx.GasMeter().ConsumeGas(instanceCosts, "Loading CosmWasm module: instantiate")
 
 	codeInfo := k.GetCodeInfo(ctx, codeID)
 	if codeInfo == nil {
 		return nil, nil, types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
 	}
-	if !authPolicy.CanInstantiateContract(codeInfo.InstantiateConfig, creator) {
+	if err := k.assertCapabilitiesEnabled(ctx, codeID); err != nil {
+		return nil, nil, err
+	}
+	k.recordCodeUsage(ctx, codeID, entrypointInstantiate)
+	if !authPolicy.CanInstantiateContract(k.withCallerCodeID(ctx, codeInfo.InstantiateConfig, creator), codeInfo.InstantiateConfig, creator) {
 		return nil, nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not instantiate")
 	}
+	if c := codeInfo.InstantiateConfig.Constraints; c != nil {
+		if err := c.Accept(admin, deposit); err != nil {
+			return nil, nil, err
+		}
+	}
+	if config, pruned := codeInfo.InstantiateConfig.WithoutExpired(ctx); pruned {
+		codeInfo.InstantiateConfig = config
+		k.storeCodeInfo(ctx, codeID, *codeInfo)
+	}
+	instantiateFee, err := k.chargeFees(ctx, creator, authPolicy, func(p types.FeeParams) sdk.Coins { return p.Instantiate })
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "instantiate fee")
+	}
 	contractAddress := addressGenerator(ctx, codeID, codeInfo.CodeHash)
+	span.SetContract(contractAddress)
 	if k.HasContractInfo(ctx, contractAddress) {
 		return nil, nil, types.ErrDuplicate.Wrap("instance with this code id, sender and label exists: try a different label")
 	}
@@ -314,7 +461,9 @@ func (k Keeper) instantiate(
 
 	// instantiate wasm contract
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, err := k.wasmVM.Instantiate(codeInfo.CodeHash, env, info, initMsg, vmStore, cosmwasmAPI, querier, k.gasMeter(ctx), gas, costJSONDeserialization)
+	start := time.Now()
+	res, gasUsed, err := k.wasmVM.Instantiate(codeInfo.CodeHash, env, info, initMsg, vmStore, k.wasmVMAPI(ctx), querier, k.gasMeter(ctx), gas, k.deserializationCosts(ctx))
+	k.observeVMCall(ctx, entrypointInstantiate, codeID, start, gasUsed, err != nil)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if err != nil {
 		return nil, nil, errorsmod.Wrap(types.ErrInstantiateFailed, err.Error())
@@ -345,11 +494,15 @@ func (k Keeper) instantiate(
 	k.appendToContractHistory(ctx, contractAddress, historyEntry)
 	k.storeContractInfo(ctx, contractAddress, &contractInfo)
 
-	ctx.EventManager().EmitEvent(sdk.NewEvent(
+	evt := sdk.NewEvent(
 		types.EventTypeInstantiate,
 		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
 		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
-	))
+	)
+	if !instantiateFee.IsZero() {
+		evt = evt.AppendAttributes(sdk.NewAttribute(types.AttributeKeyFee, instantiateFee.String()))
+	}
+	ctx.EventManager().EmitEvent(evt)
 
 	ctx = types.WithSubMsgAuthzPolicy(ctx, authPolicy.SubMessageAuthorizationPolicy(types.AuthZActionInstantiate))
 	data, err := k.handleContractResponse(ctx, contractAddress, contractInfo.IBCPortID, res.Messages, res.Attributes, res.Data, res.Events)
@@ -363,13 +516,18 @@ func (k Keeper) instantiate(
 // Execute executes the contract instance
 func (k Keeper) execute(ctx sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "execute")
+	defer k.startGasTrace(ctx, "execute", contractAddress).End()
+	ctx, span := k.startSpan(ctx, "execute", contractAddress)
+	defer span.End()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
+	span.SetCodeID(contractInfo.CodeID)
 
-	executeCosts := k.gasRegister.InstantiateContractCosts(k.IsPinnedCode(ctx, contractInfo.CodeID), len(msg))
+	executeCosts := k.getGasRegister(ctx).InstantiateContractCosts(k.IsPinnedCode(ctx, contractInfo.CodeID), len(msg))
 	ctx.GasMeter().ConsumeGas(executeCosts, "Loading CosmWasm module: execute")
+	k.recordCodeUsage(ctx, contractInfo.CodeID, entrypointExecute)
 
 	// add more funds
 	if !coins.IsZero() {
@@ -384,7 +542,9 @@ func (k Keeper) execute(ctx sdk.Context, contractAddress, caller sdk.AccAddress,
 	// prepare querier
 	querier := k.newQueryHandler(ctx, contractAddress)
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.Execute(codeInfo.CodeHash, env, info, msg, prefixStore, cosmwasmAPI, querier, k.gasMeter(ctx), gas, costJSONDeserialization)
+	start := time.Now()
+	res, gasUsed, execErr := k.wasmVM.Execute(codeInfo.CodeHash, env, info, msg, prefixStore, k.wasmVMAPI(ctx), querier, k.gasMeter(ctx), gas, k.deserializationCosts(ctx))
+	k.observeVMCall(ctx, entrypointExecute, contractInfo.CodeID, start, gasUsed, execErr != nil)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if execErr != nil {
 		return nil, errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
@@ -412,7 +572,11 @@ func (k Keeper) migrate(
 	authZ types.AuthorizationPolicy,
 ) ([]byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "migrate")
-	migrateSetupCosts := k.gasRegister.InstantiateContractCosts(k.IsPinnedCode(ctx, newCodeID), len(msg))
+	defer k.startGasTrace(ctx, "migrate", contractAddress).End()
+	ctx, span := k.startSpan(ctx, "migrate", contractAddress)
+	defer span.End()
+	span.SetCodeID(newCodeID)
+	migrateSetupCosts := k.getGasRegister(ctx).InstantiateContractCosts(k.IsPinnedCode(ctx, newCodeID), len(msg))
 	ctx.GasMeter().ConsumeGas(migrateSetupCosts, "Loading CosmWasm module: migrate")
 
 	contractInfo := k.GetContractInfo(ctx, contractAddress)
@@ -427,8 +591,11 @@ func (k Keeper) migrate(
 	if newCodeInfo == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown code")
 	}
+	if err := k.assertCapabilitiesEnabled(ctx, newCodeID); err != nil {
+		return nil, err
+	}
 
-	if !authZ.CanInstantiateContract(newCodeInfo.InstantiateConfig, caller) {
+	if !authZ.CanInstantiateContract(k.withCallerCodeID(ctx, newCodeInfo.InstantiateConfig, caller), newCodeInfo.InstantiateConfig, caller) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "to use new code")
 	}
 
@@ -456,7 +623,9 @@ func (k Keeper) migrate(
 	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
 	vmStore := types.NewStoreAdapter(prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey))
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, err := k.wasmVM.Migrate(newCodeInfo.CodeHash, env, msg, vmStore, cosmwasmAPI, &querier, k.gasMeter(ctx), gas, costJSONDeserialization)
+	start := time.Now()
+	res, gasUsed, err := k.wasmVM.Migrate(newCodeInfo.CodeHash, env, msg, vmStore, k.wasmVMAPI(ctx), &querier, k.gasMeter(ctx), gas, k.deserializationCosts(ctx))
+	k.observeVMCall(ctx, entrypointMigrate, newCodeID, start, gasUsed, err != nil)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if err != nil {
 		return nil, errorsmod.Wrap(types.ErrMigrationFailed, err.Error())
@@ -494,12 +663,16 @@ func (k Keeper) migrate(
 // This is an extension point for some very advanced scenarios only. Use with care!
 func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "sudo")
+	defer k.startGasTrace(ctx, "sudo", contractAddress).End()
+	ctx, span := k.startSpan(ctx, "sudo", contractAddress)
+	defer span.End()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
+	span.SetCodeID(contractInfo.CodeID)
 
-	sudoSetupCosts := k.gasRegister.InstantiateContractCosts(k.IsPinnedCode(ctx, contractInfo.CodeID), len(msg))
+	sudoSetupCosts := k.getGasRegister(ctx).InstantiateContractCosts(k.IsPinnedCode(ctx, contractInfo.CodeID), len(msg))
 	ctx.GasMeter().ConsumeGas(sudoSetupCosts, "Loading CosmWasm module: sudo")
 
 	env := types.NewEnv(ctx, contractAddress)
@@ -507,7 +680,9 @@ func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte
 	// prepare querier
 	querier := k.newQueryHandler(ctx, contractAddress)
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.Sudo(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, k.gasMeter(ctx), gas, costJSONDeserialization)
+	start := time.Now()
+	res, gasUsed, execErr := k.wasmVM.Sudo(codeInfo.CodeHash, env, msg, prefixStore, k.wasmVMAPI(ctx), querier, k.gasMeter(ctx), gas, k.deserializationCosts(ctx))
+	k.observeVMCall(ctx, entrypointSudo, contractInfo.CodeID, start, gasUsed, execErr != nil)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if execErr != nil {
 		return nil, errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
@@ -529,13 +704,17 @@ func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte
 
 // reply is only called from keeper internal functions (dispatchSubmessages) after processing the submessage
 func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+	defer k.startGasTrace(ctx, "reply", contractAddress).End()
+	ctx, span := k.startSpan(ctx, "reply", contractAddress)
+	defer span.End()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
+	span.SetCodeID(contractInfo.CodeID)
 
 	// always consider this pinned
-	replyCosts := k.gasRegister.ReplyCosts(true, reply)
+	replyCosts := k.getGasRegister(ctx).ReplyCosts(true, reply)
 	ctx.GasMeter().ConsumeGas(replyCosts, "Loading CosmWasm module: reply")
 
 	env := types.NewEnv(ctx, contractAddress)
@@ -544,7 +723,9 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply was
 	querier := k.newQueryHandler(ctx, contractAddress)
 	gas := k.runtimeGasForContract(ctx)
 
-	res, gasUsed, execErr := k.wasmVM.Reply(codeInfo.CodeHash, env, reply, prefixStore, cosmwasmAPI, querier, k.gasMeter(ctx), gas, costJSONDeserialization)
+	start := time.Now()
+	res, gasUsed, execErr := k.wasmVM.Reply(codeInfo.CodeHash, env, reply, prefixStore, k.wasmVMAPI(ctx), querier, k.gasMeter(ctx), gas, k.deserializationCosts(ctx))
+	k.observeVMCall(ctx, entrypointReply, contractInfo.CodeID, start, gasUsed, execErr != nil)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if execErr != nil {
 		return nil, errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
@@ -613,6 +794,11 @@ func (k Keeper) setContractAdmin(ctx sdk.Context, contractAddress, caller, newAd
 	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
 		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
 	}
+	if codeInfo := k.GetCodeInfo(ctx, contractInfo.CodeID); codeInfo != nil && codeInfo.InstantiateConfig.Constraints != nil {
+		if err := codeInfo.InstantiateConfig.Constraints.AcceptAdmin(newAdmin); err != nil {
+			return err
+		}
+	}
 	newAdminStr := newAdmin.String()
 	contractInfo.Admin = newAdminStr
 	k.storeContractInfo(ctx, contractAddress, contractInfo)
@@ -685,6 +871,9 @@ func (k Keeper) getLastContractHistoryEntry(ctx sdk.Context, contractAddr sdk.Ac
 // QuerySmart queries the smart contract itself.
 func (k Keeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "query-smart")
+	defer k.startGasTrace(ctx, "query-smart", contractAddr).End()
+	ctx, span := k.startSpan(ctx, "query-smart", contractAddr)
+	defer span.End()
 
 	// checks and increase query stack size
 	ctx, err := checkAndIncreaseQueryStackSize(ctx, k.maxQueryStackSize)
@@ -696,15 +885,18 @@ func (k Keeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []b
 	if err != nil {
 		return nil, err
 	}
+	span.SetCodeID(contractInfo.CodeID)
 
-	smartQuerySetupCosts := k.gasRegister.InstantiateContractCosts(k.IsPinnedCode(ctx, contractInfo.CodeID), len(req))
+	smartQuerySetupCosts := k.getGasRegister(ctx).InstantiateContractCosts(k.IsPinnedCode(ctx, contractInfo.CodeID), len(req))
 	ctx.GasMeter().ConsumeGas(smartQuerySetupCosts, "Loading CosmWasm module: query")
 
 	// prepare querier
 	querier := k.newQueryHandler(ctx, contractAddr)
 
 	env := types.NewEnv(ctx, contractAddr)
-	queryResult, gasUsed, qErr := k.wasmVM.Query(codeInfo.CodeHash, env, req, prefixStore, cosmwasmAPI, querier, k.gasMeter(ctx), k.runtimeGasForContract(ctx), costJSONDeserialization)
+	start := time.Now()
+	queryResult, gasUsed, qErr := k.queryWasmEngine(ctx, codeInfo.CodeHash).Query(codeInfo.CodeHash, env, req, prefixStore, k.wasmVMAPI(ctx), querier, k.gasMeter(ctx), k.runtimeGasForContract(ctx), k.deserializationCosts(ctx))
+	k.observeVMCall(ctx, entrypointQuery, contractInfo.CodeID, start, gasUsed, qErr != nil)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if qErr != nil {
 		return nil, errorsmod.Wrap(types.ErrQueryFailed, qErr.Error())
@@ -760,6 +952,9 @@ func (k Keeper) contractInstance(ctx sdk.Context, contractAddress sdk.AccAddress
 	}
 	var codeInfo types.CodeInfo
 	k.cdc.MustUnmarshal(codeInfoBz, &codeInfo)
+	if err := k.assertCapabilitiesEnabled(ctx, contractInfo.CodeID); err != nil {
+		return contractInfo, codeInfo, nil, err
+	}
 	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
 	return contractInfo, codeInfo, types.NewStoreAdapter(prefixStore), nil
@@ -843,6 +1038,29 @@ func (k Keeper) GetCodeInfo(ctx sdk.Context, codeID uint64) *types.CodeInfo {
 	return &codeInfo
 }
 
+// assertCodeIDsExist returns an error when the access config references a code id that was not stored before.
+// A code id of a future upload would authorize any contract of it.
+func (k Keeper) assertCodeIDsExist(ctx sdk.Context, config types.AccessConfig) error {
+	for _, id := range config.CodeIDs {
+		if !k.containsCodeInfo(ctx, id) {
+			return types.ErrNoSuchCodeFn(id).Wrapf("access config code id %d", id)
+		}
+	}
+	return nil
+}
+
+// withCallerCodeID stores the code id of the actor into the context returned when it is a contract and the
+// access config authorizes by code ids
+func (k Keeper) withCallerCodeID(ctx sdk.Context, config types.AccessConfig, actor sdk.AccAddress) sdk.Context {
+	if config.Permission != types.AccessTypeAnyOfCodeIDs {
+		return ctx
+	}
+	if info := k.GetContractInfo(ctx, actor); info != nil {
+		return types.WithCallerCodeID(ctx, actor, info.CodeID)
+	}
+	return ctx
+}
+
 func (k Keeper) containsCodeInfo(ctx sdk.Context, codeID uint64) bool {
 	store := ctx.KVStore(k.storeKey)
 	return store.Has(types.GetCodeKey(codeID))
@@ -881,7 +1099,7 @@ func (k Keeper) pinCode(ctx sdk.Context, codeID uint64) error {
 		return types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
 	}
 
-	if err := k.wasmVM.Pin(codeInfo.CodeHash); err != nil {
+	if err := k.pinInVM(codeInfo.CodeHash); err != nil {
 		return errorsmod.Wrap(types.ErrPinContractFailed, err.Error())
 	}
 	store := ctx.KVStore(k.storeKey)
@@ -901,7 +1119,7 @@ func (k Keeper) unpinCode(ctx sdk.Context, codeID uint64) error {
 	if codeInfo == nil {
 		return types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
 	}
-	if err := k.wasmVM.Unpin(codeInfo.CodeHash); err != nil {
+	if err := k.unpinInVM(codeInfo.CodeHash); err != nil {
 		return errorsmod.Wrap(types.ErrUnpinContractFailed, err.Error())
 	}
 
@@ -933,13 +1151,46 @@ func (k Keeper) InitializePinnedCodes(ctx sdk.Context) error {
 		if codeInfo == nil {
 			return types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
 		}
-		if err := k.wasmVM.Pin(codeInfo.CodeHash); err != nil {
+		if err := k.pinInVM(codeInfo.CodeHash); err != nil {
 			return errorsmod.Wrap(types.ErrPinContractFailed, err.Error())
 		}
 	}
 	return nil
 }
 
+// pinInVM pins the code in the wasmvm instances so that the pins are consistent between them.
+// The pin in the main instance is rolled back when the query instance fails.
+func (k Keeper) pinInVM(checksum []byte) error {
+	if err := k.wasmVM.Pin(checksum); err != nil {
+		return err
+	}
+	if k.queryWasmVM == nil {
+		return nil
+	}
+	err := k.syncQueryVMCode(checksum)
+	if err == nil {
+		err = k.queryWasmVM.Pin(checksum)
+	}
+	if err != nil {
+		if rollbackErr := k.wasmVM.Unpin(checksum); rollbackErr != nil {
+			return errorsmod.Wrapf(err, "rollback: %s", rollbackErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// unpinInVM removes the pinned code from the wasmvm instances
+func (k Keeper) unpinInVM(checksum []byte) error {
+	if err := k.wasmVM.Unpin(checksum); err != nil {
+		return err
+	}
+	if k.queryWasmVM != nil {
+		return k.queryWasmVM.Unpin(checksum)
+	}
+	return nil
+}
+
 // setContractInfoExtension updates the extension point data that is stored with the contract info
 func (k Keeper) setContractInfoExtension(ctx sdk.Context, contractAddr sdk.AccAddress, ext types.ContractInfoExtension) error {
 	info := k.GetContractInfo(ctx, contractAddr)
@@ -960,10 +1211,21 @@ func (k Keeper) setAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAd
 	if info == nil {
 		return types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
 	}
-	isSubset := newConfig.Permission.IsSubset(k.getInstantiateAccessConfig(ctx))
+	// constraints are kept unless replaced or cleared explicitly with empty constraints
+	switch {
+	case newConfig.Constraints == nil:
+		newConfig.Constraints = info.InstantiateConfig.Constraints
+	case newConfig.Constraints.IsEmpty():
+		newConfig.Constraints = nil
+	}
+	isSubset := newConfig.Permission.IsSubset(k.getInstantiateAccessConfig(ctx)) &&
+		newConfig.Constraints.IsSubset(info.InstantiateConfig.Constraints)
 	if !authz.CanModifyCodeAccessConfig(sdk.MustAccAddressFromBech32(info.Creator), caller, isSubset) {
 		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify code access config")
 	}
+	if err := k.assertCodeIDsExist(ctx, newConfig); err != nil {
+		return err
+	}
 
 	info.InstantiateConfig = newConfig
 	k.storeCodeInfo(ctx, codeID, *info)
@@ -976,6 +1238,14 @@ func (k Keeper) setAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAd
 		attr := sdk.NewAttribute(types.AttributeKeyAuthorizedAddresses, strings.Join(addrs, ","))
 		evt.Attributes = append(evt.Attributes, attr.ToKVPair())
 	}
+	if len(newConfig.CodeIDs) != 0 {
+		ids := make([]string, len(newConfig.CodeIDs))
+		for i, id := range newConfig.CodeIDs {
+			ids[i] = strconv.FormatUint(id, 10)
+		}
+		attr := sdk.NewAttribute(types.AttributeKeyAuthorizedCodeIDs, strings.Join(ids, ","))
+		evt.Attributes = append(evt.Attributes, attr.ToKVPair())
+	}
 	ctx.EventManager().EmitEvent(evt)
 	return nil
 }
@@ -990,7 +1260,7 @@ func (k *Keeper) handleContractResponse(
 	data []byte,
 	evts wasmvmtypes.Events,
 ) ([]byte, error) {
-	attributeGasCost := k.gasRegister.EventCosts(attrs, evts)
+	attributeGasCost := k.getGasRegister(ctx).EventCosts(attrs, evts)
 	ctx.GasMeter().ConsumeGas(attributeGasCost, "Custom contract event attributes")
 	// emit all events from this contract itself
 	if len(attrs) != 0 {
@@ -1007,6 +1277,9 @@ func (k *Keeper) handleContractResponse(
 		}
 		ctx.EventManager().EmitEvents(customEvents)
 	}
+	if l, ok := types.SubMsgListener(ctx); ok && len(msgs) != 0 {
+		l(contractAddr, msgs)
+	}
 	return k.wasmVMResponseHandler.Handle(ctx, contractAddr, ibcPort, msgs, data)
 }
 
@@ -1018,11 +1291,14 @@ func (k Keeper) runtimeGasForContract(ctx sdk.Context) uint64 {
 	if meter.Limit() == math.MaxUint64 { // infinite gas meter and not out of gas
 		return math.MaxUint64
 	}
-	return k.gasRegister.ToWasmVMGas(meter.Limit() - meter.GasConsumedToLimit())
+	return k.getGasRegister(ctx).ToWasmVMGas(meter.Limit() - meter.GasConsumedToLimit())
 }
 
 func (k Keeper) consumeRuntimeGas(ctx sdk.Context, gas uint64) {
-	consumed := k.gasRegister.FromWasmVMGas(gas)
+	consumed := k.getGasRegister(ctx).FromWasmVMGas(gas)
+	if t, ok := types.GasTracerFromContext(ctx); ok {
+		t.Record("wasmvm", consumed).SetAttribute("wasmvm_gas", strconv.FormatUint(gas, 10))
+	}
 	ctx.GasMeter().ConsumeGas(consumed, "wasm contract")
 	// throw OutOfGas error if we ran out (got exactly to zero due to better limit enforcing)
 	if ctx.GasMeter().IsOutOfGas() {
@@ -1030,6 +1306,14 @@ func (k Keeper) consumeRuntimeGas(ctx sdk.Context, gas uint64) {
 	}
 }
 
+// observeVMCall records the execution metrics for a contract call into wasmvm, when enabled
+func (k Keeper) observeVMCall(ctx sdk.Context, entrypoint string, codeID uint64, start time.Time, gasUsed uint64, failed bool) {
+	if k.metrics == nil {
+		return
+	}
+	k.metrics.Observe(entrypoint, codeID, time.Since(start), k.loadGasRegister(ctx).FromWasmVMGas(gasUsed), failed)
+}
+
 func (k Keeper) autoIncrementID(ctx sdk.Context, lastIDKey []byte) uint64 {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(lastIDKey)
@@ -1084,13 +1368,15 @@ func (k Keeper) importContract(ctx sdk.Context, contractAddr sdk.AccAddress, c *
 }
 
 func (k Keeper) newQueryHandler(ctx sdk.Context, contractAddress sdk.AccAddress) QueryHandler {
-	return NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress, k.gasRegister)
+	return NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress, k.getGasRegister(ctx))
 }
 
 // MultipliedGasMeter wraps the GasMeter from context and multiplies all reads by out defined multiplier
 type MultipliedGasMeter struct {
 	originalMeter sdk.GasMeter
 	GasRegister   GasRegister
+	// tracer is optional and counts the reads
+	tracer *types.GasTracer
 }
 
 func NewMultipliedGasMeter(originalMeter sdk.GasMeter, gr GasRegister) MultipliedGasMeter {
@@ -1100,11 +1386,17 @@ func NewMultipliedGasMeter(originalMeter sdk.GasMeter, gr GasRegister) Multiplie
 var _ wasmvm.GasMeter = MultipliedGasMeter{}
 
 func (m MultipliedGasMeter) GasConsumed() sdk.Gas {
-	return m.GasRegister.ToWasmVMGas(m.originalMeter.GasConsumed())
+	r := m.GasRegister.ToWasmVMGas(m.originalMeter.GasConsumed())
+	if m.tracer != nil {
+		m.tracer.RecordGasMeterRead(r)
+	}
+	return r
 }
 
 func (k Keeper) gasMeter(ctx sdk.Context) MultipliedGasMeter {
-	return NewMultipliedGasMeter(ctx.GasMeter(), k.gasRegister)
+	m := NewMultipliedGasMeter(ctx.GasMeter(), k.getGasRegister(ctx))
+	m.tracer, _ = types.GasTracerFromContext(ctx)
+	return m
 }
 
 // Logger returns a module-specific logger.
@@ -1118,7 +1410,14 @@ func moduleLogger(ctx sdk.Context) log.Logger {
 
 // Querier creates a new grpc querier instance
 func Querier(k *Keeper) *GrpcQuerier {
-	return NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+	q := NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+	q.smartQueryLimiter = k.smartQueryLimiter
+	q.smartQueryCache = k.smartQueryCache
+	q.executeSimulator = k
+	if k.codeUsage != nil {
+		q.pinRecommender = k
+	}
+	return q
 }
 
 // QueryGasLimit returns the gas limit for smart queries.
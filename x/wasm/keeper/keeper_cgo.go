@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	wasmvm "github.com/CosmWasm/wasmvm"
+	dbm "github.com/cometbft/cometbft-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -42,11 +43,17 @@ func NewKeeper(
 		accountKeeper:        accountKeeper,
 		bank:                 NewBankCoinTransferrer(bankKeeper),
 		accountPruner:        NewVestingCoinBurner(bankKeeper),
+		feeCollector:         NewDistributionFeeCollector(bankKeeper, distrKeeper),
 		portKeeper:           portKeeper,
 		capabilityKeeper:     capabilityKeeper,
 		messenger:            NewDefaultMessageHandler(router, ics4Wrapper, channelKeeper, capabilityKeeper, bankKeeper, cdc, portSource),
 		queryGasLimit:        wasmConfig.SmartQueryGasLimit,
-		gasRegister:          NewDefaultWasmGasRegister(),
+		smartQueryLimiter:    newSmartQueryLimiter(wasmConfig.MaxConcurrentSmartQueries, wasmConfig.SmartQueryTimeout),
+		smartQueryCache:      newSmartQueryCache(uint64(wasmConfig.SmartQueryCacheSize) * 1024 * 1024),
+		cacheWarmupWorkers:   wasmConfig.CacheWarmupWorkers,
+		gasRegisterCache:     &gasRegisterCache{},
+		queryVMCodes:         newQueryVMCodes(),
+		vmCapabilities:       types.ParseCapabilities(availableCapabilities),
 		maxQueryStackSize:    types.DefaultMaxQueryStackSize,
 		acceptedAccountTypes: defaultAcceptedAccountTypes,
 		propagateGovAuthorization: map[types.AuthorizationPolicyAction]struct{}{
@@ -67,6 +74,31 @@ func NewKeeper(
 		if err != nil {
 			panic(err)
 		}
+		// the record of compiled codes is only consistent with the file system cache of the default instance
+		if wasmConfig.CacheWarmupWorkers != 0 {
+			version, err := wasmvm.LibwasmvmVersion()
+			if err != nil {
+				panic(err)
+			}
+			if keeper.cacheWarmupRecord, err = newCacheWarmupRecord(filepath.Join(homeDir, "wasm"), version); err != nil {
+				panic(err)
+			}
+		}
+		// the query instance has its own data directory and memory cache. Code is copied over from the main instance on first use.
+		if keeper.queryWasmVM == nil && wasmConfig.QueryMemoryCacheSize != 0 {
+			keeper.queryWasmVM, err = wasmvm.NewVM(filepath.Join(homeDir, "wasm-query"), availableCapabilities, contractMemoryLimit, wasmConfig.ContractDebugMode, wasmConfig.QueryMemoryCacheSize)
+			if err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	if keeper.codeUsage == nil && wasmConfig.CodeUsageTracking {
+		db, err := dbm.NewGoLevelDB("code_usage", filepath.Join(homeDir, "wasm"))
+		if err != nil {
+			panic(err)
+		}
+		keeper.codeUsage = NewCodeUsageTracker(db)
 	}
 
 	for _, o := range postOpts {
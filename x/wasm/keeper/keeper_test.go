@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"context"
 	_ "embed"
 	"encoding/json"
 	"errors"
@@ -35,6 +36,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
 	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -557,6 +559,168 @@ func TestInstantiateWithPermissions(t *testing.T) {
 	}
 }
 
+func TestInstantiateWithCodeIDsPermission(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.ContractKeeper
+	factory := InstantiateReflectExampleContract(t, ctx, keepers)
+	other := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	config := types.AccessConfig{Permission: types.AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{factory.CodeID}}
+	codeID, _, err := k.Create(ctx, factory.CreatorAddr, testdata.ReflectContractWasm(), &config)
+	require.NoError(t, err)
+
+	// contract of the authorized code
+	_, _, err = k.Instantiate(ctx, codeID, factory.Contract, nil, []byte("{}"), "by factory", nil)
+	require.NoError(t, err)
+	// contract of another code
+	_, _, err = k.Instantiate(ctx, codeID, other.Contract, nil, []byte("{}"), "by other", nil)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	// account that is not a contract
+	_, _, err = k.Instantiate(ctx, codeID, factory.CreatorAddr, nil, []byte("{}"), "by creator", nil)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestCodeIDsPermissionRequiresExistingCodes(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.ContractKeeper
+	example := StoreReflectContract(t, ctx, keepers)
+	unknownCodeID := example.CodeID + 1
+	config := types.AccessConfig{Permission: types.AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{unknownCodeID}}
+
+	_, _, err := k.Create(ctx, example.CreatorAddr, testdata.ReflectContractWasm(), &config)
+	require.ErrorIs(t, err, types.ErrNoSuchCodeFn(unknownCodeID))
+
+	err = k.SetAccessConfig(ctx, example.CodeID, example.CreatorAddr, config)
+	require.ErrorIs(t, err, types.ErrNoSuchCodeFn(unknownCodeID))
+	assert.Equal(t, types.AllowEverybody, keepers.WasmKeeper.GetCodeInfo(ctx, example.CodeID).InstantiateConfig)
+}
+
+func TestInstantiateWithConstraints(t *testing.T) {
+	var (
+		myAddr    = bytes.Repeat([]byte{1}, types.SDKAddrLen)
+		otherAddr = bytes.Repeat([]byte{2}, types.SDKAddrLen)
+		oneToken  = sdk.NewCoins(sdk.NewInt64Coin("denom", 1))
+	)
+	specs := map[string]struct {
+		constraints types.InstantiateConstraints
+		admin       sdk.AccAddress
+		deposit     sdk.Coins
+		expErr      bool
+	}{
+		"no admin": {
+			constraints: types.InstantiateConstraints{NoAdmin: true},
+		},
+		"no admin - with admin": {
+			constraints: types.InstantiateConstraints{NoAdmin: true},
+			admin:       myAddr,
+			expErr:      true,
+		},
+		"admin": {
+			constraints: types.InstantiateConstraints{Admin: sdk.AccAddress(otherAddr).String()},
+			admin:       otherAddr,
+		},
+		"admin - other admin": {
+			constraints: types.InstantiateConstraints{Admin: sdk.AccAddress(otherAddr).String()},
+			admin:       myAddr,
+			expErr:      true,
+		},
+		"max funds": {
+			constraints: types.InstantiateConstraints{MaxFunds: oneToken},
+			deposit:     oneToken,
+		},
+		"max funds - exceeded": {
+			constraints: types.InstantiateConstraints{MaxFunds: oneToken},
+			deposit:     oneToken.Add(oneToken...),
+			expErr:      true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			k := keepers.ContractKeeper
+			keepers.Faucet.Fund(ctx, myAddr, sdk.NewInt64Coin("denom", 100))
+			config := types.AccessConfig{Permission: types.AccessTypeEverybody, Constraints: &spec.constraints}
+			codeID, _, err := k.Create(ctx, myAddr, testdata.ReflectContractWasm(), &config)
+			require.NoError(t, err)
+
+			// when
+			_, _, gotErr := k.Instantiate(ctx, codeID, myAddr, spec.admin, []byte("{}"), "classic", spec.deposit)
+			_, _, gotErr2 := k.Instantiate2(ctx, codeID, myAddr, spec.admin, []byte("{}"), "predictable", spec.deposit, []byte("salt"), false)
+
+			// then
+			if spec.expErr {
+				assert.ErrorIs(t, gotErr, types.ErrInstantiateConstraint)
+				assert.ErrorIs(t, gotErr2, types.ErrInstantiateConstraint)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.NoError(t, gotErr2)
+		})
+	}
+}
+
+func TestSetContractAdminWithConstraints(t *testing.T) {
+	var (
+		myAddr    = bytes.Repeat([]byte{1}, types.SDKAddrLen)
+		otherAddr = bytes.Repeat([]byte{2}, types.SDKAddrLen)
+	)
+	specs := map[string]struct {
+		constraints types.InstantiateConstraints
+		admin       sdk.AccAddress
+		newAdmin    sdk.AccAddress
+		expErr      bool
+	}{
+		"admin - update to same": {
+			constraints: types.InstantiateConstraints{Admin: sdk.AccAddress(myAddr).String()},
+			admin:       myAddr,
+			newAdmin:    myAddr,
+		},
+		"admin - update to other": {
+			constraints: types.InstantiateConstraints{Admin: sdk.AccAddress(myAddr).String()},
+			admin:       myAddr,
+			newAdmin:    otherAddr,
+			expErr:      true,
+		},
+		"admin - clear": {
+			constraints: types.InstantiateConstraints{Admin: sdk.AccAddress(myAddr).String()},
+			admin:       myAddr,
+			expErr:      true,
+		},
+		"no admin - set by gov": {
+			constraints: types.InstantiateConstraints{NoAdmin: true},
+			newAdmin:    otherAddr,
+			expErr:      true,
+		},
+		"max funds only": {
+			constraints: types.InstantiateConstraints{MaxFunds: sdk.NewCoins(sdk.NewInt64Coin("denom", 1))},
+			admin:       myAddr,
+			newAdmin:    otherAddr,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			k := keepers.ContractKeeper
+			config := types.AccessConfig{Permission: types.AccessTypeEverybody, Constraints: &spec.constraints}
+			codeID, _, err := k.Create(ctx, myAddr, testdata.ReflectContractWasm(), &config)
+			require.NoError(t, err)
+			contractAddr, _, err := k.Instantiate(ctx, codeID, myAddr, spec.admin, []byte("{}"), "label", nil)
+			require.NoError(t, err)
+
+			// when
+			gotErr := keepers.WasmKeeper.setContractAdmin(ctx, contractAddr, myAddr, spec.newAdmin, GovAuthorizationPolicy{})
+
+			// then
+			if spec.expErr {
+				assert.ErrorIs(t, gotErr, types.ErrInstantiateConstraint)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.newAdmin.String(), keepers.WasmKeeper.GetContractInfo(ctx, contractAddr).Admin)
+		})
+	}
+}
+
 func TestInstantiateWithAccounts(t *testing.T) {
 	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	example := StoreHackatomExampleContract(t, parentCtx, keepers)
@@ -1820,6 +1984,183 @@ func TestInitializePinnedCodes(t *testing.T) {
 	}
 }
 
+func TestPinCodeWithQueryWasmEngine(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+
+	var pinned, queryVMPinned []wasmvm.Checksum
+	mock := wasmtesting.MockWasmer{
+		PinFn: func(checksum wasmvm.Checksum) error {
+			pinned = append(pinned, checksum)
+			return nil
+		},
+		UnpinFn: func(checksum wasmvm.Checksum) error {
+			pinned = nil
+			return nil
+		},
+	}
+	wasmtesting.MakeInstantiable(&mock)
+	k.queryWasmVM = &wasmtesting.MockWasmer{
+		GetCodeFn: func(checksum wasmvm.Checksum) (wasmvm.WasmCode, error) {
+			return nil, nil
+		},
+		PinFn: func(checksum wasmvm.Checksum) error {
+			queryVMPinned = append(queryVMPinned, checksum)
+			return nil
+		},
+		UnpinFn: func(checksum wasmvm.Checksum) error {
+			queryVMPinned = nil
+			return nil
+		},
+	}
+	myCodeID := StoreRandomContract(t, ctx, keepers, &mock).CodeID
+	var exp wasmvm.Checksum = k.GetCodeInfo(ctx, myCodeID).CodeHash
+
+	// when pinned
+	require.NoError(t, k.pinCode(ctx, myCodeID))
+	// then
+	assert.Equal(t, []wasmvm.Checksum{exp}, pinned)
+	assert.Equal(t, []wasmvm.Checksum{exp}, queryVMPinned)
+
+	// when initialized on restart
+	pinned, queryVMPinned = nil, nil
+	require.NoError(t, k.InitializePinnedCodes(ctx))
+	// then
+	assert.Equal(t, []wasmvm.Checksum{exp}, pinned)
+	assert.Equal(t, []wasmvm.Checksum{exp}, queryVMPinned)
+
+	// when unpinned
+	require.NoError(t, k.unpinCode(ctx, myCodeID))
+	// then
+	assert.Empty(t, pinned)
+	assert.Empty(t, queryVMPinned)
+}
+
+func TestPinCodeWithQueryWasmEngineRollback(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+
+	var pinned []wasmvm.Checksum
+	mock := wasmtesting.MockWasmer{
+		PinFn: func(checksum wasmvm.Checksum) error {
+			pinned = append(pinned, checksum)
+			return nil
+		},
+		UnpinFn: func(checksum wasmvm.Checksum) error {
+			pinned = nil
+			return nil
+		},
+	}
+	wasmtesting.MakeInstantiable(&mock)
+	k.queryWasmVM = &wasmtesting.MockWasmer{
+		GetCodeFn: func(checksum wasmvm.Checksum) (wasmvm.WasmCode, error) {
+			return nil, nil
+		},
+		PinFn: func(checksum wasmvm.Checksum) error {
+			return errors.New("testing")
+		},
+	}
+	myCodeID := StoreRandomContract(t, ctx, keepers, &mock).CodeID
+
+	// when
+	gotErr := k.pinCode(ctx, myCodeID)
+	// then
+	require.ErrorIs(t, gotErr, types.ErrPinContractFailed)
+	assert.Empty(t, pinned)
+	assert.False(t, k.IsPinnedCode(ctx, myCodeID))
+}
+
+func TestQueryWasmEngineSyncsCode(t *testing.T) {
+	mainVM := &wasmtesting.MockWasmer{
+		GetCodeFn: func(checksum wasmvm.Checksum) (wasmvm.WasmCode, error) {
+			return []byte("my code"), nil
+		},
+	}
+	var stored []wasmvm.WasmCode
+	queryVM := &wasmtesting.MockWasmer{
+		GetCodeFn: func(checksum wasmvm.Checksum) (wasmvm.WasmCode, error) {
+			return nil, errors.New("not found")
+		},
+		StoreCodeUncheckedFn: func(code wasmvm.WasmCode) (wasmvm.Checksum, error) {
+			stored = append(stored, code)
+			return nil, nil
+		},
+	}
+	k := Keeper{wasmVM: mainVM, queryWasmVM: queryVM, queryVMCodes: newQueryVMCodes()}
+	ctx := sdk.Context{}.WithContext(context.Background())
+
+	// when not marked
+	got := k.queryWasmEngine(ctx, []byte("my checksum"))
+	// then
+	assert.Equal(t, mainVM, got)
+	assert.Empty(t, stored)
+
+	// when marked
+	got = k.queryWasmEngine(withQueryVM(ctx), []byte("my checksum"))
+	// then
+	assert.Equal(t, queryVM, got)
+	assert.Equal(t, []wasmvm.WasmCode{[]byte("my code")}, stored)
+
+	// when marked again
+	got = k.queryWasmEngine(withQueryVM(ctx), []byte("my checksum"))
+	// then code is not copied again
+	assert.Equal(t, queryVM, got)
+	assert.Len(t, stored, 1)
+}
+
+func TestQuerySmartWithQueryWasmEngine(t *testing.T) {
+	queryVM := &wasmtesting.MockWasmer{
+		GetCodeFn: func(checksum wasmvm.Checksum) (wasmvm.WasmCode, error) {
+			return nil, nil
+		},
+		QueryFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, queryMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) ([]byte, uint64, error) {
+			return []byte(`"from query vm"`), 1, nil
+		},
+	}
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithQueryWasmEngine(queryVM))
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	querier := Querier(k)
+
+	fromMainVM := fmt.Sprintf(`{"verifier":"%s"}`, example.VerifierAddr)
+	specs := map[string]struct {
+		isCheckTx     bool
+		contractQuery bool
+		expGRPC       string
+	}{
+		"block execution": {
+			expGRPC: fromMainVM,
+		},
+		"outside of block execution": {
+			isCheckTx: true,
+			expGRPC:   `"from query vm"`,
+		},
+		"query sent by contract outside of block execution": {
+			isCheckTx:     true,
+			contractQuery: true,
+			expGRPC:       fromMainVM,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			qCtx := ctx.WithIsCheckTx(spec.isCheckTx)
+			if spec.contractQuery {
+				qCtx = withContractQuery(qCtx)
+			}
+			// when
+			got, err := k.QuerySmart(qCtx, example.Contract, []byte(`{"verifier":{}}`))
+			// then direct queries always use the main instance
+			require.NoError(t, err)
+			assert.JSONEq(t, fromMainVM, string(got))
+
+			// and via grpc
+			rsp, err := querier.SmartContractState(sdk.WrapSDKContext(qCtx), &types.QuerySmartContractStateRequest{Address: example.Contract.String(), QueryData: []byte(`{"verifier":{}}`)})
+			require.NoError(t, err)
+			assert.JSONEq(t, spec.expGRPC, string(rsp.Data))
+		})
+	}
+}
+
 func TestPinnedContractLoops(t *testing.T) {
 	var capturedChecksums []wasmvm.Checksum
 	mock := wasmtesting.MockWasmer{PinFn: func(checksum wasmvm.Checksum) error {
@@ -2162,6 +2503,69 @@ func TestSetAccessConfig(t *testing.T) {
 	}
 }
 
+func TestSetAccessConfigConstraints(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	creatorAddr := RandomAccountAddress(t)
+	oneToken := sdk.NewCoins(sdk.NewInt64Coin("denom", 1))
+	twoTokens := sdk.NewCoins(sdk.NewInt64Coin("denom", 2))
+	existing := &types.InstantiateConstraints{NoAdmin: true, MaxFunds: twoTokens}
+	const codeID = 1
+
+	specs := map[string]struct {
+		authz          types.AuthorizationPolicy
+		newConstraints *types.InstantiateConstraints
+		expConstraints *types.InstantiateConstraints
+		expErr         bool
+	}{
+		"user - not set keeps existing": {
+			authz:          DefaultAuthorizationPolicy{},
+			expConstraints: existing,
+		},
+		"user - stricter": {
+			authz:          DefaultAuthorizationPolicy{},
+			newConstraints: &types.InstantiateConstraints{NoAdmin: true, MaxFunds: oneToken},
+			expConstraints: &types.InstantiateConstraints{NoAdmin: true, MaxFunds: oneToken},
+		},
+		"user - looser": {
+			authz:          DefaultAuthorizationPolicy{},
+			newConstraints: &types.InstantiateConstraints{MaxFunds: twoTokens},
+			expErr:         true,
+		},
+		"user - clear": {
+			authz:          DefaultAuthorizationPolicy{},
+			newConstraints: &types.InstantiateConstraints{},
+			expErr:         true,
+		},
+		"gov - looser": {
+			authz:          GovAuthorizationPolicy{},
+			newConstraints: &types.InstantiateConstraints{MaxFunds: twoTokens},
+			expConstraints: &types.InstantiateConstraints{MaxFunds: twoTokens},
+		},
+		"gov - clear": {
+			authz:          GovAuthorizationPolicy{},
+			newConstraints: &types.InstantiateConstraints{},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			k.storeCodeInfo(ctx, codeID, types.NewCodeInfo(nil, creatorAddr, types.AccessConfig{Permission: types.AccessTypeEverybody, Constraints: existing}))
+			newConfig := types.AccessConfig{Permission: types.AccessTypeEverybody, Constraints: spec.newConstraints}
+			// when
+			gotErr := k.setAccessConfig(ctx, codeID, creatorAddr, newConfig, spec.authz)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.Equal(t, existing, k.GetCodeInfo(ctx, codeID).InstantiateConfig.Constraints)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expConstraints, k.GetCodeInfo(ctx, codeID).InstantiateConfig.Constraints)
+		})
+	}
+}
+
 func TestAppendToContractHistory(t *testing.T) {
 	f := fuzz.New().Funcs(ModelFuzzers...)
 	pCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
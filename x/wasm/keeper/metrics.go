@@ -1,8 +1,14 @@
 package keeper
 
 import (
+	"strconv"
+	"sync"
+	"time"
+
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	"github.com/prometheus/client_golang/prometheus"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -73,3 +79,94 @@ func (p *WasmVMMetricsCollector) Collect(c chan<- prometheus.Metric) {
 	// We had to either scan the whole directory of potentially thousands of files or track the values when files are added or removed.
 	// Such a tracking would need to be on disk such that the values are not cleared when the node is restarted.
 }
+
+// contract entrypoints used as metric labels
+const (
+	entrypointInstantiate       = "instantiate"
+	entrypointExecute           = "execute"
+	entrypointMigrate           = "migrate"
+	entrypointSudo              = "sudo"
+	entrypointReply             = "reply"
+	entrypointQuery             = "query"
+	entrypointIBCChannelOpen    = "ibc_channel_open"
+	entrypointIBCChannelConnect = "ibc_channel_connect"
+	entrypointIBCChannelClose   = "ibc_channel_close"
+	entrypointIBCPacketReceive  = "ibc_packet_receive"
+	entrypointIBCPacketAck      = "ibc_packet_ack"
+	entrypointIBCPacketTimeout  = "ibc_packet_timeout"
+)
+
+// labelCodeIDOther is used for all code ids above the cardinality limit
+const labelCodeIDOther = "other"
+
+// ContractExecutionMetrics records the latency, gas consumed and errors of the contract calls into wasmvm.
+// The metrics are labeled by entrypoint and optionally by code id.
+type ContractExecutionMetrics struct {
+	// maxCodeIDs is the max number of distinct code id label values. The code id label is empty when 0.
+	maxCodeIDs uint32
+	mu         sync.Mutex
+	codeIDs    map[uint64]string
+
+	Duration *prometheus.HistogramVec
+	GasUsed  *prometheus.CounterVec
+	Errors   *prometheus.CounterVec
+}
+
+// NewContractExecutionMetrics constructor. The number of code id label values is capped by maxCodeIDs,
+// all other code ids are reported as "other". The code id label is not used when set to 0.
+func NewContractExecutionMetrics(maxCodeIDs uint32) *ContractExecutionMetrics {
+	labels := []string{"entrypoint", "code_id"}
+	return &ContractExecutionMetrics{
+		maxCodeIDs: maxCodeIDs,
+		codeIDs:    make(map[uint64]string),
+		Duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "wasmvm_execution_duration_seconds",
+			Help:    "Duration of contract calls into wasmvm",
+			Buckets: prometheus.ExponentialBuckets(0.0005, 2, 14),
+		}, labels),
+		GasUsed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "wasmvm_execution_gas_used_total",
+			Help: "Total sdk gas consumed by contract calls into wasmvm",
+		}, labels),
+		Errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "wasmvm_execution_errors_total",
+			Help: "Total number of failed contract calls into wasmvm",
+		}, labels),
+	}
+}
+
+// Register registers all metrics
+func (m *ContractExecutionMetrics) Register(r prometheus.Registerer) {
+	r.MustRegister(m.Duration, m.GasUsed, m.Errors)
+}
+
+// Observe records a contract call into wasmvm. Safe to call on a nil instance.
+func (m *ContractExecutionMetrics) Observe(entrypoint string, codeID uint64, duration time.Duration, gasUsed sdk.Gas, failed bool) {
+	if m == nil {
+		return
+	}
+	codeIDLabel := m.codeIDLabel(codeID)
+	m.Duration.WithLabelValues(entrypoint, codeIDLabel).Observe(duration.Seconds())
+	m.GasUsed.WithLabelValues(entrypoint, codeIDLabel).Add(float64(gasUsed))
+	if failed {
+		m.Errors.WithLabelValues(entrypoint, codeIDLabel).Inc()
+	}
+}
+
+// codeIDLabel returns the label value for the code id. Code ids are added on first use until the limit is reached.
+func (m *ContractExecutionMetrics) codeIDLabel(codeID uint64) string {
+	if m.maxCodeIDs == 0 {
+		return ""
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if v, ok := m.codeIDs[codeID]; ok {
+		return v
+	}
+	if uint32(len(m.codeIDs)) >= m.maxCodeIDs {
+		return labelCodeIDOther
+	}
+	v := strconv.FormatUint(codeID, 10)
+	m.codeIDs[codeID] = v
+	return v
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractExecutionMetricsCodeIDLabel(t *testing.T) {
+	specs := map[string]struct {
+		maxCodeIDs uint32
+		codeIDs    []uint64
+		exp        []string
+	}{
+		"label disabled": {
+			maxCodeIDs: 0,
+			codeIDs:    []uint64{1, 2},
+			exp:        []string{"", ""},
+		},
+		"within limit": {
+			maxCodeIDs: 2,
+			codeIDs:    []uint64{1, 2, 1},
+			exp:        []string{"1", "2", "1"},
+		},
+		"above limit": {
+			maxCodeIDs: 2,
+			codeIDs:    []uint64{1, 2, 3, 2},
+			exp:        []string{"1", "2", labelCodeIDOther, "2"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			m := NewContractExecutionMetrics(spec.maxCodeIDs)
+			got := make([]string, len(spec.codeIDs))
+			for i, id := range spec.codeIDs {
+				got[i] = m.codeIDLabel(id)
+			}
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestContractExecutionMetricsObserve(t *testing.T) {
+	m := NewContractExecutionMetrics(1)
+	m.Observe(entrypointExecute, 1, time.Millisecond, 100, false)
+	m.Observe(entrypointExecute, 1, time.Millisecond, 50, true)
+	m.Observe(entrypointExecute, 2, time.Millisecond, 10, true)
+
+	assert.Equal(t, float64(150), testutil.ToFloat64(m.GasUsed.WithLabelValues(entrypointExecute, "1")))
+	assert.Equal(t, float64(10), testutil.ToFloat64(m.GasUsed.WithLabelValues(entrypointExecute, labelCodeIDOther)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.Errors.WithLabelValues(entrypointExecute, "1")))
+	assert.Equal(t, 2, testutil.CollectAndCount(m.Duration))
+
+	// nil instance is a noop
+	var nilMetrics *ContractExecutionMetrics
+	nilMetrics.Observe(entrypointExecute, 1, time.Millisecond, 1, true)
+}
+
+func TestContractExecutionMetricsRecorded(t *testing.T) {
+	r := prometheus.NewRegistry()
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithVMExecutionMetrics(r, 10))
+	k := keepers.WasmKeeper
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	_, err := keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+	require.NoError(t, err)
+	_, err = keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"unknown":{}}`), nil)
+	require.Error(t, err)
+	_, err = k.QuerySmart(ctx, example.Contract, []byte(`{"verifier":{}}`))
+	require.NoError(t, err)
+
+	codeID := "1"
+	assert.Equal(t, float64(1), testutil.ToFloat64(k.metrics.Errors.WithLabelValues(entrypointExecute, codeID)))
+	assert.Equal(t, float64(0), testutil.ToFloat64(k.metrics.Errors.WithLabelValues(entrypointInstantiate, codeID)))
+	for _, ep := range []string{entrypointInstantiate, entrypointExecute, entrypointQuery} {
+		assert.Greater(t, testutil.ToFloat64(k.metrics.GasUsed.WithLabelValues(ep, codeID)), float64(0), ep)
+	}
+	// 3 entrypoints per metric
+	count, err := testutil.GatherAndCount(r, "wasmvm_execution_duration_seconds", "wasmvm_execution_gas_used_total")
+	require.NoError(t, err)
+	assert.Equal(t, 6, count)
+}
@@ -37,15 +37,28 @@ func TestModuleMigrations(t *testing.T) {
 				sp, _ := wasmApp.ParamsKeeper.GetSubspace(types.ModuleName)
 				sp.SetParamSet(ctx, &params)
 			},
-			exp: types.Params{
-				CodeUploadAccess:             types.AllowNobody,
-				InstantiateDefaultPermission: types.AccessTypeNobody,
-			},
+			exp: func() types.Params {
+				gasRegister := types.DefaultGasRegisterParams()
+				capabilities := wasmApp.WasmKeeper.VMCapabilityParams()
+				sizeLimits := types.DefaultSizeLimitParams()
+				return types.Params{
+					CodeUploadAccess:             types.AllowNobody,
+					InstantiateDefaultPermission: types.AccessTypeNobody,
+					GasRegister:                  &gasRegister,
+					Capabilities:                 &capabilities,
+					SizeLimits:                   &sizeLimits,
+				}
+			}(),
 		},
 		"fresh from genesis": {
 			startVersion: wasmApp.ModuleManager.GetVersionMap()[types.ModuleName], // latest
 			setup:        func(ctx sdk.Context) {},
-			exp:          types.DefaultParams(),
+			exp: func() types.Params {
+				params := types.DefaultParams()
+				capabilities := wasmApp.WasmKeeper.VMCapabilityParams()
+				params.Capabilities = &capabilities
+				return params
+			}(),
 		},
 	}
 	for name, spec := range specs {
@@ -63,7 +76,7 @@ func TestModuleMigrations(t *testing.T) {
 
 			// then
 			require.NoError(t, err)
-			var expModuleVersion uint64 = 4
+			var expModuleVersion uint64 = 8
 			assert.Equal(t, expModuleVersion, gotVM[types.ModuleName])
 			gotParams := wasmApp.WasmKeeper.GetParams(ctx)
 			assert.Equal(t, spec.exp, gotParams)
@@ -106,7 +119,7 @@ func TestAccessConfigMigrations(t *testing.T) {
 
 	// then
 	require.NoError(t, err)
-	var expModuleVersion uint64 = 4
+	var expModuleVersion uint64 = 8
 	assert.Equal(t, expModuleVersion, gotVM[types.ModuleName])
 
 	// any address was not migrated
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
@@ -100,11 +101,19 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		var err error
 		var events []sdk.Event
 		var data [][]byte
+		traceNode := startGasTrace(ctx, "submsg", contractAddr)
+		traceNode.SetAttribute("id", strconv.FormatUint(msg.ID, 10))
+		traceNode.SetAttribute("reply_on", msg.ReplyOn.String())
+		spanCtx, span := startChildSpan(subCtx, "submsg", contractAddr)
+		span.SetAttribute("wasm.submsg_id", strconv.FormatUint(msg.ID, 10))
+		span.SetAttribute("wasm.reply_on", msg.ReplyOn.String())
 		if limitGas {
-			events, data, err = d.dispatchMsgWithGasLimit(subCtx, contractAddr, ibcPort, msg.Msg, *msg.GasLimit)
+			events, data, err = d.dispatchMsgWithGasLimit(spanCtx, contractAddr, ibcPort, msg.Msg, *msg.GasLimit)
 		} else {
-			events, data, err = d.messenger.DispatchMsg(subCtx, contractAddr, ibcPort, msg.Msg)
+			events, data, err = d.messenger.DispatchMsg(spanCtx, contractAddr, ibcPort, msg.Msg)
 		}
+		span.End()
+		traceNode.End()
 
 		// if it succeeds, commit state changes from submessage, and pass on events to Event Manager
 		var filteredEvents []sdk.Event
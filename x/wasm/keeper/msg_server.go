@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -97,9 +98,7 @@ func (m msgServer) InstantiateContract2(goCtx context.Context, msg *types.MsgIns
 
 	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
 
-	addrGenerator := PredicableAddressGenerator(senderAddr, msg.Salt, msg.Msg, msg.FixMsg)
-
-	contractAddr, data, err := m.keeper.instantiate(ctx, msg.CodeID, senderAddr, adminAddr, msg.Msg, msg.Label, msg.Funds, addrGenerator, policy)
+	contractAddr, data, err := m.keeper.instantiate2(ctx, msg.CodeID, senderAddr, adminAddr, msg.Msg, msg.Label, msg.Funds, msg.Salt, msg.FixMsg, policy)
 	if err != nil {
 		return nil, err
 	}
@@ -243,6 +242,10 @@ func (m msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 		return nil, errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", authority, req.Authority)
 	}
 
+	if missing := m.keeper.unsupportedCapabilities(req.Params); len(missing) != 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "capabilities not supported by wasmvm: %s", strings.Join(missing, ","))
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if err := m.keeper.SetParams(ctx, req.Params); err != nil {
 		return nil, err
@@ -406,16 +409,7 @@ func (m msgServer) RemoveCodeUploadParamsAddresses(goCtx context.Context, req *t
 	if params.CodeUploadAccess.Permission != types.AccessTypeAnyOfAddresses {
 		return nil, errorsmod.Wrap(types.ErrInvalid, "permission")
 	}
-	addresses := params.CodeUploadAccess.Addresses
-	newAddresses := make([]string, 0)
-	for _, addr := range addresses {
-		if contains(req.Addresses, addr) {
-			continue
-		}
-		newAddresses = append(newAddresses, addr)
-	}
-
-	params.CodeUploadAccess.Addresses = newAddresses
+	params.CodeUploadAccess = params.CodeUploadAccess.WithoutAddresses(req.Addresses...)
 
 	if err := m.keeper.SetParams(ctx, params); err != nil {
 		return nil, err
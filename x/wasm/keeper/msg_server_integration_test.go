@@ -975,6 +975,208 @@ func TestInstantiateContract2(t *testing.T) {
 	}
 }
 
+func TestSizeLimits(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                = wasmApp.WasmKeeper.GetAuthority()
+	)
+	params := wasmApp.WasmKeeper.GetParams(ctx)
+	params.SizeLimits = &types.SizeLimitParams{
+		MaxWasmSize:         uint64(len(wasmContract)) - 1,
+		MaxProposalWasmSize: uint64(len(wasmContract)),
+		MaxLabelSize:        5,
+		MaxSaltSize:         2,
+	}
+	require.NoError(t, wasmApp.WasmKeeper.SetParams(ctx, params))
+
+	// store a code with the authority to instantiate
+	storeMsg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+		m.WASMByteCode = wasmContract
+		m.Sender = authority
+	})
+	rsp, err := wasmApp.MsgServiceRouter().Handler(storeMsg)(ctx, storeMsg)
+	require.NoError(t, err)
+	var result types.MsgStoreCodeResponse
+	require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &result))
+
+	specs := map[string]struct {
+		src    sdk.Msg
+		expErr bool
+	}{
+		"store code exceeds max wasm size": {
+			src: types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+				m.WASMByteCode = wasmContract
+				m.Sender = myAddress.String()
+			}),
+			expErr: true,
+		},
+		"store code by authority within max proposal wasm size": {
+			src: types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+				m.WASMByteCode = wasmContract
+				m.Sender = authority
+			}),
+		},
+		"store code by authority exceeds max proposal wasm size": {
+			src: types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+				m.WASMByteCode = append(append([]byte{}, wasmContract...), 0)
+				m.Sender = authority
+			}),
+			expErr: true,
+		},
+		"instantiate with label within limit": {
+			src: &types.MsgInstantiateContract{
+				Sender: authority,
+				CodeID: result.CodeID,
+				Label:  "12345",
+				Msg:    []byte(`{}`),
+			},
+		},
+		"instantiate with label exceeds limit": {
+			src: &types.MsgInstantiateContract{
+				Sender: authority,
+				CodeID: result.CodeID,
+				Label:  "123456",
+				Msg:    []byte(`{}`),
+			},
+			expErr: true,
+		},
+		"instantiate2 with salt within limit": {
+			src: &types.MsgInstantiateContract2{
+				Sender: authority,
+				CodeID: result.CodeID,
+				Label:  "label",
+				Msg:    []byte(`{}`),
+				Salt:   []byte("ab"),
+			},
+		},
+		"instantiate2 with salt exceeds limit": {
+			src: &types.MsgInstantiateContract2{
+				Sender: authority,
+				CodeID: result.CodeID,
+				Label:  "label",
+				Msg:    []byte(`{}`),
+				Salt:   []byte("abc"),
+			},
+			expErr: true,
+		},
+		"instantiate2 with label exceeds limit": {
+			src: &types.MsgInstantiateContract2{
+				Sender: authority,
+				CodeID: result.CodeID,
+				Label:  "123456",
+				Msg:    []byte(`{}`),
+				Salt:   []byte("cd"),
+			},
+			expErr: true,
+		},
+		"store and instantiate with label exceeds limit": {
+			src: &types.MsgStoreAndInstantiateContract{
+				Authority:    authority,
+				WASMByteCode: wasmContract,
+				Label:        "123456",
+				Msg:          []byte(`{}`),
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+
+			// when
+			_, err := wasmApp.MsgServiceRouter().Handler(spec.src)(ctx, spec.src)
+
+			// then
+			if spec.expErr {
+				require.ErrorIs(t, err, types.ErrLimit)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSizeLimitsWithContractKeeper(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                = sdk.MustAccAddressFromBech32(wasmApp.WasmKeeper.GetAuthority())
+	)
+	params := wasmApp.WasmKeeper.GetParams(ctx)
+	params.SizeLimits = &types.SizeLimitParams{
+		MaxWasmSize:         uint64(len(wasmContract)) - 1,
+		MaxProposalWasmSize: uint64(len(wasmContract)),
+		MaxLabelSize:        5,
+		MaxSaltSize:         2,
+	}
+	require.NoError(t, wasmApp.WasmKeeper.SetParams(ctx, params))
+	govKeeper := keeper.NewGovPermissionKeeper(&wasmApp.WasmKeeper)
+	defaultKeeper := keeper.NewDefaultPermissionKeeper(&wasmApp.WasmKeeper)
+
+	codeID, _, err := govKeeper.Create(ctx, authority, wasmContract, nil)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		do     func(ctx sdk.Context) error
+		expErr bool
+	}{
+		"create exceeds max wasm size": {
+			do: func(ctx sdk.Context) error {
+				_, _, err := defaultKeeper.Create(ctx, myAddress, wasmContract, nil)
+				return err
+			},
+			expErr: true,
+		},
+		"gov create exceeds max proposal wasm size": {
+			do: func(ctx sdk.Context) error {
+				_, _, err := govKeeper.Create(ctx, authority, append(append([]byte{}, wasmContract...), 0), nil)
+				return err
+			},
+			expErr: true,
+		},
+		"gov instantiate with label within limit": {
+			do: func(ctx sdk.Context) error {
+				_, _, err := govKeeper.Instantiate(ctx, codeID, authority, nil, []byte(`{}`), "12345", nil)
+				return err
+			},
+		},
+		"gov instantiate with label exceeds limit": {
+			do: func(ctx sdk.Context) error {
+				_, _, err := govKeeper.Instantiate(ctx, codeID, authority, nil, []byte(`{}`), "123456", nil)
+				return err
+			},
+			expErr: true,
+		},
+		"gov instantiate2 with salt exceeds limit": {
+			do: func(ctx sdk.Context) error {
+				_, _, err := govKeeper.Instantiate2(ctx, codeID, authority, nil, []byte(`{}`), "label", nil, []byte("abc"), false)
+				return err
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+
+			// when
+			err := spec.do(ctx)
+
+			// then
+			if spec.expErr {
+				require.ErrorIs(t, err, types.ErrLimit)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestUpdateInstantiateConfig(t *testing.T) {
 	wasmApp := app.Setup(t)
 	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
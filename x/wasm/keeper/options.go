@@ -5,6 +5,7 @@ import (
 	"reflect"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -32,6 +33,14 @@ func WithWasmEngine(x types.WasmerEngine) Option {
 	})
 }
 
+// WithQueryWasmEngine is an optional constructor parameter to set the wasmVM engine for smart queries
+// via gRPC. See `WasmConfig.QueryMemoryCacheSize` for the default engine.
+func WithQueryWasmEngine(x types.WasmerEngine) Option {
+	return optsFn(func(k *Keeper) {
+		k.queryWasmVM = x
+	})
+}
+
 // WithWasmEngineDecorator is an optional constructor parameter to decorate the default wasmVM engine.
 func WithWasmEngineDecorator(d func(old types.WasmerEngine) types.WasmerEngine) Option {
 	return postOptsFn(func(k *Keeper) {
@@ -131,7 +140,41 @@ func WithVMCacheMetrics(r prometheus.Registerer) Option {
 	})
 }
 
+// WithVMExecutionMetrics records latency, gas and error metrics for all contract calls into wasmvm.
+// The number of distinct code id label values is capped by maxCodeIDLabels. The code id label
+// is not used when set to 0.
+func WithVMExecutionMetrics(r prometheus.Registerer, maxCodeIDLabels uint32) Option {
+	return optsFn(func(k *Keeper) {
+		m := NewContractExecutionMetrics(maxCodeIDLabels)
+		m.Register(r)
+		k.metrics = m
+	})
+}
+
+// WithTracerProvider records OpenTelemetry spans for the keeper operations, sub-messages and contract queries
+// with the given provider. See NewTracerProvider for a provider configured with the wasm config.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	if tp == nil {
+		panic("must not be nil")
+	}
+	return optsFn(func(k *Keeper) {
+		k.tracer = tp.Tracer(tracerName)
+	})
+}
+
+// WithCodeUsageTracker counts the instantiations and executions per code for pin recommendations.
+// See `WasmConfig.CodeUsageTracking` for the default tracker.
+func WithCodeUsageTracker(x *CodeUsageTracker) Option {
+	if x == nil {
+		panic("must not be nil")
+	}
+	return optsFn(func(k *Keeper) {
+		k.codeUsage = x
+	})
+}
+
 // WithGasRegister set a new gas register to implement custom gas costs.
+// The gas costs in the on-chain params are ignored when a custom register is set.
 // When the "gas multiplier" for wasmvm gas conversion is modified inside the new register,
 // make sure to also use `WithApiCosts` option for non default values
 func WithGasRegister(x GasRegister) Option {
@@ -144,10 +187,10 @@ func WithGasRegister(x GasRegister) Option {
 }
 
 // WithAPICosts sets custom api costs. Amounts are in cosmwasm gas Not SDK gas.
+// They are not scaled with the gas multiplier param.
 func WithAPICosts(human, canonical uint64) Option {
-	return optsFn(func(_ *Keeper) {
-		costHumanize = human
-		costCanonical = canonical
+	return optsFn(func(k *Keeper) {
+		k.apiCosts = &apiCosts{human: human, canonical: canonical}
 	})
 }
 
@@ -6,8 +6,10 @@ import (
 	"testing"
 
 	wasmvm "github.com/CosmWasm/wasmvm"
+	dbm "github.com/cometbft/cometbft-db"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
 
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -41,6 +43,28 @@ func TestConstructorOptions(t *testing.T) {
 			},
 			isPostOpt: true,
 		},
+		"vm execution metrics": {
+			srcOpt: WithVMExecutionMetrics(prometheus.NewRegistry(), 2),
+			verify: func(t *testing.T, k Keeper) {
+				t.Helper()
+				require.NotNil(t, k.metrics)
+				assert.Equal(t, uint32(2), k.metrics.maxCodeIDs)
+			},
+		},
+		"tracer provider": {
+			srcOpt: WithTracerProvider(trace.NewNoopTracerProvider()),
+			verify: func(t *testing.T, k Keeper) {
+				t.Helper()
+				assert.NotNil(t, k.tracer)
+			},
+		},
+		"code usage tracker": {
+			srcOpt: WithCodeUsageTracker(NewCodeUsageTracker(dbm.NewMemDB())),
+			verify: func(t *testing.T, k Keeper) {
+				t.Helper()
+				assert.NotNil(t, k.codeUsage)
+			},
+		},
 		"decorate wasmvm": {
 			srcOpt: WithWasmEngineDecorator(func(old types.WasmerEngine) types.WasmerEngine {
 				require.IsType(t, &wasmvm.VM{}, old)
@@ -106,9 +130,7 @@ func TestConstructorOptions(t *testing.T) {
 			srcOpt: WithAPICosts(1, 2),
 			verify: func(t *testing.T, k Keeper) {
 				t.Helper()
-				t.Cleanup(setAPIDefaults)
-				assert.Equal(t, uint64(1), costHumanize)
-				assert.Equal(t, uint64(2), costCanonical)
+				assert.Equal(t, &apiCosts{human: 1, canonical: 2}, k.apiCosts)
 			},
 		},
 		"max recursion query limit": {
@@ -159,11 +181,6 @@ func TestConstructorOptions(t *testing.T) {
 	}
 }
 
-func setAPIDefaults() {
-	costHumanize = DefaultGasCostHumanAddress * DefaultGasMultiplier
-	costCanonical = DefaultGasCostCanonicalAddress * DefaultGasMultiplier
-}
-
 func TestSplitOpts(t *testing.T) {
 	a := optsFn(nil)
 	b := optsFn(nil)
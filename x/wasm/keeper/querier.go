@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"runtime/debug"
@@ -27,6 +28,22 @@ type GrpcQuerier struct {
 	storeKey      storetypes.StoreKey
 	keeper        types.ViewKeeper
 	queryGasLimit sdk.Gas
+	// smartQueryLimiter limits smart queries outside of block execution. Nil when unlimited
+	smartQueryLimiter *smartQueryLimiter
+	// smartQueryCache stores smart query results outside of block execution. Nil when disabled
+	smartQueryCache *smartQueryCache
+	// pinRecommender ranks codes by usage. Nil when code usage tracking is disabled
+	pinRecommender pinRecommender
+	// executeSimulator runs contract executions without persisting state. Nil when not backed by a keeper
+	executeSimulator executeSimulator
+}
+
+type pinRecommender interface {
+	pinRecommendations(ctx sdk.Context, limit uint32) ([]types.PinRecommendation, error)
+}
+
+type executeSimulator interface {
+	SimulateExecute(ctx sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (*types.QuerySimulateExecuteResponse, error)
 }
 
 // NewGrpcQuerier constructor
@@ -147,6 +164,69 @@ func (q GrpcQuerier) AllContractState(c context.Context, req *types.QueryAllCont
 	}, nil
 }
 
+// ContractStateRange returns the raw store data of a contract within the requested key range
+func (q GrpcQuerier) ContractStateRange(c context.Context, req *types.QueryContractStateRangeRequest) (*types.QueryContractStateRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	start, end := nilIfEmpty(req.StartKey), nilIfEmpty(req.EndKey)
+	if start != nil && end != nil && bytes.Compare(start, end) >= 0 {
+		return nil, status.Error(codes.InvalidArgument, "start key must be before end key")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.keeper.HasContractInfo(ctx, contractAddr) {
+		return nil, types.ErrNoSuchContractFn(contractAddr.String()).
+			Wrapf("address %s", contractAddr.String())
+	}
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	contractStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetContractStorePrefix(contractAddr))
+	rangeStore := prefix.NewStore(contractStore, req.Prefix)
+	var iter storetypes.Iterator
+	if req.Reverse {
+		iter = rangeStore.ReverseIterator(start, end)
+	} else {
+		iter = rangeStore.Iterator(start, end)
+	}
+	defer iter.Close()
+
+	var nextKey, lastKey []byte
+	r := make([]types.Model, 0)
+	for ; iter.Valid(); iter.Next() {
+		if len(r) == limit {
+			// the end key is exclusive so that a reverse scan continues with the last key returned
+			nextKey = iter.Key()
+			if req.Reverse {
+				nextKey = lastKey
+			}
+			break
+		}
+		lastKey = iter.Key()
+		r = append(r, types.Model{
+			Key:   append(append([]byte{}, req.Prefix...), lastKey...),
+			Value: iter.Value(),
+		})
+	}
+	return &types.QueryContractStateRangeResponse{
+		Models:  r,
+		NextKey: nextKey,
+	}, nil
+}
+
+func nilIfEmpty(bz []byte) []byte {
+	if len(bz) == 0 {
+		return nil
+	}
+	return bz
+}
+
 func (q GrpcQuerier) RawContractState(c context.Context, req *types.QueryRawContractStateRequest) (*types.QueryRawContractStateResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -166,7 +246,7 @@ func (q GrpcQuerier) RawContractState(c context.Context, req *types.QueryRawCont
 	return &types.QueryRawContractStateResponse{Data: rsp}, nil
 }
 
-func (q GrpcQuerier) SmartContractState(c context.Context, req *types.QuerySmartContractStateRequest) (rsp *types.QuerySmartContractStateResponse, err error) {
+func (q GrpcQuerier) SmartContractState(c context.Context, req *types.QuerySmartContractStateRequest) (*types.QuerySmartContractStateResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -177,7 +257,38 @@ func (q GrpcQuerier) SmartContractState(c context.Context, req *types.QuerySmart
 	if err != nil {
 		return nil, err
 	}
-	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(sdk.NewGasMeter(q.queryGasLimit))
+	ctx := sdk.UnwrapSDKContext(c)
+	limiter, cache := q.smartQueryLimiter, q.smartQueryCache
+	switch {
+	case !ctx.IsCheckTx(): // consensus paths are never limited or cached
+		limiter, cache = nil, nil
+	case isContractQuery(ctx): // queries by contracts run on the store of the tx and are never limited or cached
+		limiter, cache = nil, nil
+	default: // queries from clients can use the separate query wasmvm instance
+		ctx = withQueryVM(ctx)
+	}
+	if bz := cache.Get(ctx.BlockHeight(), contractAddr, req.QueryData); bz != nil {
+		return &types.QuerySmartContractStateResponse{Data: bz}, nil
+	}
+	bz, err := limiter.run(c, func() ([]byte, error) {
+		return q.querySmart(ctx, contractAddr, req.QueryData)
+	})
+	if err == nil && bz != nil {
+		cache.Add(ctx.BlockHeight(), contractAddr, req.QueryData, bz)
+	}
+	switch {
+	case err != nil:
+		return nil, err
+	case bz == nil:
+		return nil, types.ErrNoSuchContractFn(contractAddr.String()).
+			Wrapf("address %s", contractAddr.String())
+	}
+	return &types.QuerySmartContractStateResponse{Data: bz}, nil
+}
+
+// querySmart executes the smart query with the query gas limit and recovers from panics
+func (q GrpcQuerier) querySmart(ctx sdk.Context, contractAddr sdk.AccAddress, queryData []byte) (bz []byte, err error) {
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(q.queryGasLimit))
 	// recover from out-of-gas panic
 	defer func() {
 		if r := recover(); r != nil {
@@ -190,24 +301,61 @@ func (q GrpcQuerier) SmartContractState(c context.Context, req *types.QuerySmart
 			default:
 				err = sdkerrors.ErrPanic
 			}
-			rsp = nil
+			bz = nil
 			moduleLogger(ctx).
 				Debug("smart query contract",
 					"error", "recovering panic",
-					"contract-address", req.Address,
+					"contract-address", contractAddr.String(),
 					"stacktrace", string(debug.Stack()))
 		}
 	}()
+	return q.keeper.QuerySmart(ctx, contractAddr, queryData)
+}
 
-	bz, err := q.keeper.QuerySmart(ctx, contractAddr, req.QueryData)
-	switch {
-	case err != nil:
-		return nil, err
-	case bz == nil:
-		return nil, types.ErrNoSuchContractFn(contractAddr.String()).
-			Wrapf("address %s", contractAddr.String())
+// SimulateExecute runs a contract execution on a branched state that is never committed
+func (q GrpcQuerier) SimulateExecute(c context.Context, req *types.QuerySimulateExecuteRequest) (rsp *types.QuerySimulateExecuteResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
-	return &types.QuerySmartContractStateResponse{Data: bz}, nil
+	if q.executeSimulator == nil {
+		return nil, status.Error(codes.Unavailable, "execute simulation is not supported by this querier")
+	}
+	if err := req.Msg.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid msg")
+	}
+	if !req.Funds.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid funds")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+	senderAddr, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(sdk.NewGasMeter(q.queryGasLimit))
+	// recover from out-of-gas panic
+	defer func() {
+		if r := recover(); r != nil {
+			switch rType := r.(type) {
+			case sdk.ErrorOutOfGas:
+				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas,
+					"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
+					rType.Descriptor, ctx.GasMeter().Limit(), ctx.GasMeter().GasConsumed(),
+				)
+			default:
+				err = sdkerrors.ErrPanic
+			}
+			rsp = nil
+			moduleLogger(ctx).
+				Debug("simulate execute contract",
+					"error", "recovering panic",
+					"contract-address", req.Contract,
+					"stacktrace", string(debug.Stack()))
+		}
+	}()
+	return q.executeSimulator.SimulateExecute(ctx, contractAddr, senderAddr, req.Msg, req.Funds)
 }
 
 func (q GrpcQuerier) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
@@ -243,11 +391,13 @@ func (q GrpcQuerier) Codes(c context.Context, req *types.QueryCodesRequest) (*ty
 			if err := q.cdc.Unmarshal(value, &c); err != nil {
 				return false, err
 			}
+			codeID := binary.BigEndian.Uint64(key)
 			r = append(r, types.CodeInfoResponse{
-				CodeID:                binary.BigEndian.Uint64(key),
+				CodeID:                codeID,
 				Creator:               c.Creator,
 				DataHash:              c.CodeHash,
 				InstantiatePermission: c.InstantiateConfig,
+				Analysis:              q.keeper.GetCodeAnalysis(ctx, codeID),
 			})
 		}
 		return true, nil
@@ -284,6 +434,7 @@ func queryCode(ctx sdk.Context, codeID uint64, keeper types.ViewKeeper) (*types.
 		Creator:               res.Creator,
 		DataHash:              res.CodeHash,
 		InstantiatePermission: res.InstantiateConfig,
+		Analysis:              keeper.GetCodeAnalysis(ctx, codeID),
 	}
 
 	code, err := keeper.GetByteCode(ctx, codeID)
@@ -352,3 +503,31 @@ func (q GrpcQuerier) ContractsByCreator(c context.Context, req *types.QueryContr
 		Pagination:        pageRes,
 	}, nil
 }
+
+// PinRecommendations ranks the unpinned codes by the estimated gas savings with the usage data of this node
+func (q GrpcQuerier) PinRecommendations(c context.Context, req *types.QueryPinRecommendationsRequest) (*types.QueryPinRecommendationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if q.pinRecommender == nil {
+		return nil, status.Error(codes.Unavailable, "code usage tracking is disabled on this node")
+	}
+	r, err := q.pinRecommender.pinRecommendations(sdk.UnwrapSDKContext(c), req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPinRecommendationsResponse{Recommendations: r}, nil
+}
+
+// UploadQuota returns the remaining code upload quota of the address
+func (q GrpcQuerier) UploadQuota(c context.Context, req *types.QueryUploadQuotaRequest) (*types.QueryUploadQuotaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	rsp := q.keeper.UploadQuota(sdk.UnwrapSDKContext(c), addr)
+	return &rsp, nil
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
 	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -208,6 +209,109 @@ func TestQuerySmartContractPanics(t *testing.T) {
 	}
 }
 
+func TestQuerySmartContractStateLimits(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	contractAddr := BuildContractAddressClassic(1, 1)
+	keepers.WasmKeeper.storeCodeInfo(ctx, 1, types.CodeInfo{})
+	keepers.WasmKeeper.storeContractInfo(ctx, contractAddr, &types.ContractInfo{
+		CodeID:  1,
+		Created: types.NewAbsoluteTxPosition(ctx),
+	})
+	keepers.WasmKeeper.wasmVM = &wasmtesting.MockWasmer{QueryFn: func(checksum wasmvm.Checksum, env wasmvmtypes.Env, queryMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) ([]byte, uint64, error) {
+		time.Sleep(50 * time.Millisecond)
+		return []byte(`{}`), 0, nil
+	}}
+	keepers.WasmKeeper.smartQueryLimiter = newSmartQueryLimiter(0, time.Millisecond)
+	req := &types.QuerySmartContractStateRequest{Address: contractAddr.String(), QueryData: types.RawContractMessage("{}")}
+
+	specs := map[string]struct {
+		checkTx       bool
+		contractQuery bool
+		expCode       codes.Code
+	}{
+		"node local query limited": {
+			checkTx: true,
+			expCode: codes.DeadlineExceeded,
+		},
+		"consensus query not limited": {
+			checkTx: false,
+			expCode: codes.OK,
+		},
+		"contract query in check tx not limited": {
+			checkTx:       true,
+			contractQuery: true,
+			expCode:       codes.OK,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			q := Querier(keepers.WasmKeeper)
+			qCtx := ctx.WithIsCheckTx(spec.checkTx)
+			if spec.contractQuery {
+				qCtx = withContractQuery(qCtx)
+			}
+			got, gotErr := q.SmartContractState(sdk.WrapSDKContext(qCtx), req)
+			if spec.expCode != codes.OK {
+				assert.Equal(t, spec.expCode, status.Code(gotErr))
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, types.RawContractMessage(`{}`), got.Data)
+		})
+	}
+}
+
+func TestQuerySmartContractStateCache(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	contractAddr := BuildContractAddressClassic(1, 1)
+	keepers.WasmKeeper.storeCodeInfo(ctx, 1, types.CodeInfo{})
+	keepers.WasmKeeper.storeContractInfo(ctx, contractAddr, &types.ContractInfo{
+		CodeID:  1,
+		Created: types.NewAbsoluteTxPosition(ctx),
+	})
+	var queryCount int
+	keepers.WasmKeeper.wasmVM = &wasmtesting.MockWasmer{QueryFn: func(checksum wasmvm.Checksum, env wasmvmtypes.Env, queryMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) ([]byte, uint64, error) {
+		queryCount++
+		return []byte(fmt.Sprintf(`{"height":%d}`, env.Block.Height)), 0, nil
+	}}
+	keepers.WasmKeeper.smartQueryCache = newSmartQueryCache(1024)
+	req := &types.QuerySmartContractStateRequest{Address: contractAddr.String(), QueryData: types.RawContractMessage("{}")}
+	q := Querier(keepers.WasmKeeper)
+	height := ctx.BlockHeight()
+	expData := fmt.Sprintf(`{"height":%d}`, height)
+
+	// when queried on a node twice
+	for i := 0; i < 2; i++ {
+		got, err := q.SmartContractState(sdk.WrapSDKContext(ctx.WithIsCheckTx(true)), req)
+		require.NoError(t, err)
+		assert.Equal(t, expData, string(got.Data))
+	}
+	// then the result was cached
+	assert.Equal(t, 1, queryCount)
+
+	// when queried in consensus
+	got, err := q.SmartContractState(sdk.WrapSDKContext(ctx.WithIsCheckTx(false)), req)
+	// then the cache is not used
+	require.NoError(t, err)
+	assert.Equal(t, expData, string(got.Data))
+	assert.Equal(t, 2, queryCount)
+
+	// when queried by a contract in check tx
+	got, err = q.SmartContractState(sdk.WrapSDKContext(withContractQuery(ctx.WithIsCheckTx(true))), req)
+	// then the cache is not used
+	require.NoError(t, err)
+	assert.Equal(t, expData, string(got.Data))
+	assert.Equal(t, 3, queryCount)
+
+	// when queried on a node for a new height
+	got, err = q.SmartContractState(sdk.WrapSDKContext(ctx.WithIsCheckTx(true).WithBlockHeight(height+1)), req)
+	// then the cache was invalidated
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf(`{"height":%d}`, height+1), string(got.Data))
+	assert.Equal(t, 4, queryCount)
+}
+
 func TestQueryRawContractState(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	keeper := keepers.WasmKeeper
@@ -265,6 +369,200 @@ func TestQueryRawContractState(t *testing.T) {
 	}
 }
 
+func TestQueryContractStateRange(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractAddr := exampleContract.Contract.String()
+	balancePrefix := []byte("\x00\x07balance")
+	prefixed := func(k string) []byte { return append(append([]byte{}, balancePrefix...), k...) }
+	contractModel := []types.Model{
+		{Key: prefixed("alice"), Value: []byte(`"1"`)},
+		{Key: prefixed("bob"), Value: []byte(`"2"`)},
+		{Key: prefixed("carl"), Value: []byte(`"3"`)},
+		{Key: []byte("\x00\x08balances"), Value: []byte(`"other"`)},
+	}
+	require.NoError(t, keeper.importContractState(ctx, exampleContract.Contract, contractModel))
+
+	randomAddr := RandomBech32AccountAddress(t)
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcQuery   *types.QueryContractStateRangeRequest
+		expModels  []types.Model
+		expNextKey []byte
+		expErr     error
+	}{
+		"all with prefix": {
+			srcQuery:  &types.QueryContractStateRangeRequest{Address: contractAddr, Prefix: balancePrefix},
+			expModels: contractModel[0:3],
+		},
+		"start and end": {
+			srcQuery:  &types.QueryContractStateRangeRequest{Address: contractAddr, Prefix: balancePrefix, StartKey: []byte("b"), EndKey: []byte("carl")},
+			expModels: contractModel[1:2],
+		},
+		"with limit": {
+			srcQuery:   &types.QueryContractStateRangeRequest{Address: contractAddr, Prefix: balancePrefix, Limit: 2},
+			expModels:  contractModel[0:2],
+			expNextKey: []byte("carl"),
+		},
+		"reverse": {
+			srcQuery:  &types.QueryContractStateRangeRequest{Address: contractAddr, Prefix: balancePrefix, Reverse: true},
+			expModels: []types.Model{contractModel[2], contractModel[1], contractModel[0]},
+		},
+		"reverse with limit": {
+			srcQuery:   &types.QueryContractStateRangeRequest{Address: contractAddr, Prefix: balancePrefix, Reverse: true, Limit: 2},
+			expModels:  []types.Model{contractModel[2], contractModel[1]},
+			expNextKey: []byte("bob"),
+		},
+		"reverse continued with next key": {
+			srcQuery:  &types.QueryContractStateRangeRequest{Address: contractAddr, Prefix: balancePrefix, Reverse: true, EndKey: []byte("bob")},
+			expModels: contractModel[0:1],
+		},
+		"without prefix": {
+			srcQuery:  &types.QueryContractStateRangeRequest{Address: contractAddr, StartKey: []byte("\x00\x08"), EndKey: []byte("\x00\x09")},
+			expModels: contractModel[3:],
+		},
+		"empty range": {
+			srcQuery:  &types.QueryContractStateRangeRequest{Address: contractAddr, Prefix: []byte("not existing")},
+			expModels: []types.Model{},
+		},
+		"start after end": {
+			srcQuery: &types.QueryContractStateRangeRequest{Address: contractAddr, StartKey: []byte("b"), EndKey: []byte("a")},
+			expErr:   status.Error(codes.InvalidArgument, "start key must be before end key"),
+		},
+		"unknown address": {
+			srcQuery: &types.QueryContractStateRangeRequest{Address: randomAddr},
+			expErr:   types.ErrNoSuchContractFn(randomAddr).Wrapf("address %s", randomAddr),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.ContractStateRange(sdk.WrapSDKContext(ctx), spec.srcQuery)
+			if spec.expErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, spec.expErr.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expModels, got.Models)
+			assert.Equal(t, spec.expNextKey, got.NextKey)
+		})
+	}
+}
+
+func TestQuerySimulateExecute(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, ReflectFeatures)
+	keeper := keepers.ContractKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+	_, bob := keyPubAddr()
+
+	reflectID, _, err := keeper.Create(ctx, creator, testdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+	reflectStart := sdk.NewCoins(sdk.NewInt64Coin("denom", 40000))
+	reflectAddr, _, err := keeper.Instantiate(ctx, reflectID, creator, nil, []byte("{}"), "reflect", reflectStart)
+	require.NoError(t, err)
+
+	// escrow releases funds when called by the reflect contract
+	hackatom := StoreHackatomExampleContract(t, ctx, keepers)
+	_, beneficiary := keyPubAddr()
+	escrowInit := HackatomExampleInitMsg{Verifier: reflectAddr, Beneficiary: beneficiary}.GetBytes(t)
+	escrowAddr, _, err := keeper.Instantiate(ctx, hackatom.CodeID, creator, nil, escrowInit, "escrow", sdk.NewCoins(sdk.NewInt64Coin("denom", 100)))
+	require.NoError(t, err)
+	escrowBalance := keepers.BankKeeper.GetAllBalances(ctx, escrowAddr)
+
+	// the reflect contract stores its state in the "config" namespace
+	configKey := append([]byte{0, 6}, "config"...)
+
+	changeOwnerMsg := mustMarshal(t, testdata.ReflectHandleMsg{ChangeOwner: &testdata.OwnerPayload{Owner: bob}})
+	reflectMsg := mustMarshal(t, testdata.ReflectHandleMsg{Reflect: &testdata.ReflectPayload{Msgs: []wasmvmtypes.CosmosMsg{{
+		Wasm: &wasmvmtypes.WasmMsg{Execute: &wasmvmtypes.ExecuteMsg{ContractAddr: escrowAddr.String(), Msg: []byte(`{"release":{}}`)}},
+	}}}})
+	releaseMsg := mustMarshal(t, map[string]any{"release": struct{}{}})
+
+	q := Querier(keepers.WasmKeeper)
+	specs := map[string]struct {
+		srcReq          *types.QuerySimulateExecuteRequest
+		expSubMsgs      []string
+		expStateChanges []types.ContractStateChange
+		expErr          bool
+	}{
+		"state changes": {
+			srcReq: &types.QuerySimulateExecuteRequest{Sender: creator.String(), Contract: reflectAddr.String(), Msg: changeOwnerMsg},
+			expStateChanges: []types.ContractStateChange{{
+				Contract: reflectAddr.String(),
+				Key:      configKey,
+				OldValue: []byte(fmt.Sprintf(`{"owner":%q}`, creator.String())),
+				NewValue: []byte(fmt.Sprintf(`{"owner":%q}`, bob.String())),
+			}},
+		},
+		"sub-messages dispatched": {
+			srcReq:          &types.QuerySimulateExecuteRequest{Sender: creator.String(), Contract: reflectAddr.String(), Msg: reflectMsg},
+			expSubMsgs:      []string{reflectAddr.String(), escrowAddr.String()},
+			expStateChanges: []types.ContractStateChange{},
+		},
+		"with funds": {
+			srcReq: &types.QuerySimulateExecuteRequest{
+				Sender: reflectAddr.String(), Contract: escrowAddr.String(), Msg: releaseMsg,
+				Funds: sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+			},
+			expSubMsgs:      []string{escrowAddr.String()},
+			expStateChanges: []types.ContractStateChange{},
+		},
+		"execution fails": {
+			srcReq: &types.QuerySimulateExecuteRequest{Sender: creator.String(), Contract: escrowAddr.String(), Msg: releaseMsg},
+			expErr: true,
+		},
+		"unknown contract": {
+			srcReq: &types.QuerySimulateExecuteRequest{Sender: creator.String(), Contract: RandomBech32AccountAddress(t), Msg: releaseMsg},
+			expErr: true,
+		},
+		"invalid msg": {
+			srcReq: &types.QuerySimulateExecuteRequest{Sender: creator.String(), Contract: reflectAddr.String(), Msg: []byte("not json")},
+			expErr: true,
+		},
+		"invalid sender": {
+			srcReq: &types.QuerySimulateExecuteRequest{Sender: "invalid", Contract: reflectAddr.String(), Msg: changeOwnerMsg},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			em := sdk.NewEventManager()
+			gotRsp, gotErr := q.SimulateExecute(sdk.WrapSDKContext(ctx.WithEventManager(em)), spec.srcReq)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.NotEmpty(t, gotRsp.Events)
+			assert.Empty(t, em.Events())
+			assert.Greater(t, gotRsp.GasUsed, uint64(0))
+			gotSubMsgSenders := make([]string, len(gotRsp.SubMsgs))
+			for i, v := range gotRsp.SubMsgs {
+				gotSubMsgSenders[i] = v.Contract
+				assert.NotEmpty(t, v.Msg)
+			}
+			assert.Equal(t, spec.expSubMsgs, nilIfEmptySlice(gotSubMsgSenders))
+			assert.Equal(t, spec.expStateChanges, gotRsp.StateChanges)
+			// and nothing persisted
+			assert.Equal(t, reflectStart, keepers.BankKeeper.GetAllBalances(ctx, reflectAddr))
+			assert.Equal(t, escrowBalance, keepers.BankKeeper.GetAllBalances(ctx, escrowAddr))
+			assert.Equal(t, []byte(fmt.Sprintf(`{"owner":%q}`, creator.String())), keepers.WasmKeeper.QueryRaw(ctx, reflectAddr, configKey))
+		})
+	}
+}
+
+func nilIfEmptySlice[T any](s []T) []T {
+	if len(s) == 0 {
+		return nil
+	}
+	return s
+}
+
 func TestQueryContractListByCodeOrdering(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	keeper := keepers.WasmKeeper
@@ -742,6 +1040,7 @@ func TestQueryCodeInfo(t *testing.T) {
 					Creator:               codeInfo.Creator,
 					DataHash:              codeInfo.CodeHash,
 					InstantiatePermission: spec.accessConfig,
+					Analysis:              hackatomCodeAnalysis(wasmCode),
 				},
 				Data: wasmCode,
 			}
@@ -802,6 +1101,7 @@ func TestQueryCodeInfoList(t *testing.T) {
 			Creator:               code.codeInfo.Creator,
 			DataHash:              code.codeInfo.CodeHash,
 			InstantiatePermission: code.codeInfo.InstantiateConfig,
+			Analysis:              hackatomCodeAnalysis(wasmCode),
 		})
 	}
 	q := Querier(keeper)
@@ -815,6 +1115,13 @@ func TestQueryCodeInfoList(t *testing.T) {
 	require.EqualValues(t, allCodesResponse, got.CodeInfos)
 }
 
+func hackatomCodeAnalysis(wasmCode []byte) *types.CodeAnalysis {
+	return &types.CodeAnalysis{
+		Entrypoints: []string{"execute", "instantiate", "migrate", "query", "sudo"},
+		CodeSize:    uint64(len(wasmCode)),
+	}
+}
+
 func TestQueryContractsByCreatorList(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"container/list"
+	"encoding/binary"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// smartQueryCache is a node local LRU cache for smart query results, bounded by the memory size of the entries.
+// Only results for the latest block height seen are stored. All entries are dropped when a query for a newer
+// height comes in, which is the case after a new block was committed.
+// The cache must not be used on consensus paths.
+type smartQueryCache struct {
+	maxBytes uint64
+
+	mu       sync.Mutex
+	height   int64
+	size     uint64
+	lru      *list.List
+	elements map[string]*list.Element
+}
+
+type smartQueryCacheEntry struct {
+	key   string
+	value []byte
+}
+
+// size is an approximation of the memory used by the entry
+func (e smartQueryCacheEntry) size() uint64 {
+	return uint64(2*len(e.key) + len(e.value))
+}
+
+// newSmartQueryCache constructor. Returns nil when maxBytes is 0.
+func newSmartQueryCache(maxBytes uint64) *smartQueryCache {
+	if maxBytes == 0 {
+		return nil
+	}
+	return &smartQueryCache{
+		maxBytes: maxBytes,
+		lru:      list.New(),
+		elements: make(map[string]*list.Element),
+	}
+}
+
+// Get returns the cached result or nil. Safe to call on a nil instance.
+func (c *smartQueryCache) Get(height int64, contractAddr sdk.AccAddress, queryData []byte) []byte {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidate(height)
+	if e, ok := c.elements[smartQueryCacheKey(height, contractAddr, queryData)]; ok {
+		c.lru.MoveToFront(e)
+		telemetry.IncrCounter(1, "wasm", "smart-query", "cache", "hit")
+		return e.Value.(*smartQueryCacheEntry).value
+	}
+	telemetry.IncrCounter(1, "wasm", "smart-query", "cache", "miss")
+	return nil
+}
+
+// Add stores the result and evicts the least recently used entries when the max size is exceeded.
+// Results for an outdated height or that exceed the max size alone are not stored. Safe to call on a nil instance.
+func (c *smartQueryCache) Add(height int64, contractAddr sdk.AccAddress, queryData, value []byte) {
+	if c == nil {
+		return
+	}
+	entry := &smartQueryCacheEntry{key: smartQueryCacheKey(height, contractAddr, queryData), value: value}
+	if entry.size() > c.maxBytes {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidate(height)
+	if height != c.height {
+		return
+	}
+	if e, ok := c.elements[entry.key]; ok {
+		c.lru.MoveToFront(e)
+		return
+	}
+	c.elements[entry.key] = c.lru.PushFront(entry)
+	c.size += entry.size()
+	for c.size > c.maxBytes {
+		c.remove(c.lru.Back())
+	}
+}
+
+// invalidate drops all entries when the height is newer than the cached one
+func (c *smartQueryCache) invalidate(height int64) {
+	if height <= c.height {
+		return
+	}
+	c.height = height
+	c.size = 0
+	c.lru.Init()
+	c.elements = make(map[string]*list.Element)
+}
+
+func (c *smartQueryCache) remove(e *list.Element) {
+	entry := c.lru.Remove(e).(*smartQueryCacheEntry)
+	delete(c.elements, entry.key)
+	c.size -= entry.size()
+}
+
+// smartQueryCacheKey is the height, the length prefixed contract address and the query data
+func smartQueryCacheKey(height int64, contractAddr sdk.AccAddress, queryData []byte) string {
+	r := make([]byte, 0, 9+len(contractAddr)+len(queryData))
+	r = binary.BigEndian.AppendUint64(r, uint64(height))
+	r = append(r, byte(len(contractAddr)))
+	r = append(r, contractAddr...)
+	r = append(r, queryData...)
+	return string(r)
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSmartQueryCache(t *testing.T) {
+	myContract, otherContract := RandomAccountAddress(t), RandomAccountAddress(t)
+	c := newSmartQueryCache(1024)
+	require.NotNil(t, c)
+
+	assert.Nil(t, c.Get(1, myContract, []byte(`{}`)))
+	c.Add(1, myContract, []byte(`{}`), []byte(`"my result"`))
+	assert.Equal(t, []byte(`"my result"`), c.Get(1, myContract, []byte(`{}`)))
+	// key contains contract and query data
+	assert.Nil(t, c.Get(1, otherContract, []byte(`{}`)))
+	assert.Nil(t, c.Get(1, myContract, []byte(`{"other":{}}`)))
+
+	// outdated heights are not cached
+	assert.Nil(t, c.Get(0, myContract, []byte(`{}`)))
+	c.Add(0, myContract, []byte(`{"other":{}}`), []byte(`"outdated"`))
+	assert.Nil(t, c.Get(0, myContract, []byte(`{"other":{}}`)))
+	assert.Nil(t, c.Get(1, myContract, []byte(`{"other":{}}`)))
+
+	// new height drops all entries
+	assert.Nil(t, c.Get(2, myContract, []byte(`{}`)))
+	assert.Equal(t, 0, c.lru.Len())
+	assert.Zero(t, c.size)
+	assert.Nil(t, c.Get(1, myContract, []byte(`{}`)))
+}
+
+func TestSmartQueryCacheEviction(t *testing.T) {
+	myContract := RandomAccountAddress(t)
+	entrySize := smartQueryCacheEntry{key: smartQueryCacheKey(1, myContract, []byte(`{"a":{}}`)), value: []byte(`1`)}.size()
+	c := newSmartQueryCache(2 * entrySize)
+
+	c.Add(1, myContract, []byte(`{"a":{}}`), []byte(`1`))
+	c.Add(1, myContract, []byte(`{"b":{}}`), []byte(`2`))
+	// touch a so that b is the least recently used
+	require.NotNil(t, c.Get(1, myContract, []byte(`{"a":{}}`)))
+	c.Add(1, myContract, []byte(`{"c":{}}`), []byte(`3`))
+
+	assert.Equal(t, []byte(`1`), c.Get(1, myContract, []byte(`{"a":{}}`)))
+	assert.Nil(t, c.Get(1, myContract, []byte(`{"b":{}}`)))
+	assert.Equal(t, []byte(`3`), c.Get(1, myContract, []byte(`{"c":{}}`)))
+	assert.Equal(t, 2*entrySize, c.size)
+
+	// entries that exceed the max size alone are not stored
+	c.Add(1, myContract, []byte(`{"d":{}}`), make([]byte, 2*entrySize))
+	assert.Nil(t, c.Get(1, myContract, []byte(`{"d":{}}`)))
+	assert.Equal(t, 2, c.lru.Len())
+}
+
+func TestSmartQueryCacheDisabled(t *testing.T) {
+	c := newSmartQueryCache(0)
+	assert.Nil(t, c)
+	// nil instance is a noop
+	c.Add(1, RandomAccountAddress(t), []byte(`{}`), []byte(`1`))
+	assert.Nil(t, c.Get(1, RandomAccountAddress(t), []byte(`{}`)))
+}
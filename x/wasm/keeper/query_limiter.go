@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// reasons for rejected smart queries used in metrics
+const (
+	rejectReasonConcurrency = "concurrency"
+	rejectReasonTimeout     = "timeout"
+)
+
+// smartQueryLimiter limits the number of concurrent smart queries and their wall-clock execution time on a node.
+// The limits are node local and must not be applied to consensus paths.
+type smartQueryLimiter struct {
+	// slots has the capacity of max concurrent queries. Nil when unlimited
+	slots chan struct{}
+	// timeout is the max wall-clock time of a query. Disabled when 0
+	timeout time.Duration
+}
+
+// newSmartQueryLimiter constructor. Returns nil when no limits are set.
+func newSmartQueryLimiter(maxConcurrent uint32, timeout time.Duration) *smartQueryLimiter {
+	if maxConcurrent == 0 && timeout == 0 {
+		return nil
+	}
+	l := &smartQueryLimiter{timeout: timeout}
+	if maxConcurrent != 0 {
+		l.slots = make(chan struct{}, maxConcurrent)
+	}
+	return l
+}
+
+// run executes the query within the limits. Queries are rejected with gRPC status `ResourceExhausted` when all
+// slots are taken or `DeadlineExceeded` when the timeout is hit.
+// A wasmvm call can not be interrupted. On timeout, the query continues in the background and keeps its slot until
+// completed. The query function must therefore recover from panics.
+func (l *smartQueryLimiter) run(ctx context.Context, query func() ([]byte, error)) ([]byte, error) {
+	if l == nil {
+		return query()
+	}
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		default:
+			telemetry.IncrCounter(1, "wasm", "smart-query", "rejected", rejectReasonConcurrency)
+			return nil, status.Error(codes.ResourceExhausted, "max concurrent smart queries reached")
+		}
+	}
+	release := func() {
+		if l.slots != nil {
+			<-l.slots
+		}
+	}
+	if l.timeout == 0 {
+		defer release()
+		return query()
+	}
+
+	type result struct {
+		bz  []byte
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		defer release()
+		bz, err := query()
+		done <- result{bz: bz, err: err}
+	}()
+	timer := time.NewTimer(l.timeout)
+	defer timer.Stop()
+	select {
+	case r := <-done:
+		return r.bz, r.err
+	case <-timer.C:
+		telemetry.IncrCounter(1, "wasm", "smart-query", "rejected", rejectReasonTimeout)
+		return nil, status.Errorf(codes.DeadlineExceeded, "smart query exceeded timeout of %s", l.timeout)
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+}
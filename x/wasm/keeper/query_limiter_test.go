@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewSmartQueryLimiter(t *testing.T) {
+	assert.Nil(t, newSmartQueryLimiter(0, 0))
+
+	l := newSmartQueryLimiter(2, 0)
+	require.NotNil(t, l)
+	assert.Equal(t, 2, cap(l.slots))
+	assert.Zero(t, l.timeout)
+
+	l = newSmartQueryLimiter(0, time.Second)
+	require.NotNil(t, l)
+	assert.Nil(t, l.slots)
+	assert.Equal(t, time.Second, l.timeout)
+}
+
+func TestSmartQueryLimiterRun(t *testing.T) {
+	myErr := errors.New("testing")
+	specs := map[string]struct {
+		limiter *smartQueryLimiter
+		query   func() ([]byte, error)
+		expBz   []byte
+		expErr  error
+		expCode codes.Code
+	}{
+		"nil limiter": {
+			query: func() ([]byte, error) { return []byte("ok"), nil },
+			expBz: []byte("ok"),
+		},
+		"concurrency only": {
+			limiter: newSmartQueryLimiter(1, 0),
+			query:   func() ([]byte, error) { return []byte("ok"), nil },
+			expBz:   []byte("ok"),
+		},
+		"within timeout": {
+			limiter: newSmartQueryLimiter(1, time.Second),
+			query:   func() ([]byte, error) { return []byte("ok"), nil },
+			expBz:   []byte("ok"),
+		},
+		"query error returned": {
+			limiter: newSmartQueryLimiter(1, time.Second),
+			query:   func() ([]byte, error) { return nil, myErr },
+			expErr:  myErr,
+		},
+		"timeout exceeded": {
+			limiter: newSmartQueryLimiter(0, time.Millisecond),
+			query: func() ([]byte, error) {
+				time.Sleep(100 * time.Millisecond)
+				return []byte("ok"), nil
+			},
+			expCode: codes.DeadlineExceeded,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotBz, gotErr := spec.limiter.run(context.Background(), spec.query)
+			switch {
+			case spec.expErr != nil:
+				require.ErrorIs(t, gotErr, spec.expErr)
+			case spec.expCode != codes.OK:
+				assert.Equal(t, spec.expCode, status.Code(gotErr))
+			default:
+				require.NoError(t, gotErr)
+			}
+			assert.Equal(t, spec.expBz, gotBz)
+		})
+	}
+}
+
+func TestSmartQueryLimiterConcurrency(t *testing.T) {
+	l := newSmartQueryLimiter(1, 0)
+	started, release := make(chan struct{}), make(chan struct{})
+	done := make(chan error)
+	go func() {
+		_, err := l.run(context.Background(), func() ([]byte, error) {
+			close(started)
+			<-release
+			return nil, nil
+		})
+		done <- err
+	}()
+	<-started
+
+	// when all slots are taken
+	_, gotErr := l.run(context.Background(), func() ([]byte, error) { return nil, nil })
+	// then
+	assert.Equal(t, codes.ResourceExhausted, status.Code(gotErr))
+
+	// and when slot was released
+	close(release)
+	require.NoError(t, <-done)
+	_, gotErr = l.run(context.Background(), func() ([]byte, error) { return nil, nil })
+	// then
+	require.NoError(t, gotErr)
+}
+
+func TestSmartQueryLimiterSlotKeptOnTimeout(t *testing.T) {
+	l := newSmartQueryLimiter(1, time.Millisecond)
+	release := make(chan struct{})
+	_, gotErr := l.run(context.Background(), func() ([]byte, error) {
+		<-release
+		return nil, nil
+	})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(gotErr))
+
+	// the query is still running in the background
+	_, gotErr = l.run(context.Background(), func() ([]byte, error) { return nil, nil })
+	assert.Equal(t, codes.ResourceExhausted, status.Code(gotErr))
+
+	close(release)
+	require.Eventually(t, func() bool { return len(l.slots) == 0 }, time.Second, time.Millisecond)
+}
+
+func TestSmartQueryLimiterContextCanceled(t *testing.T) {
+	l := newSmartQueryLimiter(0, time.Second)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, gotErr := l.run(ctx, func() ([]byte, error) {
+		time.Sleep(100 * time.Millisecond)
+		return nil, nil
+	})
+	assert.Equal(t, codes.Canceled, status.Code(gotErr))
+}
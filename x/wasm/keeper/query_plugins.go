@@ -48,10 +48,17 @@ type GRPCQueryRouter interface {
 var _ wasmvmtypes.Querier = QueryHandler{}
 
 func (q QueryHandler) Query(request wasmvmtypes.QueryRequest, gasLimit uint64) ([]byte, error) {
+	traceNode := startGasTrace(q.Ctx, "query", q.Caller)
+	defer traceNode.End()
+	traceNode.SetAttribute("type", queryRequestType(request))
+	ctx, span := startChildSpan(q.Ctx, "query", q.Caller)
+	defer span.End()
+	span.SetAttribute("wasm.query_type", queryRequestType(request))
+
 	// set a limit for a subCtx
 	sdkGas := q.gasRegister.FromWasmVMGas(gasLimit)
 	// discard all changes/ events in subCtx by not committing the cached context
-	subCtx, _ := q.Ctx.WithGasMeter(sdk.NewGasMeter(sdkGas)).CacheContext()
+	subCtx, _ := withContractQuery(ctx).WithGasMeter(sdk.NewGasMeter(sdkGas)).CacheContext()
 
 	// make sure we charge the higher level context even on panic
 	defer func() {
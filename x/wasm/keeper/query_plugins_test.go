@@ -822,6 +822,7 @@ func TestDistributionQuerier(t *testing.T) {
 type distrKeeperMock struct {
 	DelegationRewardsFn        func(c context.Context, req *distributiontypes.QueryDelegationRewardsRequest) (*distributiontypes.QueryDelegationRewardsResponse, error)
 	GetDelegatorWithdrawAddrFn func(ctx sdk.Context, delAddr sdk.AccAddress) sdk.AccAddress
+	FundCommunityPoolFn        func(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
 func (m distrKeeperMock) DelegationRewards(ctx context.Context, req *distributiontypes.QueryDelegationRewardsRequest) (*distributiontypes.QueryDelegationRewardsResponse, error) {
@@ -838,6 +839,13 @@ func (m distrKeeperMock) GetDelegatorWithdrawAddr(ctx sdk.Context, delAddr sdk.A
 	return m.GetDelegatorWithdrawAddrFn(ctx, delAddr)
 }
 
+func (m distrKeeperMock) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	if m.FundCommunityPoolFn == nil {
+		panic("not expected to be called")
+	}
+	return m.FundCommunityPoolFn(ctx, amount, sender)
+}
+
 type mockWasmQueryKeeper struct {
 	GetContractInfoFn func(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo
 	QueryRawFn        func(ctx sdk.Context, contractAddress sdk.AccAddress, key []byte) []byte
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+type queryVMContextKey int
+
+const (
+	// contextKeyUseQueryVM marks a context of a smart query that was received via the gRPC query service
+	contextKeyUseQueryVM queryVMContextKey = iota
+	// contextKeyContractQuery marks a context of a query that was sent by a contract
+	contextKeyContractQuery
+)
+
+// withQueryVM marks the context so that smart queries are run in the separate query wasmvm instance
+func withQueryVM(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(contextKeyUseQueryVM, true)
+}
+
+// useQueryVM returns true when the context was marked with withQueryVM
+func useQueryVM(ctx sdk.Context) bool {
+	if ctx.Context() == nil {
+		return false
+	}
+	v, ok := ctx.Value(contextKeyUseQueryVM).(bool)
+	return ok && v
+}
+
+// withContractQuery marks the context of a query sent by a contract
+func withContractQuery(ctx sdk.Context) sdk.Context {
+	if ctx.Context() == nil {
+		return ctx
+	}
+	return ctx.WithValue(contextKeyContractQuery, true)
+}
+
+// isContractQuery returns true when the context was marked with withContractQuery
+func isContractQuery(ctx sdk.Context) bool {
+	if ctx.Context() == nil {
+		return false
+	}
+	v, ok := ctx.Value(contextKeyContractQuery).(bool)
+	return ok && v
+}
+
+// queryVMCodes keeps track of the checksums that were copied into the query wasmvm instance
+type queryVMCodes struct {
+	mu     sync.RWMutex
+	stored map[string]struct{}
+}
+
+func newQueryVMCodes() *queryVMCodes {
+	return &queryVMCodes{stored: make(map[string]struct{})}
+}
+
+// Has returns true when the checksum was stored before. Safe to call on a nil instance.
+func (c *queryVMCodes) Has(checksum []byte) bool {
+	if c == nil {
+		return false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	_, ok := c.stored[string(checksum)]
+	return ok
+}
+
+// Add marks the checksum as stored. Safe to call on a nil instance.
+func (c *queryVMCodes) Add(checksum []byte) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stored[string(checksum)] = struct{}{}
+}
+
+// syncQueryVMCode copies the wasm code from the main instance into the query instance when it is not
+// stored there, yet. The query instance has its own data directory and does not see new code otherwise.
+func (k Keeper) syncQueryVMCode(checksum []byte) error {
+	if k.queryVMCodes.Has(checksum) {
+		return nil
+	}
+	if _, err := k.queryWasmVM.GetCode(checksum); err != nil {
+		code, err := k.wasmVM.GetCode(checksum)
+		if err != nil {
+			return err
+		}
+		if _, err := k.queryWasmVM.StoreCodeUnchecked(code); err != nil {
+			return err
+		}
+	}
+	k.queryVMCodes.Add(checksum)
+	return nil
+}
+
+// queryWasmEngine returns the wasmvm instance for a smart query. The separate query instance is used,
+// when configured, only for queries received via the gRPC query service. Queries that are sent by
+// contracts or that are part of the block execution always use the main instance.
+func (k Keeper) queryWasmEngine(ctx sdk.Context, checksum []byte) types.WasmerEngine {
+	if k.queryWasmVM == nil || !useQueryVM(ctx) {
+		return k.wasmVM
+	}
+	if err := k.syncQueryVMCode(checksum); err != nil {
+		k.Logger(ctx).Error("failed to store code in query wasmvm, falling back to main instance", "error", err)
+		return k.wasmVM
+	}
+	return k.queryWasmVM
+}
@@ -28,16 +28,22 @@ func (k Keeper) OnOpenChannel(
 	msg wasmvmtypes.IBCChannelOpenMsg,
 ) (string, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-open-channel")
-	_, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+	defer k.startGasTrace(ctx, "ibc-open-channel", contractAddr).End()
+	ctx, span := k.startSpan(ctx, "ibc-open-channel", contractAddr)
+	defer span.End()
+	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return "", err
 	}
+	span.SetCodeID(contractInfo.CodeID)
 
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCChannelOpen(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
+	start := time.Now()
+	res, gasUsed, execErr := k.wasmVM.IBCChannelOpen(codeInfo.CodeHash, env, msg, prefixStore, k.wasmVMAPI(ctx), querier, ctx.GasMeter(), gas, k.deserializationCosts(ctx))
+	k.observeVMCall(ctx, entrypointIBCChannelOpen, contractInfo.CodeID, start, gasUsed, execErr != nil)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if execErr != nil {
 		return "", errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
@@ -61,16 +67,22 @@ func (k Keeper) OnConnectChannel(
 	msg wasmvmtypes.IBCChannelConnectMsg,
 ) error {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-connect-channel")
+	defer k.startGasTrace(ctx, "ibc-connect-channel", contractAddr).End()
+	ctx, span := k.startSpan(ctx, "ibc-connect-channel", contractAddr)
+	defer span.End()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
+	span.SetCodeID(contractInfo.CodeID)
 
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCChannelConnect(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
+	start := time.Now()
+	res, gasUsed, execErr := k.wasmVM.IBCChannelConnect(codeInfo.CodeHash, env, msg, prefixStore, k.wasmVMAPI(ctx), querier, ctx.GasMeter(), gas, k.deserializationCosts(ctx))
+	k.observeVMCall(ctx, entrypointIBCChannelConnect, contractInfo.CodeID, start, gasUsed, execErr != nil)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
@@ -91,17 +103,23 @@ func (k Keeper) OnCloseChannel(
 	msg wasmvmtypes.IBCChannelCloseMsg,
 ) error {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-close-channel")
+	defer k.startGasTrace(ctx, "ibc-close-channel", contractAddr).End()
+	ctx, span := k.startSpan(ctx, "ibc-close-channel", contractAddr)
+	defer span.End()
 
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
+	span.SetCodeID(contractInfo.CodeID)
 
 	params := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCChannelClose(codeInfo.CodeHash, params, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
+	start := time.Now()
+	res, gasUsed, execErr := k.wasmVM.IBCChannelClose(codeInfo.CodeHash, params, msg, prefixStore, k.wasmVMAPI(ctx), querier, ctx.GasMeter(), gas, k.deserializationCosts(ctx))
+	k.observeVMCall(ctx, entrypointIBCChannelClose, contractInfo.CodeID, start, gasUsed, execErr != nil)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
@@ -122,16 +140,22 @@ func (k Keeper) OnRecvPacket(
 	msg wasmvmtypes.IBCPacketReceiveMsg,
 ) (ibcexported.Acknowledgement, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-recv-packet")
+	defer k.startGasTrace(ctx, "ibc-recv-packet", contractAddr).End()
+	ctx, span := k.startSpan(ctx, "ibc-recv-packet", contractAddr)
+	defer span.End()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return nil, err
 	}
+	span.SetCodeID(contractInfo.CodeID)
 
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCPacketReceive(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
+	start := time.Now()
+	res, gasUsed, execErr := k.wasmVM.IBCPacketReceive(codeInfo.CodeHash, env, msg, prefixStore, k.wasmVMAPI(ctx), querier, ctx.GasMeter(), gas, k.deserializationCosts(ctx))
+	k.observeVMCall(ctx, entrypointIBCPacketReceive, contractInfo.CodeID, start, gasUsed, execErr != nil || res.Err != "")
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if execErr != nil {
 		panic(execErr) // let the contract fully abort an IBC packet receive.
@@ -180,16 +204,22 @@ func (k Keeper) OnAckPacket(
 	msg wasmvmtypes.IBCPacketAckMsg,
 ) error {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-ack-packet")
+	defer k.startGasTrace(ctx, "ibc-ack-packet", contractAddr).End()
+	ctx, span := k.startSpan(ctx, "ibc-ack-packet", contractAddr)
+	defer span.End()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
+	span.SetCodeID(contractInfo.CodeID)
 
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCPacketAck(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
+	start := time.Now()
+	res, gasUsed, execErr := k.wasmVM.IBCPacketAck(codeInfo.CodeHash, env, msg, prefixStore, k.wasmVMAPI(ctx), querier, ctx.GasMeter(), gas, k.deserializationCosts(ctx))
+	k.observeVMCall(ctx, entrypointIBCPacketAck, contractInfo.CodeID, start, gasUsed, execErr != nil)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
@@ -206,17 +236,23 @@ func (k Keeper) OnTimeoutPacket(
 	msg wasmvmtypes.IBCPacketTimeoutMsg,
 ) error {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-timeout-packet")
+	defer k.startGasTrace(ctx, "ibc-timeout-packet", contractAddr).End()
+	ctx, span := k.startSpan(ctx, "ibc-timeout-packet", contractAddr)
+	defer span.End()
 
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
+	span.SetCodeID(contractInfo.CodeID)
 
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCPacketTimeout(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
+	start := time.Now()
+	res, gasUsed, execErr := k.wasmVM.IBCPacketTimeout(codeInfo.CodeHash, env, msg, prefixStore, k.wasmVMAPI(ctx), querier, ctx.GasMeter(), gas, k.deserializationCosts(ctx))
+	k.observeVMCall(ctx, entrypointIBCPacketTimeout, contractInfo.CodeID, start, gasUsed, execErr != nil)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// SimulateExecute executes the contract in a branched context that is never committed. The gas limit must be
+// set in the context gas meter by the caller.
+// The result contains the data, events and gas used as well as the sub-messages dispatched and
+// all the contract store keys that were modified.
+func (k Keeper) SimulateExecute(ctx sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (*types.QuerySimulateExecuteResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "simulate-execute")
+
+	// writes of the simulation are flushed into the branch through a tracing layer to collect the keys modified
+	var trace bytes.Buffer
+	branch := ctx.MultiStore().CacheMultiStore()
+	traced := branch.SetTracer(&trace).CacheMultiStore()
+
+	var subMsgs []types.DispatchedSubMsg
+	simCtx := ctx.WithMultiStore(traced).WithEventManager(sdk.NewEventManager())
+	simCtx = types.WithSubMsgListener(simCtx, func(contractAddr sdk.AccAddress, msgs []wasmvmtypes.SubMsg) {
+		for _, m := range msgs {
+			subMsgs = append(subMsgs, newDispatchedSubMsg(contractAddr, m))
+		}
+	})
+	data, err := k.execute(simCtx, contractAddress, caller, msg, coins)
+	if err != nil {
+		return nil, err
+	}
+	traced.Write()
+
+	changes, err := k.contractStateChanges(ctx.MultiStore().GetKVStore(k.storeKey), branch.GetKVStore(k.storeKey), &trace)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "state changes")
+	}
+	return &types.QuerySimulateExecuteResponse{
+		Data:         data,
+		Events:       simCtx.EventManager().ABCIEvents(),
+		GasUsed:      ctx.GasMeter().GasConsumed(),
+		SubMsgs:      subMsgs,
+		StateChanges: changes,
+	}, nil
+}
+
+func newDispatchedSubMsg(contractAddr sdk.AccAddress, m wasmvmtypes.SubMsg) types.DispatchedSubMsg {
+	var gasLimit uint64
+	if m.GasLimit != nil {
+		gasLimit = *m.GasLimit
+	}
+	msgBz, err := json.Marshal(m.Msg)
+	if err != nil { // the message was unmarshalled from json before
+		panic(err)
+	}
+	return types.DispatchedSubMsg{
+		Contract: contractAddr.String(),
+		ID:       m.ID,
+		ReplyOn:  m.ReplyOn.String(),
+		GasLimit: gasLimit,
+		Msg:      msgBz,
+	}
+}
+
+// storeTraceOperation is the json encoded operation written by the cosmos-sdk tracekv store
+type storeTraceOperation struct {
+	Operation string                 `json:"operation"`
+	Key       string                 `json:"key"`
+	Value     string                 `json:"value"`
+	Metadata  map[string]interface{} `json:"metadata"`
+}
+
+// contractStateChanges compares the keys that were written or deleted in the trace log between the original and the
+// modified store. Keys outside the contract stores are ignored.
+func (k Keeper) contractStateChanges(orig, modified sdk.KVStore, trace *bytes.Buffer) ([]types.ContractStateChange, error) {
+	storeName := k.storeKey.Name()
+	seen := make(map[string]struct{})
+	r := make([]types.ContractStateChange, 0)
+	scanner := bufio.NewScanner(trace)
+	scanner.Buffer(nil, 64*1024*1024)
+	for scanner.Scan() {
+		var op storeTraceOperation
+		if err := json.Unmarshal(scanner.Bytes(), &op); err != nil {
+			return nil, err
+		}
+		if (op.Operation != "write" && op.Operation != "delete") || op.Metadata["store_name"] != storeName {
+			continue
+		}
+		key, err := base64.StdEncoding.DecodeString(op.Key)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.HasPrefix(key, types.ContractStorePrefix) {
+			continue
+		}
+		if _, exists := seen[string(key)]; exists {
+			continue
+		}
+		seen[string(key)] = struct{}{}
+		oldValue, newValue := orig.Get(key), modified.Get(key)
+		if bytes.Equal(oldValue, newValue) {
+			continue
+		}
+		contractAddr, contractKey, ok := splitContractStoreKey(modified, key)
+		if !ok {
+			continue
+		}
+		r = append(r, types.ContractStateChange{
+			Contract: contractAddr.String(),
+			Key:      contractKey,
+			OldValue: oldValue,
+			NewValue: newValue,
+		})
+	}
+	return r, scanner.Err()
+}
+
+// splitContractStoreKey returns the contract address and the relative key for a key in the contract store prefix.
+// Addresses can be of the length of a contract address or a legacy sdk address. The length is detected by the
+// contract info stored.
+func splitContractStoreKey(store sdk.KVStore, key []byte) (sdk.AccAddress, []byte, bool) {
+	key = key[len(types.ContractStorePrefix):]
+	for _, n := range []int{types.ContractAddrLen, types.SDKAddrLen} {
+		if len(key) <= n {
+			continue
+		}
+		if addr := sdk.AccAddress(key[:n]); store.Has(types.GetContractAddressKey(addr)) {
+			return addr, key[n:], true
+		}
+	}
+	return nil, nil, false
+}
@@ -60,6 +60,11 @@ func TestSnapshotter(t *testing.T) {
 				require.Equal(t, uint64(i+1), codeID)
 				srcCodeIDToChecksum[codeID] = checksum
 			}
+			// the restore must not be restricted by the size limits
+			params := wasmKeeper.GetParams(ctx)
+			params.SizeLimits.MaxWasmSize = 1
+			require.NoError(t, wasmKeeper.SetParams(ctx, params))
+
 			// create snapshot
 			srcWasmApp.Commit()
 			snapshotHeight := uint64(srcWasmApp.LastBlockHeight())
@@ -67,12 +72,6 @@ func TestSnapshotter(t *testing.T) {
 			require.NoError(t, err)
 			assert.NotNil(t, snapshot)
 
-			originalMaxWasmSize := types.MaxWasmSize
-			types.MaxWasmSize = 1
-			t.Cleanup(func() {
-				types.MaxWasmSize = originalMaxWasmSize
-			})
-
 			// when snapshot imported into dest app instance
 			destWasmApp := app.SetupWithEmptyStore(t)
 			require.NoError(t, destWasmApp.SnapshotManager().Restore(*snapshot))
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/json"
+	"time"
 
 	tmBytes "github.com/cometbft/cometbft/libs/bytes"
 	fuzz "github.com/google/gofuzz"
@@ -11,7 +12,7 @@ import (
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
-var ModelFuzzers = []interface{}{FuzzAddr, FuzzAddrString, FuzzAbsoluteTxPosition, FuzzContractInfo, FuzzStateModel, FuzzAccessType, FuzzAccessConfig, FuzzContractCodeHistory}
+var ModelFuzzers = []interface{}{FuzzAddr, FuzzAddrString, FuzzAbsoluteTxPosition, FuzzContractInfo, FuzzStateModel, FuzzAccessType, FuzzAccessConfig, FuzzContractCodeHistory, FuzzFeeParams, FuzzSizeLimitParams, FuzzUploadQuotaParams}
 
 func FuzzAddr(m *sdk.AccAddress, c fuzz.Continue) {
 	*m = make([]byte, 20)
@@ -61,14 +62,9 @@ func FuzzStateModel(m *types.Model, c fuzz.Continue) {
 }
 
 func FuzzAccessType(m *types.AccessType, c fuzz.Continue) {
-	pos := c.Int() % len(types.AllAccessTypes)
-	for _, v := range types.AllAccessTypes {
-		if pos == 0 {
-			*m = v
-			return
-		}
-		pos--
-	}
+	// code ids must reference existing codes and are not supported as default permission
+	accessTypes := []types.AccessType{types.AccessTypeNobody, types.AccessTypeAnyOfAddresses, types.AccessTypeEverybody}
+	*m = accessTypes[c.Intn(len(accessTypes))]
 }
 
 func FuzzAccessConfig(m *types.AccessConfig, c fuzz.Continue) {
@@ -77,3 +73,24 @@ func FuzzAccessConfig(m *types.AccessConfig, c fuzz.Continue) {
 	FuzzAddr(&add, c)
 	*m = m.Permission.With(add)
 }
+
+func FuzzSizeLimitParams(m *types.SizeLimitParams, c fuzz.Continue) {
+	*m = types.DefaultSizeLimitParams()
+	m.MaxWasmSize += uint64(c.Intn(1024))
+	m.MaxLabelSize += uint64(c.Intn(128))
+}
+
+func FuzzUploadQuotaParams(m *types.UploadQuotaParams, c fuzz.Continue) {
+	m.MaxCodes = uint64(c.Intn(100))
+	m.MaxBytes = uint64(c.Int63())
+	m.Window = time.Duration(c.Int63n(int64(time.Hour))) + time.Second
+	m.ExemptAddresses = make([]string, 1)
+	FuzzAddrString(&m.ExemptAddresses[0], c)
+}
+
+func FuzzFeeParams(m *types.FeeParams, c fuzz.Continue) {
+	m.UploadFlat = sdk.NewCoins(sdk.NewInt64Coin("alx", c.Int63()))
+	m.UploadPerByte = sdk.NewDecCoins(sdk.NewDecCoinFromDec("blx", sdk.NewDecWithPrec(c.Int63(), 6)))
+	m.Instantiate = sdk.NewCoins(sdk.NewInt64Coin("alx", c.Int63()), sdk.NewInt64Coin("blx", c.Int63()))
+	m.Destination = types.FeeDestination(c.Intn(len(types.FeeDestination_name)))
+}
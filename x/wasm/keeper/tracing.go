@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// tracerName is the instrumentation name of the wasm module spans
+const tracerName = "github.com/CosmWasm/wasmd/x/wasm"
+
+// span attribute keys
+const (
+	spanAttributeContract = "wasm.contract_address"
+	spanAttributeCodeID   = "wasm.code_id"
+	spanAttributeGasUsed  = "wasm.gas_used"
+)
+
+// wasmSpan is an OpenTelemetry span for a wasm operation. All methods are safe to call on a nil instance
+// so that there is no overhead when tracing is disabled.
+type wasmSpan struct {
+	span     trace.Span
+	ctx      sdk.Context
+	gasStart sdk.Gas
+}
+
+// SetContract sets the contract address attribute
+func (s *wasmSpan) SetContract(contractAddr sdk.AccAddress) {
+	if s == nil {
+		return
+	}
+	s.span.SetAttributes(attribute.String(spanAttributeContract, contractAddr.String()))
+}
+
+// SetCodeID sets the code id attribute
+func (s *wasmSpan) SetCodeID(codeID uint64) {
+	if s == nil {
+		return
+	}
+	s.span.SetAttributes(attribute.Int64(spanAttributeCodeID, int64(codeID)))
+}
+
+// SetAttribute sets a custom string attribute
+func (s *wasmSpan) SetAttribute(key, value string) {
+	if s == nil {
+		return
+	}
+	s.span.SetAttributes(attribute.String(key, value))
+}
+
+// End sets the sdk gas consumed within the span and completes it
+func (s *wasmSpan) End() {
+	if s == nil {
+		return
+	}
+	s.span.SetAttributes(attribute.Int64(spanAttributeGasUsed, int64(s.ctx.GasMeter().GasConsumed()-s.gasStart)))
+	s.span.End()
+}
+
+// startSpan starts a new span when a tracer provider was set. The context returned contains the new span
+// so that nested operations are recorded as children. The span returned can be nil and must be closed with `End`.
+func (k Keeper) startSpan(ctx sdk.Context, name string, contractAddr sdk.AccAddress) (sdk.Context, *wasmSpan) {
+	if k.tracer == nil {
+		return ctx, nil
+	}
+	return startSpan(ctx, k.tracer, name, contractAddr)
+}
+
+// startChildSpan starts a new span only when there is a recording span in the context already.
+// This is used by the components outside the keeper that are called within a keeper operation.
+func startChildSpan(ctx sdk.Context, name string, contractAddr sdk.AccAddress) (sdk.Context, *wasmSpan) {
+	parent := trace.SpanFromContext(ctx.Context())
+	if !parent.IsRecording() {
+		return ctx, nil
+	}
+	return startSpan(ctx, parent.TracerProvider().Tracer(tracerName), name, contractAddr)
+}
+
+func startSpan(ctx sdk.Context, tracer trace.Tracer, name string, contractAddr sdk.AccAddress) (sdk.Context, *wasmSpan) {
+	goCtx, span := tracer.Start(ctx.Context(), name)
+	s := &wasmSpan{span: span, ctx: ctx, gasStart: ctx.GasMeter().GasConsumed()}
+	if contractAddr != nil {
+		s.SetContract(contractAddr)
+	}
+	return ctx.WithContext(goCtx), s
+}
+
+// NewTracerProvider creates a tracer provider with the span exporter configured in the wasm config.
+// Nil is returned when tracing is disabled.
+// The provider must be shut down on exit to flush the pending spans.
+func NewTracerProvider(c types.WasmConfig) (*sdktrace.TracerProvider, error) {
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+	switch c.TracingExporter {
+	case "":
+		return nil, nil
+	case types.TracingExporterStdout:
+		exporter, err = stdouttrace.New()
+	case types.TracingExporterOTLP:
+		var opts []otlptracegrpc.Option
+		if c.TracingOTLPEndpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(c.TracingOTLPEndpoint))
+		}
+		if c.TracingOTLPInsecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(context.Background(), opts...)
+	default:
+		return nil, fmt.Errorf("unsupported tracing exporter: %q", c.TracingExporter)
+	}
+	if err != nil {
+		return nil, err
+	}
+	serviceName := version.AppName
+	if serviceName == "" {
+		serviceName = types.ModuleName
+	}
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+	), nil
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestTracingSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithTracerProvider(tp))
+	k := keepers.WasmKeeper
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	_, err := keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+	require.NoError(t, err)
+	_, err = k.QuerySmart(ctx, example.Contract, []byte(`{"verifier":{}}`))
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	spanNames := make([]string, len(spans))
+	for i, s := range spans {
+		spanNames[i] = s.Name()
+	}
+	// hackatom queries the contract balance on release and sends it to the beneficiary
+	assert.Equal(t, []string{"store-code", "instantiate", "query", "submsg", "execute", "query-smart"}, spanNames)
+
+	querySpan, submsgSpan, execSpan := spans[2], spans[3], spans[4]
+	assert.Equal(t, execSpan.SpanContext().SpanID(), querySpan.Parent().SpanID())
+	assert.Equal(t, execSpan.SpanContext().SpanID(), submsgSpan.Parent().SpanID())
+	assert.False(t, execSpan.Parent().IsValid())
+	attrs := attributeMap(execSpan.Attributes())
+	assert.Equal(t, example.Contract.String(), attrs[spanAttributeContract].AsString())
+	assert.Equal(t, int64(example.CodeID), attrs[spanAttributeCodeID].AsInt64())
+	assert.Greater(t, attrs[spanAttributeGasUsed].AsInt64(), int64(0))
+	assert.Equal(t, "0", attributeMap(submsgSpan.Attributes())["wasm.submsg_id"].AsString())
+}
+
+func TestTracingQueryPluginSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	addr := RandomAccountAddress(t)
+
+	goCtx, parent := tp.Tracer("test").Start(ctx.Context(), "parent")
+	ctx = ctx.WithContext(goCtx)
+	q := k.newQueryHandler(ctx, addr)
+	_, err := q.Query(wasmvmtypes.QueryRequest{Bank: &wasmvmtypes.BankQuery{AllBalances: &wasmvmtypes.AllBalancesQuery{Address: addr.String()}}}, 100_000_000_000_000)
+	require.NoError(t, err)
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "query", spans[0].Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(t, "bank", attributeMap(spans[0].Attributes())["wasm.query_type"].AsString())
+}
+
+func TestTracingDisabled(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+
+	gotCtx, span := k.startSpan(ctx, "execute", nil)
+	assert.Nil(t, span)
+	assert.Equal(t, ctx.Context(), gotCtx.Context())
+	// no recording parent span
+	gotCtx, span = startChildSpan(ctx, "query", nil)
+	assert.Nil(t, span)
+	assert.Equal(t, ctx.Context(), gotCtx.Context())
+	// nil span is a noop
+	span.SetCodeID(1)
+	span.SetContract(RandomAccountAddress(t))
+	span.End()
+	// zero context
+	_, span = startChildSpan(sdk.Context{}, "query", nil)
+	assert.Nil(t, span)
+}
+
+func TestNewTracerProvider(t *testing.T) {
+	specs := map[string]struct {
+		src    types.WasmConfig
+		expNil bool
+		expErr bool
+	}{
+		"disabled": {
+			src:    types.WasmConfig{},
+			expNil: true,
+		},
+		"stdout": {
+			src: types.WasmConfig{TracingExporter: types.TracingExporterStdout},
+		},
+		"otlp": {
+			src: types.WasmConfig{TracingExporter: types.TracingExporterOTLP, TracingOTLPEndpoint: "localhost:4317", TracingOTLPInsecure: true},
+		},
+		"unsupported": {
+			src:    types.WasmConfig{TracingExporter: "unknown"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			tp, gotErr := NewTracerProvider(spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			if spec.expNil {
+				assert.Nil(t, tp)
+				return
+			}
+			require.NotNil(t, tp)
+			require.NoError(t, tp.Shutdown(context.Background()))
+		})
+	}
+}
+
+func attributeMap(src []attribute.KeyValue) map[string]attribute.Value {
+	r := make(map[string]attribute.Value, len(src))
+	for _, a := range src {
+		r[string(a.Key)] = a.Value
+	}
+	return r
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// checkUploadQuota returns an error when the upload of a code with the given uncompressed size exceeds the quota of
+// the creator. The records of the uploads that left the window are pruned. Returns true when the upload must be
+// recorded.
+func (k Keeper) checkUploadQuota(ctx sdk.Context, creator sdk.AccAddress, codeSize int, authZ types.AuthorizationPolicy) (bool, error) {
+	quota := k.getParamsNoGas(ctx).UploadQuotaOrDefault()
+	if !quota.IsLimited() || authZ.IsUploadQuotaExempt(quota, creator) {
+		return false, nil
+	}
+	k.pruneUploadRecords(ctx, creator, quota)
+	codesUsed, bytesUsed := k.uploadQuotaUsage(ctx, creator, quota)
+	return true, quota.Allows(codesUsed, bytesUsed, uint64(codeSize))
+}
+
+// storeUploadRecord persists the upload with the block time so that it counts against the quota of the creator
+func (k Keeper) storeUploadRecord(ctx sdk.Context, creator sdk.AccAddress, codeID uint64, codeSize int) {
+	key := types.GetUploadRecordKey(creator, ctx.BlockTime().UnixNano(), codeID)
+	ctx.KVStore(k.storeKey).Set(key, sdk.Uint64ToBigEndian(uint64(codeSize)))
+}
+
+// IterateUploadRecords iterates over all code uploads that count against the upload quota
+func (k Keeper) IterateUploadRecords(ctx sdk.Context, cb func(types.UploadRecord) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UploadRecordPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		creator, uploadTime, codeID := types.ParseUploadRecordKey(iter.Key())
+		record := types.UploadRecord{
+			Creator:    creator.String(),
+			UploadTime: uploadTime,
+			CodeID:     codeID,
+			CodeSize:   sdk.BigEndianToUint64(iter.Value()),
+		}
+		if cb(record) {
+			return
+		}
+	}
+}
+
+// importUploadRecord persists an upload record from genesis
+func (k Keeper) importUploadRecord(ctx sdk.Context, record types.UploadRecord) error {
+	creator, err := sdk.AccAddressFromBech32(record.Creator)
+	if err != nil {
+		return errorsmod.Wrap(err, "creator")
+	}
+	key := types.GetUploadRecordKey(creator, record.UploadTime, record.CodeID)
+	ctx.KVStore(k.storeKey).Set(key, sdk.Uint64ToBigEndian(record.CodeSize))
+	return nil
+}
+
+// uploadQuotaUsage returns the number of codes and the sum of their sizes that the creator uploaded within the
+// window that ends with the current block time
+func (k Keeper) uploadQuotaUsage(ctx sdk.Context, creator sdk.AccAddress, quota types.UploadQuotaParams) (codes, bytes uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetUploadRecordPrefix(creator))
+	iter := store.Iterator(uploadWindowStart(ctx, quota), nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		codes++
+		bytes += sdk.BigEndianToUint64(iter.Value())
+	}
+	return
+}
+
+// pruneUploadRecords deletes the upload records of the creator that are not within the window anymore
+func (k Keeper) pruneUploadRecords(ctx sdk.Context, creator sdk.AccAddress, quota types.UploadQuotaParams) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetUploadRecordPrefix(creator))
+	iter := store.Iterator(nil, uploadWindowStart(ctx, quota))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// uploadWindowStart returns the first key of the uploads that are within the window
+func uploadWindowStart(ctx sdk.Context, quota types.UploadQuotaParams) []byte {
+	start := ctx.BlockTime().Add(-quota.Window).UnixNano() + 1
+	if start < 0 {
+		start = 0
+	}
+	return sdk.Uint64ToBigEndian(uint64(start))
+}
+
+// UploadQuota returns the quota state of the address
+func (k Keeper) UploadQuota(ctx sdk.Context, addr sdk.AccAddress) types.QueryUploadQuotaResponse {
+	quota := k.getParamsNoGas(ctx).UploadQuotaOrDefault()
+	r := types.QueryUploadQuotaResponse{
+		Quota:  quota,
+		Exempt: addr.String() == k.GetAuthority() || DefaultAuthorizationPolicy{}.IsUploadQuotaExempt(quota, addr),
+	}
+	if r.Exempt || !quota.IsLimited() {
+		return r
+	}
+	r.CodesUsed, r.BytesUsed = k.uploadQuotaUsage(ctx, addr, quota)
+	r.CodesRemaining, r.BytesRemaining = quota.Remaining(r.CodesUsed, r.BytesUsed)
+	return r
+}
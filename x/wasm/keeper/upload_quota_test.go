@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestCreateWithUploadQuota(t *testing.T) {
+	wasmCode := testdata.HackatomContractWasm()
+	codeSize := uint64(len(wasmCode))
+	exemptAddr := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		quota     *types.UploadQuotaParams
+		creator   sdk.AccAddress
+		policy    types.AuthorizationPolicy
+		expStored int
+	}{
+		"no quota set": {
+			policy:    DefaultAuthorizationPolicy{},
+			expStored: 3,
+		},
+		"max codes": {
+			quota:     &types.UploadQuotaParams{MaxCodes: 2, Window: time.Hour},
+			policy:    DefaultAuthorizationPolicy{},
+			expStored: 2,
+		},
+		"max bytes": {
+			quota:     &types.UploadQuotaParams{MaxBytes: 2*codeSize - 1, Window: time.Hour},
+			policy:    DefaultAuthorizationPolicy{},
+			expStored: 1,
+		},
+		"exempt address": {
+			quota:     &types.UploadQuotaParams{MaxCodes: 1, Window: time.Hour, ExemptAddresses: []string{exemptAddr.String()}},
+			creator:   exemptAddr,
+			policy:    DefaultAuthorizationPolicy{},
+			expStored: 3,
+		},
+		"gov exempt": {
+			quota:     &types.UploadQuotaParams{MaxCodes: 1, Window: time.Hour},
+			policy:    GovAuthorizationPolicy{},
+			expStored: 3,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			params := types.DefaultParams()
+			params.UploadQuota = spec.quota
+			require.NoError(t, keepers.WasmKeeper.SetParams(ctx, params))
+			creator := spec.creator
+			if creator == nil {
+				creator = RandomAccountAddress(t)
+			}
+			k := NewPermissionedKeeper(keepers.WasmKeeper, spec.policy)
+
+			// when
+			var stored int
+			for i := 0; i < 3; i++ {
+				_, _, err := k.Create(ctx, creator, wasmCode, nil)
+				if err != nil {
+					require.ErrorIs(t, err, types.ErrQuotaExceeded)
+					break
+				}
+				stored++
+			}
+
+			// then
+			assert.Equal(t, spec.expStored, stored)
+		})
+	}
+}
+
+func TestUploadQuotaWindow(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	wasmCode := testdata.HackatomContractWasm()
+	codeSize := uint64(len(wasmCode))
+	quota := types.UploadQuotaParams{MaxCodes: 2, MaxBytes: 10 * codeSize, Window: time.Hour}
+	params := types.DefaultParams()
+	params.UploadQuota = &quota
+	require.NoError(t, k.SetParams(ctx, params))
+	creator := RandomAccountAddress(t)
+	contractKeeper := NewDefaultPermissionKeeper(k)
+	startTime := ctx.BlockTime()
+
+	// first upload
+	_, _, err := contractKeeper.Create(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+	// second upload within the window
+	ctx = ctx.WithBlockTime(startTime.Add(30 * time.Minute))
+	_, _, err = contractKeeper.Create(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	exp := types.QueryUploadQuotaResponse{Quota: quota, CodesUsed: 2, BytesUsed: 2 * codeSize, BytesRemaining: 8 * codeSize}
+	assert.Equal(t, exp, k.UploadQuota(ctx, creator))
+	_, _, err = contractKeeper.Create(ctx, creator, wasmCode, nil)
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+
+	// when the first upload leaves the window
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour))
+	exp = types.QueryUploadQuotaResponse{Quota: quota, CodesUsed: 1, BytesUsed: codeSize, CodesRemaining: 1, BytesRemaining: 9 * codeSize}
+	assert.Equal(t, exp, k.UploadQuota(ctx, creator))
+	_, _, err = contractKeeper.Create(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	// then the expired record was pruned
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetUploadRecordPrefix(creator))
+	iter := store.Iterator(nil, nil)
+	var records int
+	for ; iter.Valid(); iter.Next() {
+		records++
+	}
+	iter.Close()
+	assert.Equal(t, 2, records)
+
+	// and other addresses are not affected
+	otherAddr := RandomAccountAddress(t)
+	exp = types.QueryUploadQuotaResponse{Quota: quota, CodesRemaining: 2, BytesRemaining: 10 * codeSize}
+	assert.Equal(t, exp, k.UploadQuota(ctx, otherAddr))
+}
+
+func TestUploadRecordsGenesisExportImport(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	wasmCode := testdata.HackatomContractWasm()
+	codeSize := uint64(len(wasmCode))
+	quota := types.UploadQuotaParams{MaxCodes: 2, Window: time.Hour}
+	params := types.DefaultParams()
+	params.UploadQuota = &quota
+	require.NoError(t, k.SetParams(ctx, params))
+	creator := RandomAccountAddress(t)
+	_, _, err := NewDefaultPermissionKeeper(k).Create(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	// when
+	exported := ExportGenesis(ctx, k)
+	// then
+	exp := []types.UploadRecord{{Creator: creator.String(), UploadTime: ctx.BlockTime().UnixNano(), CodeID: 1, CodeSize: codeSize}}
+	assert.Equal(t, exp, exported.UploadRecords)
+
+	// when imported
+	dstCtx, dstKeepers := CreateTestInput(t, false, AvailableCapabilities)
+	dstCtx = dstCtx.WithBlockTime(ctx.BlockTime())
+	_, err = InitGenesis(dstCtx, dstKeepers.WasmKeeper, *exported)
+	require.NoError(t, err)
+	// then the usage is preserved
+	assert.Equal(t, k.UploadQuota(ctx, creator), dstKeepers.WasmKeeper.UploadQuota(dstCtx, creator))
+	assert.Equal(t, exp, ExportGenesis(dstCtx, dstKeepers.WasmKeeper).UploadRecords)
+}
+
+func TestQueryUploadQuotaExempt(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	exemptAddr := RandomAccountAddress(t)
+	quota := types.UploadQuotaParams{MaxCodes: 1, Window: time.Hour, ExemptAddresses: []string{exemptAddr.String()}}
+	params := types.DefaultParams()
+	params.UploadQuota = &quota
+	require.NoError(t, k.SetParams(ctx, params))
+	q := Querier(k)
+
+	specs := map[string]struct {
+		addr      string
+		expExempt bool
+	}{
+		"exempt address": {
+			addr:      exemptAddr.String(),
+			expExempt: true,
+		},
+		"gov authority": {
+			addr:      k.GetAuthority(),
+			expExempt: true,
+		},
+		"other address": {
+			addr: RandomBech32AccountAddress(t),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, err := q.UploadQuota(sdk.WrapSDKContext(ctx), &types.QueryUploadQuotaRequest{Address: spec.addr})
+			require.NoError(t, err)
+			assert.Equal(t, spec.expExempt, got.Exempt)
+			assert.Equal(t, quota, got.Quota)
+		})
+	}
+	// and invalid address
+	_, err := q.UploadQuota(sdk.WrapSDKContext(ctx), &types.QueryUploadQuotaRequest{Address: "invalid"})
+	require.Error(t, err)
+}
@@ -0,0 +1,35 @@
+package v4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// Keeper abstract keeper
+type wasmKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	SetParams(ctx sdk.Context, ps types.Params) error
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper wasmKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k wasmKeeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+// The gas register params are set to the default costs that were hardcoded before.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	if params.GasRegister != nil {
+		return nil
+	}
+	gasRegister := types.DefaultGasRegisterParams()
+	params.GasRegister = &gasRegister
+	return m.keeper.SetParams(ctx, params)
+}
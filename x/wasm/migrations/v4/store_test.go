@@ -0,0 +1,56 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	v4 "github.com/CosmWasm/wasmd/x/wasm/migrations/v4"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate4To5(t *testing.T) {
+	const AvailableCapabilities = "iterator,staking,stargate,cosmwasm_1_1"
+	ctx, keepers := keeper.CreateTestInput(t, false, AvailableCapabilities)
+	wasmKeeper := keepers.WasmKeeper
+
+	myGasRegister := types.DefaultGasRegisterParams()
+	myGasRegister.InstanceCost = 1
+	specs := map[string]struct {
+		src types.Params
+		exp *types.GasRegisterParams
+	}{
+		"gas register not set": {
+			src: types.Params{
+				CodeUploadAccess:             types.AllowNobody,
+				InstantiateDefaultPermission: types.AccessTypeNobody,
+			},
+			exp: func() *types.GasRegisterParams { x := types.DefaultGasRegisterParams(); return &x }(),
+		},
+		"gas register set": {
+			src: types.Params{
+				CodeUploadAccess:             types.AllowNobody,
+				InstantiateDefaultPermission: types.AccessTypeNobody,
+				GasRegister:                  &myGasRegister,
+			},
+			exp: &myGasRegister,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			require.NoError(t, wasmKeeper.SetParams(ctx, spec.src))
+
+			// when
+			require.NoError(t, v4.NewMigrator(wasmKeeper).Migrate4to5(ctx))
+
+			// then
+			got := wasmKeeper.GetParams(ctx)
+			assert.Equal(t, spec.exp, got.GasRegister)
+			assert.Equal(t, spec.src.CodeUploadAccess, got.CodeUploadAccess)
+			assert.Equal(t, spec.src.InstantiateDefaultPermission, got.InstantiateDefaultPermission)
+		})
+	}
+}
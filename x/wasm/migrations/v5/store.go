@@ -0,0 +1,38 @@
+package v5
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// Keeper abstract keeper
+type wasmKeeper interface {
+	IterateCodeInfos(ctx sdk.Context, cb func(uint64, types.CodeInfo) bool)
+	GetCodeAnalysis(ctx sdk.Context, codeID uint64) *types.CodeAnalysis
+	AnalyzeStoredCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeInfo) error
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper wasmKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k wasmKeeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate5to6 migrates from version 5 to 6.
+// The static analysis results are stored for all existing codes.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	var err error
+	m.keeper.IterateCodeInfos(ctx, func(codeID uint64, info types.CodeInfo) bool {
+		if m.keeper.GetCodeAnalysis(ctx, codeID) != nil {
+			return false
+		}
+		err = m.keeper.AnalyzeStoredCode(ctx, codeID, info)
+		return err != nil
+	})
+	return err
+}
@@ -0,0 +1,49 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	v5 "github.com/CosmWasm/wasmd/x/wasm/migrations/v5"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate5To6(t *testing.T) {
+	const AvailableCapabilities = "iterator,staking,stargate,cosmwasm_1_1"
+	ctx, keepers := keeper.CreateTestInput(t, false, AvailableCapabilities)
+	wasmKeeper := keepers.WasmKeeper
+
+	hackatom := keeper.StoreHackatomExampleContract(t, ctx, keepers)
+	reflect := keeper.StoreReflectContract(t, ctx, keepers)
+	expHackatom := wasmKeeper.GetCodeAnalysis(ctx, hackatom.CodeID)
+	require.NotNil(t, expHackatom)
+	expReflect := wasmKeeper.GetCodeAnalysis(ctx, reflect.CodeID)
+	require.NotNil(t, expReflect)
+	// remove the analysis as stored before the migration
+	store := ctx.KVStore(keepers.WasmStoreKey)
+	store.Delete(types.GetCodeAnalysisKey(hackatom.CodeID))
+	store.Delete(types.GetCodeAnalysisKey(reflect.CodeID))
+
+	// when
+	require.NoError(t, v5.NewMigrator(wasmKeeper).Migrate5to6(ctx))
+
+	// then
+	assert.Equal(t, expHackatom, wasmKeeper.GetCodeAnalysis(ctx, hackatom.CodeID))
+	assert.Equal(t, expReflect, wasmKeeper.GetCodeAnalysis(ctx, reflect.CodeID))
+	assert.Equal(t, []string{"execute", "instantiate", "migrate", "query", "sudo"}, expHackatom.Entrypoints)
+	assert.Contains(t, expReflect.RequiredCapabilities, "stargate")
+}
+
+func TestMigrate5To6MissingCode(t *testing.T) {
+	const AvailableCapabilities = "iterator,staking,stargate,cosmwasm_1_1"
+	ctx, keepers := keeper.CreateTestInput(t, false, AvailableCapabilities)
+	creator := keeper.RandomAccountAddress(t)
+	codeInfo := types.NewCodeInfo([]byte("unknown checksum 1234567890123456"), creator, types.AllowEverybody)
+	store := ctx.KVStore(keepers.WasmStoreKey)
+	store.Set(types.GetCodeKey(1), keepers.EncodingConfig.Codec.MustMarshal(&codeInfo))
+
+	require.Error(t, v5.NewMigrator(keepers.WasmKeeper).Migrate5to6(ctx))
+}
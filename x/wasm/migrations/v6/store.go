@@ -0,0 +1,36 @@
+package v6
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// Keeper abstract keeper
+type wasmKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	SetParams(ctx sdk.Context, ps types.Params) error
+	VMCapabilityParams() types.CapabilityParams
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper wasmKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k wasmKeeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate6to7 migrates from version 6 to 7.
+// The capability params are set to the capabilities that the keeper was configured with before.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	if params.Capabilities != nil {
+		return nil
+	}
+	capabilities := m.keeper.VMCapabilityParams()
+	params.Capabilities = &capabilities
+	return m.keeper.SetParams(ctx, params)
+}
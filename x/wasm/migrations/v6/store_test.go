@@ -0,0 +1,55 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	v6 "github.com/CosmWasm/wasmd/x/wasm/migrations/v6"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate6To7(t *testing.T) {
+	const AvailableCapabilities = "iterator,staking,stargate,cosmwasm_1_1"
+	ctx, keepers := keeper.CreateTestInput(t, false, AvailableCapabilities)
+	wasmKeeper := keepers.WasmKeeper
+
+	myCapabilities := types.CapabilityParams{Enabled: []string{"iterator"}}
+	specs := map[string]struct {
+		src types.Params
+		exp *types.CapabilityParams
+	}{
+		"capabilities not set": {
+			src: types.Params{
+				CodeUploadAccess:             types.AllowNobody,
+				InstantiateDefaultPermission: types.AccessTypeNobody,
+			},
+			exp: &types.CapabilityParams{Enabled: []string{"iterator", "staking", "stargate", "cosmwasm_1_1"}},
+		},
+		"capabilities set": {
+			src: types.Params{
+				CodeUploadAccess:             types.AllowNobody,
+				InstantiateDefaultPermission: types.AccessTypeNobody,
+				Capabilities:                 &myCapabilities,
+			},
+			exp: &myCapabilities,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			require.NoError(t, wasmKeeper.SetParams(ctx, spec.src))
+
+			// when
+			require.NoError(t, v6.NewMigrator(wasmKeeper).Migrate6to7(ctx))
+
+			// then
+			got := wasmKeeper.GetParams(ctx)
+			assert.Equal(t, spec.exp, got.Capabilities)
+			assert.Equal(t, spec.src.CodeUploadAccess, got.CodeUploadAccess)
+			assert.Equal(t, spec.src.InstantiateDefaultPermission, got.InstantiateDefaultPermission)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package v7
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// Keeper abstract keeper
+type wasmKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	SetParams(ctx sdk.Context, ps types.Params) error
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper wasmKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k wasmKeeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate7to8 migrates from version 7 to 8.
+// The size limit params are set to the default limits that were hardcoded before. Limits that
+// a chain customized via the former `MaxWasmSize`, `MaxProposalWasmSize` and `MaxLabelSize`
+// variables are not preserved. Such chains must set their limits in the upgrade handler after
+// the migrations were run.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	if params.SizeLimits != nil {
+		return nil
+	}
+	limits := types.DefaultSizeLimitParams()
+	params.SizeLimits = &limits
+	return m.keeper.SetParams(ctx, params)
+}
@@ -0,0 +1,55 @@
+package v7_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	v7 "github.com/CosmWasm/wasmd/x/wasm/migrations/v7"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate7To8(t *testing.T) {
+	const AvailableCapabilities = "iterator,staking,stargate,cosmwasm_1_1"
+	ctx, keepers := keeper.CreateTestInput(t, false, AvailableCapabilities)
+	wasmKeeper := keepers.WasmKeeper
+
+	myLimits := types.SizeLimitParams{MaxWasmSize: 1, MaxProposalWasmSize: 2, MaxLabelSize: 3, MaxSaltSize: 4}
+	specs := map[string]struct {
+		src types.Params
+		exp *types.SizeLimitParams
+	}{
+		"size limits not set": {
+			src: types.Params{
+				CodeUploadAccess:             types.AllowNobody,
+				InstantiateDefaultPermission: types.AccessTypeNobody,
+			},
+			exp: func() *types.SizeLimitParams { x := types.DefaultSizeLimitParams(); return &x }(),
+		},
+		"size limits set": {
+			src: types.Params{
+				CodeUploadAccess:             types.AllowNobody,
+				InstantiateDefaultPermission: types.AccessTypeNobody,
+				SizeLimits:                   &myLimits,
+			},
+			exp: &myLimits,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			require.NoError(t, wasmKeeper.SetParams(ctx, spec.src))
+
+			// when
+			require.NoError(t, v7.NewMigrator(wasmKeeper).Migrate7to8(ctx))
+
+			// then
+			got := wasmKeeper.GetParams(ctx)
+			assert.Equal(t, spec.exp, got.SizeLimits)
+			assert.Equal(t, spec.src.CodeUploadAccess, got.CodeUploadAccess)
+			assert.Equal(t, spec.src.InstantiateDefaultPermission, got.InstantiateDefaultPermission)
+		})
+	}
+}
@@ -40,9 +40,21 @@ var (
 // Module init related flags
 const (
 	flagWasmMemoryCacheSize        = "wasm.memory_cache_size"
+	flagWasmQueryMemoryCacheSize   = "wasm.query_memory_cache_size"
 	flagWasmQueryGasLimit          = "wasm.query_gas_limit"
+	flagWasmMaxConcurrentQueries   = "wasm.max_concurrent_smart_queries"
+	flagWasmSmartQueryTimeout      = "wasm.smart_query_timeout"
+	flagWasmSmartQueryCacheSize    = "wasm.smart_query_cache_size"
+	flagWasmCodeUsageTracking      = "wasm.code_usage_tracking"
+	flagWasmCacheWarmupWorkers     = "wasm.cache_warmup_workers"
 	flagWasmSimulationGasLimit     = "wasm.simulation_gas_limit"
 	flagWasmSkipWasmVMVersionCheck = "wasm.skip_wasmvm_version_check"
+	flagWasmGasTracing             = "wasm.gas_tracing"
+	flagWasmGasTraceFile           = "wasm.gas_trace_file"
+	flagWasmMetricsMaxCodeIDLabels = "wasm.metrics_max_code_id_labels"
+	flagWasmTracingExporter        = "wasm.tracing_exporter"
+	flagWasmTracingOTLPEndpoint    = "wasm.tracing_otlp_endpoint"
+	flagWasmTracingOTLPInsecure    = "wasm.tracing_otlp_insecure"
 )
 
 // AppModuleBasic defines the basic application module used by the wasm module.
@@ -147,7 +159,7 @@ func (am AppModule) IsAppModule() { // marker
 // module. It should be incremented on each consensus-breaking change
 // introduced by the module. To avoid wrong/empty versions, the initial version
 // should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 4 }
+func (AppModule) ConsensusVersion() uint64 { return 8 }
 
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
@@ -166,6 +178,22 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+	err = cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 7, m.Migrate7to8)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // RegisterInvariants registers the wasm module invariants.
@@ -198,9 +226,14 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // BeginBlock returns the begin blocker for the wasm module.
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock returns the end blocker for the wasm module. It returns no validator
-// updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock returns the end blocker for the wasm module. It removes expired addresses from
+// the code upload access params, persists the node local code usage counters and returns
+// no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	if err := am.keeper.PruneExpiredUploadAccess(ctx); err != nil {
+		panic(err)
+	}
+	am.keeper.FlushCodeUsage(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -233,9 +266,21 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 func AddModuleInitFlags(startCmd *cobra.Command) {
 	defaults := types.DefaultWasmConfig()
 	startCmd.Flags().Uint32(flagWasmMemoryCacheSize, defaults.MemoryCacheSize, "Sets the size in MiB (NOT bytes) of an in-memory cache for Wasm modules. Set to 0 to disable.")
+	startCmd.Flags().Uint32(flagWasmQueryMemoryCacheSize, defaults.QueryMemoryCacheSize, "Sets the size in MiB (NOT bytes) of the in-memory cache of a separate wasmvm instance for smart queries via gRPC. Set to 0 to disable the instance.")
 	startCmd.Flags().Uint64(flagWasmQueryGasLimit, defaults.SmartQueryGasLimit, "Set the max gas that can be spent on executing a query with a Wasm contract")
+	startCmd.Flags().Uint32(flagWasmMaxConcurrentQueries, defaults.MaxConcurrentSmartQueries, "Set the max number of smart queries via gRPC that are executed in parallel. Set to 0 for unlimited.")
+	startCmd.Flags().Duration(flagWasmSmartQueryTimeout, defaults.SmartQueryTimeout, "Set the max wall-clock time of a smart query via gRPC. Set to 0 to disable.")
+	startCmd.Flags().Uint32(flagWasmSmartQueryCacheSize, defaults.SmartQueryCacheSize, "Set the size in MiB of the node local cache for smart query results via gRPC. Set to 0 to disable.")
+	startCmd.Flags().Bool(flagWasmCodeUsageTracking, defaults.CodeUsageTracking, "Count the contract instantiations and executions per code on this node for pin recommendations")
+	startCmd.Flags().Uint32(flagWasmCacheWarmupWorkers, defaults.CacheWarmupWorkers, "Set the number of workers that compile all unpinned codes into the wasmvm file cache in the background on startup. Set to 0 to disable.")
 	startCmd.Flags().String(flagWasmSimulationGasLimit, "", "Set the max gas that can be spent when executing a simulation TX")
 	startCmd.Flags().Bool(flagWasmSkipWasmVMVersionCheck, false, "Skip check that ensures that libwasmvm version (the Rust project) and wasmvm version (the Go project) match")
+	startCmd.Flags().Bool(flagWasmGasTracing, defaults.GasTracing, "Return a call tree of the wasm operations with their gas consumption in tx simulations")
+	startCmd.Flags().String(flagWasmGasTraceFile, defaults.GasTraceFile, "Append the gas traces of all delivered txs as json lines to this file")
+	startCmd.Flags().Uint32(flagWasmMetricsMaxCodeIDLabels, defaults.MetricsMaxCodeIDLabels, "Set the max number of distinct code ids used as label in the contract execution metrics. Set to 0 to disable the label.")
+	startCmd.Flags().String(flagWasmTracingExporter, defaults.TracingExporter, "Export OpenTelemetry spans for the wasm operations: otlp or stdout. Disabled when empty")
+	startCmd.Flags().String(flagWasmTracingOTLPEndpoint, defaults.TracingOTLPEndpoint, "Set the host and port of the OTLP gRPC collector")
+	startCmd.Flags().Bool(flagWasmTracingOTLPInsecure, defaults.TracingOTLPInsecure, "Disable the client transport security for the OTLP exporter")
 
 	preCheck := func(cmd *cobra.Command, _ []string) error {
 		skip, err := cmd.Flags().GetBool(flagWasmSkipWasmVMVersionCheck)
@@ -260,11 +305,41 @@ func ReadWasmConfig(opts servertypes.AppOptions) (types.WasmConfig, error) {
 			return cfg, err
 		}
 	}
+	if v := opts.Get(flagWasmQueryMemoryCacheSize); v != nil {
+		if cfg.QueryMemoryCacheSize, err = cast.ToUint32E(v); err != nil {
+			return cfg, err
+		}
+	}
 	if v := opts.Get(flagWasmQueryGasLimit); v != nil {
 		if cfg.SmartQueryGasLimit, err = cast.ToUint64E(v); err != nil {
 			return cfg, err
 		}
 	}
+	if v := opts.Get(flagWasmMaxConcurrentQueries); v != nil {
+		if cfg.MaxConcurrentSmartQueries, err = cast.ToUint32E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagWasmSmartQueryTimeout); v != nil {
+		if cfg.SmartQueryTimeout, err = cast.ToDurationE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagWasmSmartQueryCacheSize); v != nil {
+		if cfg.SmartQueryCacheSize, err = cast.ToUint32E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagWasmCodeUsageTracking); v != nil {
+		if cfg.CodeUsageTracking, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagWasmCacheWarmupWorkers); v != nil {
+		if cfg.CacheWarmupWorkers, err = cast.ToUint32E(v); err != nil {
+			return cfg, err
+		}
+	}
 	if v := opts.Get(flagWasmSimulationGasLimit); v != nil {
 		if raw, ok := v.(string); !ok || raw != "" {
 			limit, err := cast.ToUint64E(v) // non empty string set
@@ -274,6 +349,36 @@ func ReadWasmConfig(opts servertypes.AppOptions) (types.WasmConfig, error) {
 			cfg.SimulationGasLimit = &limit
 		}
 	}
+	if v := opts.Get(flagWasmGasTracing); v != nil {
+		if cfg.GasTracing, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagWasmGasTraceFile); v != nil {
+		if cfg.GasTraceFile, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagWasmMetricsMaxCodeIDLabels); v != nil {
+		if cfg.MetricsMaxCodeIDLabels, err = cast.ToUint32E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagWasmTracingExporter); v != nil {
+		if cfg.TracingExporter, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagWasmTracingOTLPEndpoint); v != nil {
+		if cfg.TracingOTLPEndpoint, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagWasmTracingOTLPInsecure); v != nil {
+		if cfg.TracingOTLPInsecure, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
 	// attach contract debugging to global "trace" flag
 	if v := opts.Get(server.FlagTrace); v != nil {
 		if cfg.ContractDebugMode, err = cast.ToBoolE(v); err != nil {
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/ed25519"
@@ -478,6 +479,94 @@ func TestReadWasmConfig(t *testing.T) {
 				ContractDebugMode:  true,
 			},
 		},
+		"set gas tracing via opts": {
+			src: AppOptionsMock{
+				"wasm.gas_tracing":    true,
+				"wasm.gas_trace_file": "/tmp/trace.jsonl",
+			},
+			exp: types.WasmConfig{
+				SmartQueryGasLimit: defaults.SmartQueryGasLimit,
+				MemoryCacheSize:    defaults.MemoryCacheSize,
+				GasTracing:         true,
+				GasTraceFile:       "/tmp/trace.jsonl",
+			},
+		},
+		"set smart query limits via opts": {
+			src: AppOptionsMock{
+				"wasm.max_concurrent_smart_queries": 10,
+				"wasm.smart_query_timeout":          "5s",
+			},
+			exp: types.WasmConfig{
+				SmartQueryGasLimit:        defaults.SmartQueryGasLimit,
+				MemoryCacheSize:           defaults.MemoryCacheSize,
+				MaxConcurrentSmartQueries: 10,
+				SmartQueryTimeout:         5 * time.Second,
+			},
+		},
+		"set smart query cache size via opts": {
+			src: AppOptionsMock{
+				"wasm.smart_query_cache_size": 16,
+			},
+			exp: types.WasmConfig{
+				SmartQueryGasLimit:  defaults.SmartQueryGasLimit,
+				MemoryCacheSize:     defaults.MemoryCacheSize,
+				SmartQueryCacheSize: 16,
+			},
+		},
+		"set code usage tracking via opts": {
+			src: AppOptionsMock{
+				"wasm.code_usage_tracking": true,
+			},
+			exp: types.WasmConfig{
+				SmartQueryGasLimit: defaults.SmartQueryGasLimit,
+				MemoryCacheSize:    defaults.MemoryCacheSize,
+				CodeUsageTracking:  true,
+			},
+		},
+		"set cache warmup workers via opts": {
+			src: AppOptionsMock{
+				"wasm.cache_warmup_workers": 4,
+			},
+			exp: types.WasmConfig{
+				SmartQueryGasLimit: defaults.SmartQueryGasLimit,
+				MemoryCacheSize:    defaults.MemoryCacheSize,
+				CacheWarmupWorkers: 4,
+			},
+		},
+		"set query memory cache size via opts": {
+			src: AppOptionsMock{
+				"wasm.query_memory_cache_size": 20,
+			},
+			exp: types.WasmConfig{
+				SmartQueryGasLimit:   defaults.SmartQueryGasLimit,
+				MemoryCacheSize:      defaults.MemoryCacheSize,
+				QueryMemoryCacheSize: 20,
+			},
+		},
+		"set metrics code id labels via opts": {
+			src: AppOptionsMock{
+				"wasm.metrics_max_code_id_labels": 10,
+			},
+			exp: types.WasmConfig{
+				SmartQueryGasLimit:     defaults.SmartQueryGasLimit,
+				MemoryCacheSize:        defaults.MemoryCacheSize,
+				MetricsMaxCodeIDLabels: 10,
+			},
+		},
+		"set tracing via opts": {
+			src: AppOptionsMock{
+				"wasm.tracing_exporter":      "otlp",
+				"wasm.tracing_otlp_endpoint": "localhost:4317",
+				"wasm.tracing_otlp_insecure": true,
+			},
+			exp: types.WasmConfig{
+				SmartQueryGasLimit:  defaults.SmartQueryGasLimit,
+				MemoryCacheSize:     defaults.MemoryCacheSize,
+				TracingExporter:     "otlp",
+				TracingOTLPEndpoint: "localhost:4317",
+				TracingOTLPInsecure: true,
+			},
+		},
 		"all defaults when no options set": {
 			src: AppOptionsMock{},
 			exp: defaults,
@@ -491,12 +580,42 @@ func TestReadWasmConfig(t *testing.T) {
 				SimulationGasLimit: &one,
 				SmartQueryGasLimit: 2,
 				MemoryCacheSize:    3,
+				GasTracing:         true,
+				GasTraceFile:       "/tmp/trace.jsonl",
+
+				MetricsMaxCodeIDLabels: 4,
+				QueryMemoryCacheSize:   5,
+
+				MaxConcurrentSmartQueries: 6,
+				SmartQueryTimeout:         7 * time.Second,
+				SmartQueryCacheSize:       8,
+				CodeUsageTracking:         true,
+				CacheWarmupWorkers:        9,
+
+				TracingExporter:     "stdout",
+				TracingOTLPEndpoint: "localhost:4317",
+				TracingOTLPInsecure: true,
 			})),
 			exp: types.WasmConfig{
 				SimulationGasLimit: &one,
 				SmartQueryGasLimit: 2,
 				MemoryCacheSize:    3,
 				ContractDebugMode:  false,
+				GasTracing:         true,
+				GasTraceFile:       "/tmp/trace.jsonl",
+
+				MetricsMaxCodeIDLabels: 4,
+				QueryMemoryCacheSize:   5,
+
+				MaxConcurrentSmartQueries: 6,
+				SmartQueryTimeout:         7 * time.Second,
+				SmartQueryCacheSize:       8,
+				CodeUsageTracking:         true,
+				CacheWarmupWorkers:        9,
+
+				TracingExporter:     "stdout",
+				TracingOTLPEndpoint: "localhost:4317",
+				TracingOTLPInsecure: true,
 			},
 		},
 	}
@@ -0,0 +1,124 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs basic validation
+func (e AccessExpiry) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
+		return errorsmod.Wrapf(err, "address: %s", e.Address)
+	}
+	if e.Time == nil && e.Height == 0 {
+		return errorsmod.Wrapf(ErrEmpty, "time or height: %s", e.Address)
+	}
+	return nil
+}
+
+// IsExpired returns true when the block time or height of the context reached the expiry
+func (e AccessExpiry) IsExpired(ctx sdk.Context) bool {
+	if e.Time != nil && !ctx.BlockTime().Before(*e.Time) {
+		return true
+	}
+	return e.Height != 0 && ctx.BlockHeight() >= 0 && uint64(ctx.BlockHeight()) >= e.Height
+}
+
+// expiresNotAfter returns true when the expiry is reached no later than the other expiry for any block.
+// Time and height can not be compared with each other so that every condition of the other expiry must be
+// matched by a condition of the same kind.
+func (e AccessExpiry) expiresNotAfter(o AccessExpiry) bool {
+	if o.Time != nil && (e.Time == nil || e.Time.After(*o.Time)) {
+		return false
+	}
+	if o.Height != 0 && (e.Height == 0 || e.Height > o.Height) {
+		return false
+	}
+	return true
+}
+
+// Expiry returns the expiry of the address or false when the address does not expire
+func (a AccessConfig) Expiry(address string) (AccessExpiry, bool) {
+	for _, e := range a.Expiries {
+		if e.Address == address {
+			return e, true
+		}
+	}
+	return AccessExpiry{}, false
+}
+
+// isExpired returns true when the address has an expiry that was reached
+func (a AccessConfig) isExpired(ctx sdk.Context, address string) bool {
+	e, ok := a.Expiry(address)
+	return ok && e.IsExpired(ctx)
+}
+
+// WithoutExpired returns a copy of the config without the expired addresses and their expiries. The permission is
+// set to nobody when no address is left. The constraints are kept. Returns false and the unmodified config when
+// nothing was expired.
+func (a AccessConfig) WithoutExpired(ctx sdk.Context) (AccessConfig, bool) {
+	if a.Permission != AccessTypeAnyOfAddresses || len(a.Expiries) == 0 {
+		return a, false
+	}
+	r := AccessConfig{Permission: a.Permission, Constraints: a.Constraints}
+	for _, addr := range a.Addresses {
+		if !a.isExpired(ctx, addr) {
+			r.Addresses = append(r.Addresses, addr)
+		}
+	}
+	if len(r.Addresses) == len(a.Addresses) {
+		return a, false
+	}
+	if len(r.Addresses) == 0 {
+		return AccessConfig{Permission: AccessTypeNobody, Constraints: a.Constraints}, true
+	}
+	for _, e := range a.Expiries {
+		if !e.IsExpired(ctx) {
+			r.Expiries = append(r.Expiries, e)
+		}
+	}
+	return r, true
+}
+
+// WithoutAddresses returns a copy of the config without the given addresses and their expiries
+func (a AccessConfig) WithoutAddresses(addrs ...string) AccessConfig {
+	r := AccessConfig{Permission: a.Permission, Addresses: make([]string, 0, len(a.Addresses)), Constraints: a.Constraints}
+	for _, v := range a.Addresses {
+		if !containsAddress(addrs, v) {
+			r.Addresses = append(r.Addresses, v)
+		}
+	}
+	for _, e := range a.Expiries {
+		if !containsAddress(addrs, e.Address) {
+			r.Expiries = append(r.Expiries, e)
+		}
+	}
+	return r
+}
+
+func containsAddress(addrs []string, addr string) bool {
+	for _, v := range addrs {
+		if v == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func assertValidExpiries(addrs []string, expiries []AccessExpiry) error {
+	idx := make(map[string]struct{}, len(expiries))
+	for _, e := range expiries {
+		if err := e.ValidateBasic(); err != nil {
+			return err
+		}
+		if !containsAddress(addrs, e.Address) {
+			return errorsmod.Wrapf(ErrInvalid, "expiry for unknown address: %s", e.Address)
+		}
+		if _, exists := idx[e.Address]; exists {
+			return ErrDuplicate.Wrapf("expiry for address: %s", e.Address)
+		}
+		idx[e.Address] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,253 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAccessConfigAllowedWithExpiry(t *testing.T) {
+	myAddr := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	otherAddr := sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+	now := time.Now().UTC()
+	ctx := sdk.Context{}.WithBlockTime(now).WithBlockHeight(100)
+
+	specs := map[string]struct {
+		expiry AccessExpiry
+		exp    bool
+	}{
+		"time not reached": {
+			expiry: AccessExpiry{Address: myAddr.String(), Time: timePtr(now.Add(time.Nanosecond))},
+			exp:    true,
+		},
+		"time reached": {
+			expiry: AccessExpiry{Address: myAddr.String(), Time: timePtr(now)},
+		},
+		"height not reached": {
+			expiry: AccessExpiry{Address: myAddr.String(), Height: 101},
+			exp:    true,
+		},
+		"height reached": {
+			expiry: AccessExpiry{Address: myAddr.String(), Height: 100},
+		},
+		"time reached before height": {
+			expiry: AccessExpiry{Address: myAddr.String(), Time: timePtr(now.Add(-time.Second)), Height: 101},
+		},
+		"height reached before time": {
+			expiry: AccessExpiry{Address: myAddr.String(), Time: timePtr(now.Add(time.Second)), Height: 99},
+		},
+		"other address expired": {
+			expiry: AccessExpiry{Address: otherAddr.String(), Height: 1},
+			exp:    true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cfg := AccessConfig{
+				Permission: AccessTypeAnyOfAddresses,
+				Addresses:  []string{myAddr.String(), otherAddr.String()},
+				Expiries:   []AccessExpiry{spec.expiry},
+			}
+			assert.Equal(t, spec.exp, cfg.Allowed(ctx, myAddr))
+		})
+	}
+}
+
+func TestAccessConfigIsSubsetWithExpiry(t *testing.T) {
+	now := time.Now().UTC()
+	ctx := sdk.Context{}.WithBlockTime(now).WithBlockHeight(100)
+	anyOf := func(expiries ...AccessExpiry) AccessConfig {
+		return AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"owner", "other"}, Expiries: expiries}
+	}
+
+	specs := map[string]struct {
+		superSet AccessConfig
+		check    AccessConfig
+		isSubSet bool
+	}{
+		"no expiry < expiry in superset": {
+			superSet: anyOf(AccessExpiry{Address: "owner", Height: 200}),
+			check:    anyOf(),
+			isSubSet: false,
+		},
+		"same expiry < expiry in superset": {
+			superSet: anyOf(AccessExpiry{Address: "owner", Height: 200}),
+			check:    anyOf(AccessExpiry{Address: "owner", Height: 200}),
+			isSubSet: true,
+		},
+		"earlier expiry < expiry in superset": {
+			superSet: anyOf(AccessExpiry{Address: "owner", Height: 200, Time: timePtr(now.Add(time.Hour))}),
+			check:    anyOf(AccessExpiry{Address: "owner", Height: 150, Time: timePtr(now.Add(time.Minute))}),
+			isSubSet: true,
+		},
+		"later height expiry !< expiry in superset": {
+			superSet: anyOf(AccessExpiry{Address: "owner", Height: 200}),
+			check:    anyOf(AccessExpiry{Address: "owner", Height: 201}),
+			isSubSet: false,
+		},
+		"later time expiry !< expiry in superset": {
+			superSet: anyOf(AccessExpiry{Address: "owner", Time: timePtr(now.Add(time.Minute))}),
+			check:    anyOf(AccessExpiry{Address: "owner", Time: timePtr(now.Add(time.Hour))}),
+			isSubSet: false,
+		},
+		"height expiry !< time expiry in superset": {
+			superSet: anyOf(AccessExpiry{Address: "owner", Time: timePtr(now.Add(time.Hour))}),
+			check:    anyOf(AccessExpiry{Address: "owner", Height: 101}),
+			isSubSet: false,
+		},
+		"expiry < no expiry in superset": {
+			superSet: anyOf(),
+			check:    anyOf(AccessExpiry{Address: "owner", Height: 200}),
+			isSubSet: true,
+		},
+		"expired address < address expired in superset": {
+			superSet: anyOf(AccessExpiry{Address: "owner", Height: 100}),
+			check:    anyOf(AccessExpiry{Address: "owner", Height: 90}),
+			isSubSet: true,
+		},
+		"active address !< address expired in superset": {
+			superSet: anyOf(AccessExpiry{Address: "owner", Height: 100}),
+			check:    anyOf(AccessExpiry{Address: "owner", Height: 150}),
+			isSubSet: false,
+		},
+		"expired address not in superset": {
+			superSet: AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"owner"}},
+			check: AccessConfig{
+				Permission: AccessTypeAnyOfAddresses,
+				Addresses:  []string{"owner", "foo"},
+				Expiries:   []AccessExpiry{{Address: "foo", Height: 100}},
+			},
+			isSubSet: true,
+		},
+		"active address not in superset": {
+			superSet: AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"owner"}},
+			check: AccessConfig{
+				Permission: AccessTypeAnyOfAddresses,
+				Addresses:  []string{"owner", "foo"},
+				Expiries:   []AccessExpiry{{Address: "foo", Height: 101}},
+			},
+			isSubSet: false,
+		},
+		"expiry < everybody": {
+			superSet: AllowEverybody,
+			check:    anyOf(AccessExpiry{Address: "owner", Height: 200}),
+			isSubSet: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, spec.isSubSet, spec.check.IsSubset(ctx, spec.superSet))
+		})
+	}
+}
+
+func TestAccessConfigValidateBasicWithExpiry(t *testing.T) {
+	myAddr := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	otherAddr := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	specs := map[string]struct {
+		src    AccessConfig
+		expErr bool
+	}{
+		"height": {
+			src: AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{myAddr}, Expiries: []AccessExpiry{{Address: myAddr, Height: 1}}},
+		},
+		"time": {
+			src: AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{myAddr}, Expiries: []AccessExpiry{{Address: myAddr, Time: timePtr(time.Now())}}},
+		},
+		"time or height not set": {
+			src:    AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{myAddr}, Expiries: []AccessExpiry{{Address: myAddr}}},
+			expErr: true,
+		},
+		"unknown address": {
+			src:    AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{myAddr}, Expiries: []AccessExpiry{{Address: otherAddr, Height: 1}}},
+			expErr: true,
+		},
+		"invalid address": {
+			src:    AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{myAddr}, Expiries: []AccessExpiry{{Address: "invalid", Height: 1}}},
+			expErr: true,
+		},
+		"duplicate": {
+			src:    AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{myAddr}, Expiries: []AccessExpiry{{Address: myAddr, Height: 1}, {Address: myAddr, Height: 2}}},
+			expErr: true,
+		},
+		"everybody": {
+			src:    AccessConfig{Permission: AccessTypeEverybody, Expiries: []AccessExpiry{{Address: myAddr, Height: 1}}},
+			expErr: true,
+		},
+		"nobody": {
+			src:    AccessConfig{Permission: AccessTypeNobody, Expiries: []AccessExpiry{{Address: myAddr, Height: 1}}},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestAccessConfigWithoutExpired(t *testing.T) {
+	ctx := sdk.Context{}.WithBlockHeight(100)
+	specs := map[string]struct {
+		src       AccessConfig
+		exp       AccessConfig
+		expPruned bool
+	}{
+		"nothing expired": {
+			src: AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"a", "b"}, Expiries: []AccessExpiry{{Address: "a", Height: 101}}},
+			exp: AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"a", "b"}, Expiries: []AccessExpiry{{Address: "a", Height: 101}}},
+		},
+		"one expired": {
+			src:       AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"a", "b", "c"}, Expiries: []AccessExpiry{{Address: "a", Height: 100}, {Address: "b", Height: 101}}},
+			exp:       AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"b", "c"}, Expiries: []AccessExpiry{{Address: "b", Height: 101}}},
+			expPruned: true,
+		},
+		"all expired": {
+			src:       AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"a"}, Expiries: []AccessExpiry{{Address: "a", Height: 1}}},
+			exp:       AllowNobody,
+			expPruned: true,
+		},
+		"all expired with constraints": {
+			src:       AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"a"}, Expiries: []AccessExpiry{{Address: "a", Height: 1}}, Constraints: &InstantiateConstraints{NoAdmin: true}},
+			exp:       AccessConfig{Permission: AccessTypeNobody, Constraints: &InstantiateConstraints{NoAdmin: true}},
+			expPruned: true,
+		},
+		"one expired with constraints": {
+			src:       AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"a", "b"}, Expiries: []AccessExpiry{{Address: "a", Height: 1}}, Constraints: &InstantiateConstraints{NoAdmin: true}},
+			exp:       AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"b"}, Constraints: &InstantiateConstraints{NoAdmin: true}},
+			expPruned: true,
+		},
+		"everybody": {
+			src: AllowEverybody,
+			exp: AllowEverybody,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotPruned := spec.src.WithoutExpired(ctx)
+			assert.Equal(t, spec.expPruned, gotPruned)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestAccessConfigWithoutAddresses(t *testing.T) {
+	src := AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"a", "b", "c"}, Expiries: []AccessExpiry{{Address: "a", Height: 1}, {Address: "b", Height: 2}}}
+	got := src.WithoutAddresses("a", "c")
+	exp := AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"b"}, Expiries: []AccessExpiry{{Address: "b", Height: 2}}}
+	assert.Equal(t, exp, got)
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -1,7 +1,12 @@
 package types
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"reflect"
 	"strings"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 
@@ -18,18 +23,43 @@ const gasDeserializationCostPerByte = uint64(1)
 var (
 	_ authztypes.Authorization         = &ContractExecutionAuthorization{}
 	_ authztypes.Authorization         = &ContractMigrationAuthorization{}
+	_ authztypes.Authorization         = &ContractInstantiationAuthorization{}
+	_ authztypes.Authorization         = &ContractUpdateAdminAuthorization{}
+	_ authztypes.Authorization         = &ContractClearAdminAuthorization{}
 	_ cdctypes.UnpackInterfacesMessage = &ContractExecutionAuthorization{}
 	_ cdctypes.UnpackInterfacesMessage = &ContractMigrationAuthorization{}
+	_ cdctypes.UnpackInterfacesMessage = &ContractInstantiationAuthorization{}
+	_ cdctypes.UnpackInterfacesMessage = &ContractUpdateAdminAuthorization{}
+	_ cdctypes.UnpackInterfacesMessage = &ContractClearAdminAuthorization{}
 )
 
 // AuthzableWasmMsg is abstract wasm tx message that is supported in authz
 type AuthzableWasmMsg interface {
 	GetFunds() sdk.Coins
 	GetMsg() RawContractMessage
+	ValidateBasic() error
+}
+
+// AuthzableContractMsg is abstract wasm tx message on an existing contract that is supported in authz
+type AuthzableContractMsg interface {
+	AuthzableWasmMsg
+	GetContract() string
+}
+
+// AuthzableAdminMsg is abstract wasm tx message to update or clear a contract admin that is supported in authz.
+// They have no contract message and transfer no funds.
+type AuthzableAdminMsg interface {
 	GetContract() string
 	ValidateBasic() error
 }
 
+// AuthzableInstantiateMsg is abstract wasm tx message to instantiate a new contract that is supported in authz
+type AuthzableInstantiateMsg interface {
+	AuthzableWasmMsg
+	GetCodeID() uint64
+	GetAdmin() string
+}
+
 // NewContractExecutionAuthorization constructor
 func NewContractExecutionAuthorization(grants ...ContractGrant) *ContractExecutionAuthorization {
 	return &ContractExecutionAuthorization{
@@ -104,7 +134,167 @@ func (a ContractMigrationAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUn
 	return nil
 }
 
-func validateGrants(g []ContractGrant) error {
+// NewContractUpdateAdminAuthorization constructor. Any new admin is allowed when allowedNewAdmins is empty.
+func NewContractUpdateAdminAuthorization(allowedNewAdmins []string, grants ...ContractGrant) *ContractUpdateAdminAuthorization {
+	return &ContractUpdateAdminAuthorization{
+		Grants:           grants,
+		AllowedNewAdmins: allowedNewAdmins,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ContractUpdateAdminAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgUpdateAdmin{})
+}
+
+// Accept implements Authorization.Accept.
+func (a *ContractUpdateAdminAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authztypes.AcceptResponse, error) {
+	exec, ok := msg.(*MsgUpdateAdmin)
+	if !ok {
+		return authztypes.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if err := exec.ValidateBasic(); err != nil {
+		return authztypes.AcceptResponse{}, err
+	}
+	if !a.isAllowedNewAdmin(exec.NewAdmin) {
+		return authztypes.AcceptResponse{Accept: false}, nil
+	}
+	return AcceptGrantedAdminMessage(ctx, a.Grants, exec, a)
+}
+
+// isAllowedNewAdmin returns true when no new admins are set or the address is one of them
+func (a ContractUpdateAdminAuthorization) isAllowedNewAdmin(newAdmin string) bool {
+	if len(a.AllowedNewAdmins) == 0 {
+		return true
+	}
+	addr, err := sdk.AccAddressFromBech32(newAdmin)
+	if err != nil {
+		return false
+	}
+	for _, v := range a.AllowedNewAdmins {
+		if allowed, err := sdk.AccAddressFromBech32(v); err == nil && allowed.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewAuthz factory method to create an Authorization with updated grants
+func (a ContractUpdateAdminAuthorization) NewAuthz(g []ContractGrant) authztypes.Authorization {
+	return NewContractUpdateAdminAuthorization(a.AllowedNewAdmins, g...)
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ContractUpdateAdminAuthorization) ValidateBasic() error {
+	if err := validateAdminGrants(a.Grants); err != nil {
+		return err
+	}
+	if err := checkDuplicatedAddresses(a.AllowedNewAdmins); err != nil {
+		return errorsmod.Wrap(err, "allowed new admins")
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a ContractUpdateAdminAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, g := range a.Grants {
+		if err := g.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewContractClearAdminAuthorization constructor
+func NewContractClearAdminAuthorization(grants ...ContractGrant) *ContractClearAdminAuthorization {
+	return &ContractClearAdminAuthorization{
+		Grants: grants,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ContractClearAdminAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgClearAdmin{})
+}
+
+// Accept implements Authorization.Accept.
+func (a *ContractClearAdminAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authztypes.AcceptResponse, error) {
+	exec, ok := msg.(*MsgClearAdmin)
+	if !ok {
+		return authztypes.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if err := exec.ValidateBasic(); err != nil {
+		return authztypes.AcceptResponse{}, err
+	}
+	return AcceptGrantedAdminMessage(ctx, a.Grants, exec, a)
+}
+
+// NewAuthz factory method to create an Authorization with updated grants
+func (a ContractClearAdminAuthorization) NewAuthz(g []ContractGrant) authztypes.Authorization {
+	return NewContractClearAdminAuthorization(g...)
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ContractClearAdminAuthorization) ValidateBasic() error {
+	return validateAdminGrants(a.Grants)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a ContractClearAdminAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, g := range a.Grants {
+		if err := g.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewContractInstantiationAuthorization constructor. The authorization applies to MsgInstantiateContract2
+// instead of MsgInstantiateContract when instantiate2 is set.
+func NewContractInstantiationAuthorization(instantiate2 bool, grants ...CodeGrant) *ContractInstantiationAuthorization {
+	return &ContractInstantiationAuthorization{
+		Grants:       grants,
+		Instantiate2: instantiate2,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ContractInstantiationAuthorization) MsgTypeURL() string {
+	if a.Instantiate2 {
+		return sdk.MsgTypeURL(&MsgInstantiateContract2{})
+	}
+	return sdk.MsgTypeURL(&MsgInstantiateContract{})
+}
+
+// Accept implements Authorization.Accept.
+func (a *ContractInstantiationAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authztypes.AcceptResponse, error) {
+	if a.Instantiate2 {
+		return AcceptGrantedInstantiateMessage[*MsgInstantiateContract2](ctx, a.Grants, msg, a)
+	}
+	return AcceptGrantedInstantiateMessage[*MsgInstantiateContract](ctx, a.Grants, msg, a)
+}
+
+// NewCodeAuthz factory method to create an Authorization with updated grants
+func (a ContractInstantiationAuthorization) NewCodeAuthz(g []CodeGrant) authztypes.Authorization {
+	return NewContractInstantiationAuthorization(a.Instantiate2, g...)
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ContractInstantiationAuthorization) ValidateBasic() error {
+	return validateGrants(a.Grants)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a ContractInstantiationAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, g := range a.Grants {
+		if err := g.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateGrants[G interface{ ValidateBasic() error }](g []G) error {
 	if len(g) == 0 {
 		return ErrEmpty.Wrap("grants")
 	}
@@ -118,14 +308,40 @@ func validateGrants(g []ContractGrant) error {
 	return nil
 }
 
+// validateAdminGrants validates the grants of admin authorizations. Admin messages have no contract message
+// that a filter could apply to so that only the AllowAllMessagesFilter is supported.
+func validateAdminGrants(g []ContractGrant) error {
+	if err := validateGrants(g); err != nil {
+		return err
+	}
+	for i, v := range g {
+		if _, ok := v.GetFilter().(*AllowAllMessagesFilter); !ok {
+			return ErrInvalid.Wrapf("position %d: filters are not supported for admin grants", i)
+		}
+	}
+	return nil
+}
+
+// ContractCodeLookup reads the code details of contracts. Used by grants that target contracts by code.
+// See WithContractCodeLookup
+type ContractCodeLookup interface {
+	GetContractHistory(ctx sdk.Context, contractAddr sdk.AccAddress) []ContractCodeHistoryEntry
+	GetCodeInfo(ctx sdk.Context, codeID uint64) *CodeInfo
+}
+
 // ContractAuthzFactory factory to create an updated Authorization object
 type ContractAuthzFactory interface {
 	NewAuthz([]ContractGrant) authztypes.Authorization
 }
 
+// CodeAuthzFactory factory to create an updated Authorization object from code grants
+type CodeAuthzFactory interface {
+	NewCodeAuthz([]CodeGrant) authztypes.Authorization
+}
+
 // AcceptGrantedMessage determines whether this grant permits the provided sdk.Msg to be performed,
 // and if so provides an upgraded authorization instance.
-func AcceptGrantedMessage[T AuthzableWasmMsg](ctx sdk.Context, grants []ContractGrant, msg sdk.Msg, factory ContractAuthzFactory) (authztypes.AcceptResponse, error) {
+func AcceptGrantedMessage[T AuthzableContractMsg](ctx sdk.Context, grants []ContractGrant, msg sdk.Msg, factory ContractAuthzFactory) (authztypes.AcceptResponse, error) {
 	exec, ok := msg.(T)
 	if !ok {
 		return authztypes.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
@@ -136,10 +352,95 @@ func AcceptGrantedMessage[T AuthzableWasmMsg](ctx sdk.Context, grants []Contract
 	if err := exec.ValidateBasic(); err != nil {
 		return authztypes.AcceptResponse{}, err
 	}
+	applies := func(g ContractGrant) (bool, error) {
+		return g.targets(ctx, exec.GetContract())
+	}
+	filter := func(g ContractGrant) (bool, error) {
+		return g.GetFilter().Accept(ctx, exec.GetMsg())
+	}
+	return acceptGrants(ctx, grants, exec, applies, filter, factory.NewAuthz)
+}
+
+// AcceptGrantedAdminMessage determines whether the grants permit the provided admin message to be performed,
+// and if so provides an upgraded authorization instance. The message must be validated before.
+func AcceptGrantedAdminMessage(ctx sdk.Context, grants []ContractGrant, msg AuthzableAdminMsg, factory ContractAuthzFactory) (authztypes.AcceptResponse, error) {
+	applies := func(g ContractGrant) (bool, error) {
+		return g.targets(ctx, msg.GetContract())
+	}
+	// admin grants have no filter, see validateAdminGrants
+	filter := func(ContractGrant) (bool, error) {
+		return true, nil
+	}
+	return acceptGrants(ctx, grants, adminAuthzMsg{msg}, applies, filter, factory.NewAuthz)
+}
+
+// adminAuthzMsg adapts an admin message to the grant limits. It has no contract message and transfers no funds.
+type adminAuthzMsg struct {
+	AuthzableAdminMsg
+}
+
+// GetFunds returns no funds
+func (m adminAuthzMsg) GetFunds() sdk.Coins {
+	return sdk.NewCoins()
+}
+
+// GetMsg returns nil as there is no contract message
+func (m adminAuthzMsg) GetMsg() RawContractMessage {
+	return nil
+}
 
+// AcceptGrantedInstantiateMessage determines whether the code grants permit the provided instantiate sdk.Msg
+// to be performed, and if so provides an upgraded authorization instance.
+func AcceptGrantedInstantiateMessage[T AuthzableInstantiateMsg](ctx sdk.Context, grants []CodeGrant, msg sdk.Msg, factory CodeAuthzFactory) (authztypes.AcceptResponse, error) {
+	exec, ok := msg.(T)
+	if !ok {
+		return authztypes.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if exec.GetMsg() == nil {
+		return authztypes.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("empty message")
+	}
+	if err := exec.ValidateBasic(); err != nil {
+		return authztypes.AcceptResponse{}, err
+	}
+	var admin sdk.AccAddress
+	if exec.GetAdmin() != "" {
+		admin = sdk.MustAccAddressFromBech32(exec.GetAdmin()) // checked in ValidateBasic
+	}
+	applies := func(g CodeGrant) (bool, error) {
+		if g.CodeID != exec.GetCodeID() {
+			return false, nil
+		}
+		return g.Constraints == nil || g.Constraints.Accept(admin, exec.GetFunds()) == nil, nil
+	}
+	filter := func(g CodeGrant) (bool, error) {
+		return g.GetFilter().Accept(ctx, exec.GetMsg())
+	}
+	return acceptGrants(ctx, grants, exec, applies, filter, factory.NewCodeAuthz)
+}
+
+// authzGrant is a granted permission with limit and filter that can be updated with new limits
+type authzGrant[G any] interface {
+	GetLimit() ContractAuthzLimitX
+	GetFilter() ContractAuthzFilterX
+	WithNewLimits(limit ContractAuthzLimitX) (*G, error)
+}
+
+// acceptGrants evaluates the limits and filters of the grants that apply to the message and
+// returns the upgraded authorization instance for the first grant that accepts it.
+func acceptGrants[G authzGrant[G]](
+	ctx sdk.Context,
+	grants []G,
+	exec AuthzableWasmMsg,
+	applies func(G) (bool, error),
+	filter func(G) (bool, error),
+	factory func([]G) authztypes.Authorization,
+) (authztypes.AcceptResponse, error) {
 	// iterate though all grants
 	for i, g := range grants {
-		if g.Contract != exec.GetContract() {
+		switch ok, err := applies(g); {
+		case err != nil:
+			return authztypes.AcceptResponse{}, err
+		case !ok:
 			continue
 		}
 
@@ -156,7 +457,7 @@ func AcceptGrantedMessage[T AuthzableWasmMsg](ctx sdk.Context, grants []Contract
 		}
 
 		// then check permission set
-		ok, err := g.GetFilter().Accept(ctx, exec.GetMsg())
+		ok, err := filter(g)
 		switch {
 		case err != nil:
 			return authztypes.AcceptResponse{}, errorsmod.Wrap(err, "filter")
@@ -172,7 +473,7 @@ func AcceptGrantedMessage[T AuthzableWasmMsg](ctx sdk.Context, grants []Contract
 			if len(updatedGrants) == 0 { // remove when empty
 				return authztypes.AcceptResponse{Accept: true, Delete: true}, nil
 			}
-			newAuthz := factory.NewAuthz(updatedGrants)
+			newAuthz := factory(updatedGrants)
 			if err := newAuthz.ValidateBasic(); err != nil { // sanity check
 				return authztypes.AcceptResponse{}, ErrInvalid.Wrapf("new grant state: %s", err)
 			}
@@ -182,7 +483,7 @@ func AcceptGrantedMessage[T AuthzableWasmMsg](ctx sdk.Context, grants []Contract
 			if err != nil {
 				return authztypes.AcceptResponse{}, err
 			}
-			newAuthz := factory.NewAuthz(append(append(grants[0:i], *obj), grants[i+1:]...))
+			newAuthz := factory(append(append(grants[0:i], *obj), grants[i+1:]...))
 			if err := newAuthz.ValidateBasic(); err != nil { // sanity check
 				return authztypes.AcceptResponse{}, ErrInvalid.Wrapf("new grant state: %s", err)
 			}
@@ -220,17 +521,16 @@ type ContractAuthzFilterX interface {
 	ValidateBasic() error
 }
 
-var _ cdctypes.UnpackInterfacesMessage = &ContractGrant{}
+var (
+	_ cdctypes.UnpackInterfacesMessage = &ContractGrant{}
+	_ cdctypes.UnpackInterfacesMessage = &CodeGrant{}
+)
 
 // NewContractGrant constructor
 func NewContractGrant(contract sdk.AccAddress, limit ContractAuthzLimitX, filter ContractAuthzFilterX) (*ContractGrant, error) {
-	pFilter, ok := filter.(proto.Message)
-	if !ok {
-		return nil, sdkerrors.ErrInvalidType.Wrap("filter is not a proto type")
-	}
-	anyFilter, err := cdctypes.NewAnyWithValue(pFilter)
+	anyFilter, err := packFilter(filter)
 	if err != nil {
-		return nil, errorsmod.Wrap(err, "filter")
+		return nil, err
 	}
 	return ContractGrant{
 		Contract: contract.String(),
@@ -238,72 +538,238 @@ func NewContractGrant(contract sdk.AccAddress, limit ContractAuthzLimitX, filter
 	}.WithNewLimits(limit)
 }
 
+// NewCodeIDContractGrant constructor for a grant on all contracts of the given code id
+func NewCodeIDContractGrant(codeID uint64, limit ContractAuthzLimitX, filter ContractAuthzFilterX) (*ContractGrant, error) {
+	anyFilter, err := packFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	return ContractGrant{
+		CodeID: codeID,
+		Filter: anyFilter,
+	}.WithNewLimits(limit)
+}
+
+// NewCodeHashContractGrant constructor for a grant on all contracts of codes with the given checksum
+func NewCodeHashContractGrant(codeHash []byte, limit ContractAuthzLimitX, filter ContractAuthzFilterX) (*ContractGrant, error) {
+	anyFilter, err := packFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	return ContractGrant{
+		CodeHash: codeHash,
+		Filter:   anyFilter,
+	}.WithNewLimits(limit)
+}
+
 // WithNewLimits factory method to create a new grant with given limit
 func (g ContractGrant) WithNewLimits(limit ContractAuthzLimitX) (*ContractGrant, error) {
-	pLimit, ok := limit.(proto.Message)
-	if !ok {
-		return nil, sdkerrors.ErrInvalidType.Wrap("limit is not a proto type")
-	}
-	anyLimit, err := cdctypes.NewAnyWithValue(pLimit)
+	anyLimit, err := packLimit(limit)
 	if err != nil {
-		return nil, errorsmod.Wrap(err, "limit")
+		return nil, err
 	}
 
 	return &ContractGrant{
 		Contract: g.Contract,
 		Limit:    anyLimit,
 		Filter:   g.Filter,
+		CodeID:   g.CodeID,
+		CodeHash: g.CodeHash,
 	}, nil
 }
 
+// targets returns true when the grant applies to the contract. Grants by code id or code hash apply to contracts
+// where the current and all previous codes in the contract history match, so that a migration can not move a
+// contract into the scope of the grant. They require a ContractCodeLookup in the context and fail with an error
+// without it.
+func (g ContractGrant) targets(ctx sdk.Context, contract string) (bool, error) {
+	if g.Contract != "" {
+		return g.Contract == contract, nil
+	}
+	lookup, ok := ContractCodeLookupFromContext(ctx)
+	if !ok {
+		return false, errorsmod.Wrap(sdkerrors.ErrLogic, "contract code lookup not set in context: the ContractCodeLookupDecorator is required for grants by code")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return false, nil
+	}
+	history := lookup.GetContractHistory(ctx, contractAddr)
+	if len(history) == 0 {
+		return false, nil
+	}
+	for _, e := range history {
+		switch {
+		case g.CodeID != 0:
+			if e.CodeID != g.CodeID {
+				return false, nil
+			}
+		default:
+			codeInfo := lookup.GetCodeInfo(ctx, e.CodeID)
+			if codeInfo == nil || !bytes.Equal(codeInfo.CodeHash, g.CodeHash) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (g ContractGrant) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return unpackLimitAndFilter(unpacker, g.Limit, g.Filter)
+}
+
+// GetLimit returns the cached value from the ContractGrant.Limit if present.
+func (g ContractGrant) GetLimit() ContractAuthzLimitX {
+	return cachedLimit(g.Limit)
+}
+
+// GetFilter returns the cached value from the ContractGrant.Filter if present.
+func (g ContractGrant) GetFilter() ContractAuthzFilterX {
+	return cachedFilter(g.Filter)
+}
+
+// ValidateBasic validates the grant
+func (g ContractGrant) ValidateBasic() error {
+	var targets int
+	for _, isSet := range []bool{g.Contract != "", g.CodeID != 0, len(g.CodeHash) != 0} {
+		if isSet {
+			targets++
+		}
+	}
+	switch {
+	case targets != 1:
+		return errorsmod.Wrap(ErrInvalid, "exactly one of contract, code id or code hash must be set")
+	case g.Contract != "":
+		if _, err := sdk.AccAddressFromBech32(g.Contract); err != nil {
+			return errorsmod.Wrap(err, "contract")
+		}
+	case len(g.CodeHash) != 0 && len(g.CodeHash) != sha256.Size:
+		return errorsmod.Wrapf(ErrInvalid, "code hash must be %d bytes", sha256.Size)
+	}
+	return validateLimitAndFilter(g.GetLimit(), g.GetFilter())
+}
+
+// NewCodeGrant constructor. Constraints are optional.
+func NewCodeGrant(codeID uint64, limit ContractAuthzLimitX, filter ContractAuthzFilterX, constraints *InstantiateConstraints) (*CodeGrant, error) {
+	anyFilter, err := packFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	return CodeGrant{
+		CodeID:      codeID,
+		Filter:      anyFilter,
+		Constraints: constraints,
+	}.WithNewLimits(limit)
+}
+
+// WithNewLimits factory method to create a new grant with given limit
+func (g CodeGrant) WithNewLimits(limit ContractAuthzLimitX) (*CodeGrant, error) {
+	anyLimit, err := packLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+	return &CodeGrant{
+		CodeID:      g.CodeID,
+		Limit:       anyLimit,
+		Filter:      g.Filter,
+		Constraints: g.Constraints,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g CodeGrant) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return unpackLimitAndFilter(unpacker, g.Limit, g.Filter)
+}
+
+// GetLimit returns the cached value from the CodeGrant.Limit if present.
+func (g CodeGrant) GetLimit() ContractAuthzLimitX {
+	return cachedLimit(g.Limit)
+}
+
+// GetFilter returns the cached value from the CodeGrant.Filter if present.
+func (g CodeGrant) GetFilter() ContractAuthzFilterX {
+	return cachedFilter(g.Filter)
+}
+
+// ValidateBasic validates the grant
+func (g CodeGrant) ValidateBasic() error {
+	if g.CodeID == 0 {
+		return ErrEmpty.Wrap("code id")
+	}
+	if g.Constraints != nil {
+		if err := g.Constraints.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "constraints")
+		}
+	}
+	return validateLimitAndFilter(g.GetLimit(), g.GetFilter())
+}
+
+func packLimit(limit ContractAuthzLimitX) (*cdctypes.Any, error) {
+	pLimit, ok := limit.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrap("limit is not a proto type")
+	}
+	anyLimit, err := cdctypes.NewAnyWithValue(pLimit)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "limit")
+	}
+	return anyLimit, nil
+}
+
+func packFilter(filter ContractAuthzFilterX) (*cdctypes.Any, error) {
+	pFilter, ok := filter.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrap("filter is not a proto type")
+	}
+	anyFilter, err := cdctypes.NewAnyWithValue(pFilter)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "filter")
+	}
+	return anyFilter, nil
+}
+
+func unpackLimitAndFilter(unpacker cdctypes.AnyUnpacker, limit, filter *cdctypes.Any) error {
 	var f ContractAuthzFilterX
-	if err := unpacker.UnpackAny(g.Filter, &f); err != nil {
+	if err := unpacker.UnpackAny(filter, &f); err != nil {
 		return errorsmod.Wrap(err, "filter")
 	}
 	var l ContractAuthzLimitX
-	if err := unpacker.UnpackAny(g.Limit, &l); err != nil {
+	if err := unpacker.UnpackAny(limit, &l); err != nil {
 		return errorsmod.Wrap(err, "limit")
 	}
 	return nil
 }
 
-// GetLimit returns the cached value from the ContractGrant.Limit if present.
-func (g ContractGrant) GetLimit() ContractAuthzLimitX {
-	if g.Limit == nil {
+func cachedLimit(limit *cdctypes.Any) ContractAuthzLimitX {
+	if limit == nil {
 		return &UndefinedLimit{}
 	}
-	a, ok := g.Limit.GetCachedValue().(ContractAuthzLimitX)
+	a, ok := limit.GetCachedValue().(ContractAuthzLimitX)
 	if !ok {
 		return &UndefinedLimit{}
 	}
 	return a
 }
 
-// GetFilter returns the cached value from the ContractGrant.Filter if present.
-func (g ContractGrant) GetFilter() ContractAuthzFilterX {
-	if g.Filter == nil {
+func cachedFilter(filter *cdctypes.Any) ContractAuthzFilterX {
+	if filter == nil {
 		return &UndefinedFilter{}
 	}
-	a, ok := g.Filter.GetCachedValue().(ContractAuthzFilterX)
+	a, ok := filter.GetCachedValue().(ContractAuthzFilterX)
 	if !ok {
 		return &UndefinedFilter{}
 	}
 	return a
 }
 
-// ValidateBasic validates the grant
-func (g ContractGrant) ValidateBasic() error {
-	if _, err := sdk.AccAddressFromBech32(g.Contract); err != nil {
-		return errorsmod.Wrap(err, "contract")
-	}
+func validateLimitAndFilter(limit ContractAuthzLimitX, filter ContractAuthzFilterX) error {
 	// execution limits
-	if err := g.GetLimit().ValidateBasic(); err != nil {
+	if err := limit.ValidateBasic(); err != nil {
 		return errorsmod.Wrap(err, "limit")
 	}
 	// filter
-	if err := g.GetFilter().ValidateBasic(); err != nil {
+	if err := filter.ValidateBasic(); err != nil {
 		return errorsmod.Wrap(err, "filter")
 	}
 	return nil
@@ -411,11 +877,136 @@ func (f AcceptedMessagesFilter) ValidateBasic() error {
 	return nil
 }
 
+// NewAcceptedMessageFieldsFilter constructor
+func NewAcceptedMessageFieldsFilter(rules ...MessageFieldRule) *AcceptedMessageFieldsFilter {
+	return &AcceptedMessageFieldsFilter{Rules: rules}
+}
+
+// Accept only payload messages where all rules match.
+func (f *AcceptedMessageFieldsFilter) Accept(ctx sdk.Context, msg RawContractMessage) (bool, error) {
+	gasForDeserialization := gasDeserializationCostPerByte * uint64(len(msg))
+	ctx.GasMeter().ConsumeGas(gasForDeserialization, "contract authorization")
+
+	if err := msg.ValidateBasic(); err != nil {
+		return false, sdkerrors.ErrUnauthorized.Wrapf("not an allowed msg: %s", err.Error())
+	}
+	document, err := decodeJSON(msg)
+	if err != nil {
+		return false, sdkerrors.ErrUnauthorized.Wrapf("not an allowed msg: %s", err.Error())
+	}
+	for _, r := range f.Rules {
+		if !r.Matches(document) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// ValidateBasic validates the filter
+func (f AcceptedMessageFieldsFilter) ValidateBasic() error {
+	if len(f.Rules) == 0 {
+		return ErrEmpty.Wrap("rules")
+	}
+	for i, r := range f.Rules {
+		if err := r.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "rule %d", i)
+		}
+	}
+	return nil
+}
+
+// ValidateBasic validates the rule
+func (r MessageFieldRule) ValidateBasic() error {
+	if _, err := parseJSONPointer(r.Path); err != nil {
+		return ErrInvalid.Wrapf("path %q: %s", r.Path, err)
+	}
+	var comparisons int
+	if len(r.Equals) != 0 {
+		comparisons++
+		if err := r.Equals.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "equals")
+		}
+	}
+	if len(r.In) != 0 {
+		comparisons++
+		idx := make(map[string]struct{}, len(r.In))
+		for _, v := range r.In {
+			if err := v.ValidateBasic(); err != nil {
+				return errorsmod.Wrap(err, "in")
+			}
+			if _, exists := idx[string(v)]; exists {
+				return ErrDuplicate.Wrapf("in: %s", v)
+			}
+			idx[string(v)] = struct{}{}
+		}
+	}
+	if r.LTE != "" {
+		comparisons++
+		if _, err := sdk.NewDecFromStr(r.LTE); err != nil {
+			return ErrInvalid.Wrapf("lte: %s", err)
+		}
+	}
+	if comparisons != 1 {
+		return ErrInvalid.Wrap("exactly one of equals, in or lte must be set")
+	}
+	return nil
+}
+
+// Matches returns true when the value at the path of the decoded json document satisfies the comparison.
+// Json values are equal when their decoded forms are equal. Numbers are compared by their literal.
+func (r MessageFieldRule) Matches(document interface{}) bool {
+	tokens, err := parseJSONPointer(r.Path)
+	if err != nil {
+		return false
+	}
+	v, ok := lookupJSONValue(document, tokens)
+	if !ok {
+		return false
+	}
+	switch {
+	case len(r.Equals) != 0:
+		return jsonValueEquals(v, r.Equals)
+	case len(r.In) != 0:
+		for _, o := range r.In {
+			if jsonValueEquals(v, o) {
+				return true
+			}
+		}
+		return false
+	case r.LTE != "":
+		var s string
+		switch n := v.(type) {
+		case json.Number:
+			s = n.String()
+		case string:
+			s = n
+		default:
+			return false
+		}
+		got, err := sdk.NewDecFromStr(s)
+		if err != nil {
+			return false
+		}
+		limit, err := sdk.NewDecFromStr(r.LTE)
+		return err == nil && got.LTE(limit)
+	}
+	return false
+}
+
+func jsonValueEquals(v interface{}, other RawContractMessage) bool {
+	o, err := decodeJSON(other)
+	return err == nil && reflect.DeepEqual(v, o)
+}
+
 var (
 	_ ContractAuthzLimitX = &UndefinedLimit{}
 	_ ContractAuthzLimitX = &MaxCallsLimit{}
 	_ ContractAuthzLimitX = &MaxFundsLimit{}
 	_ ContractAuthzLimitX = &CombinedLimit{}
+	_ ContractAuthzLimitX = &WindowLimit{}
+	_ ContractAuthzLimitX = &AllOfLimits{}
+
+	_ cdctypes.UnpackInterfacesMessage = &AllOfLimits{}
 )
 
 // UndefinedLimit null object that is always rejected in execution
@@ -533,3 +1124,175 @@ func (l CombinedLimit) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewWindowLimit constructor
+// A panic will occur if the coin set is not valid.
+func NewWindowLimit(window time.Duration, rolling bool, maxCalls uint64, maxFunds ...sdk.Coin) *WindowLimit {
+	return &WindowLimit{Window: window, Rolling: rolling, MaxCalls: maxCalls, MaxFunds: sdk.NewCoins(maxFunds...)}
+}
+
+// Accept until the max calls or the token budget of the current window is reached. The usage is
+// tracked with the block time.
+func (l WindowLimit) Accept(ctx sdk.Context, msg AuthzableWasmMsg) (*ContractAuthzLimitAcceptResult, error) {
+	now := ctx.BlockTime()
+	usages := l.activeUsages(now)
+	var calls uint64
+	var funds sdk.Coins
+	for _, u := range usages {
+		calls += u.Calls
+		funds = funds.Add(u.Funds...)
+	}
+	if calls >= l.MaxCalls {
+		return &ContractAuthzLimitAcceptResult{Accepted: false}, nil
+	}
+	transferFunds := msg.GetFunds()
+	if !transferFunds.Empty() && !funds.Add(transferFunds...).IsAllLTE(l.MaxFunds) {
+		return &ContractAuthzLimitAcceptResult{Accepted: false}, nil
+	}
+
+	usageTime := now
+	if !l.Rolling {
+		usageTime = now.Truncate(l.Window)
+	}
+	if n := len(usages); n != 0 && usages[n-1].Time.Equal(usageTime) {
+		last := usages[n-1]
+		usages[n-1] = WindowUsage{Time: usageTime, Calls: last.Calls + 1, Funds: last.Funds.Add(transferFunds...)}
+	} else {
+		usages = append(usages, WindowUsage{Time: usageTime, Calls: 1, Funds: transferFunds})
+	}
+	return &ContractAuthzLimitAcceptResult{
+		Accepted:    true,
+		UpdateLimit: &WindowLimit{Window: l.Window, Rolling: l.Rolling, MaxCalls: l.MaxCalls, MaxFunds: l.MaxFunds, Usages: usages},
+	}, nil
+}
+
+// activeUsages returns a copy of the usages that belong to the window of the given block time
+func (l WindowLimit) activeUsages(now time.Time) []WindowUsage {
+	r := make([]WindowUsage, 0, len(l.Usages)+1)
+	for _, u := range l.Usages {
+		if l.Rolling && u.Time.After(now.Add(-l.Window)) || !l.Rolling && !u.Time.Before(now.Truncate(l.Window)) {
+			r = append(r, u)
+		}
+	}
+	return r
+}
+
+// ValidateBasic validates the limit
+func (l WindowLimit) ValidateBasic() error {
+	if l.Window <= 0 {
+		return ErrInvalid.Wrap("window must be positive")
+	}
+	if l.MaxCalls == 0 {
+		return ErrEmpty.Wrap("max calls")
+	}
+	if err := l.MaxFunds.Validate(); err != nil {
+		return errorsmod.Wrap(err, "max funds")
+	}
+	if uint64(len(l.Usages)) > l.MaxCalls {
+		return ErrInvalid.Wrap("more usages than max calls")
+	}
+	for i, u := range l.Usages {
+		if u.Calls == 0 {
+			return ErrEmpty.Wrapf("usage %d: calls", i)
+		}
+		if err := u.Funds.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "usage %d: funds", i)
+		}
+	}
+	return nil
+}
+
+// NewAllOfLimits constructor
+func NewAllOfLimits(limits ...ContractAuthzLimitX) (*AllOfLimits, error) {
+	anys := make([]*cdctypes.Any, len(limits))
+	for i, l := range limits {
+		pLimit, ok := l.(proto.Message)
+		if !ok {
+			return nil, sdkerrors.ErrInvalidType.Wrap("limit is not a proto type")
+		}
+		anyLimit, err := cdctypes.NewAnyWithValue(pLimit)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "limit")
+		}
+		anys[i] = anyLimit
+	}
+	return &AllOfLimits{Limits: anys}, nil
+}
+
+// Accept when all limits accept. The limit state is updated with the new states of all limits.
+func (l AllOfLimits) Accept(ctx sdk.Context, msg AuthzableWasmMsg) (*ContractAuthzLimitAcceptResult, error) {
+	limits := l.GetLimits()
+	updated := make([]ContractAuthzLimitX, len(limits))
+	var isUpdated, deleteLimit bool
+	for i, x := range limits {
+		result, err := x.Accept(ctx, msg)
+		switch {
+		case err != nil:
+			return nil, errorsmod.Wrapf(err, "limit %d", i)
+		case result == nil: // sanity check
+			return nil, sdkerrors.ErrInvalidType.Wrapf("limit %d: result must not be nil", i)
+		case !result.Accepted:
+			return &ContractAuthzLimitAcceptResult{Accepted: false}, nil
+		}
+		deleteLimit = deleteLimit || result.DeleteLimit
+		updated[i] = x
+		if result.UpdateLimit != nil {
+			updated[i] = result.UpdateLimit
+			isUpdated = true
+		}
+	}
+	switch {
+	case deleteLimit:
+		return &ContractAuthzLimitAcceptResult{Accepted: true, DeleteLimit: true}, nil
+	case isUpdated:
+		newLimit, err := NewAllOfLimits(updated...)
+		if err != nil {
+			return nil, err
+		}
+		return &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: newLimit}, nil
+	default:
+		return &ContractAuthzLimitAcceptResult{Accepted: true}, nil
+	}
+}
+
+// GetLimits returns the cached values of the limits. Limits that are not unpacked are returned as UndefinedLimit.
+func (l AllOfLimits) GetLimits() []ContractAuthzLimitX {
+	r := make([]ContractAuthzLimitX, len(l.Limits))
+	for i, a := range l.Limits {
+		r[i] = &UndefinedLimit{}
+		if a == nil {
+			continue
+		}
+		if x, ok := a.GetCachedValue().(ContractAuthzLimitX); ok {
+			r[i] = x
+		}
+	}
+	return r
+}
+
+// ValidateBasic validates the limit
+func (l AllOfLimits) ValidateBasic() error {
+	if len(l.Limits) == 0 {
+		return ErrEmpty.Wrap("limits")
+	}
+	for i, x := range l.GetLimits() {
+		if _, ok := x.(*AllOfLimits); ok {
+			return ErrInvalid.Wrapf("limit %d: nested all of limits not supported", i)
+		}
+		if err := x.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "limit %d", i)
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (l AllOfLimits) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for i, a := range l.Limits {
+		var x ContractAuthzLimitX
+		if err := unpacker.UnpackAny(a, &x); err != nil {
+			return errorsmod.Wrapf(err, "limit %d", i)
+		}
+	}
+	return nil
+}
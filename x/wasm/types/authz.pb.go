@@ -8,6 +8,7 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
@@ -16,6 +17,9 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -23,6 +27,7 @@ var (
 	_ = proto.Marshal
 	_ = fmt.Errorf
 	_ = math.Inf
+	_ = time.Kitchen
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -121,10 +126,154 @@ func (m *ContractMigrationAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContractMigrationAuthorization proto.InternalMessageInfo
 
+// ContractUpdateAdminAuthorization defines authorization for wasm contract
+// admin updates. Admin updates have no contract message so that the grants
+// must use the AllowAllMessagesFilter. Since: wasmd 0.42
+type ContractUpdateAdminAuthorization struct {
+	// Grants for contract admin updates
+	Grants []ContractGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
+	// AllowedNewAdmins are the bech32 addresses that the admin can be updated
+	// to. Optional, any address is allowed when empty
+	AllowedNewAdmins []string `protobuf:"bytes,2,rep,name=allowed_new_admins,json=allowedNewAdmins,proto3" json:"allowed_new_admins,omitempty"`
+}
+
+func (m *ContractUpdateAdminAuthorization) Reset()         { *m = ContractUpdateAdminAuthorization{} }
+func (m *ContractUpdateAdminAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ContractUpdateAdminAuthorization) ProtoMessage()    {}
+func (*ContractUpdateAdminAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{2}
+}
+
+func (m *ContractUpdateAdminAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ContractUpdateAdminAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractUpdateAdminAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ContractUpdateAdminAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractUpdateAdminAuthorization.Merge(m, src)
+}
+
+func (m *ContractUpdateAdminAuthorization) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ContractUpdateAdminAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractUpdateAdminAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractUpdateAdminAuthorization proto.InternalMessageInfo
+
+// ContractClearAdminAuthorization defines authorization for clearing the
+// admin of a wasm contract. Clearing the admin has no contract message so
+// that the grants must use the AllowAllMessagesFilter. Since: wasmd 0.42
+type ContractClearAdminAuthorization struct {
+	// Grants for clearing contract admins
+	Grants []ContractGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
+}
+
+func (m *ContractClearAdminAuthorization) Reset()         { *m = ContractClearAdminAuthorization{} }
+func (m *ContractClearAdminAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ContractClearAdminAuthorization) ProtoMessage()    {}
+func (*ContractClearAdminAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{3}
+}
+
+func (m *ContractClearAdminAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ContractClearAdminAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractClearAdminAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ContractClearAdminAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractClearAdminAuthorization.Merge(m, src)
+}
+
+func (m *ContractClearAdminAuthorization) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ContractClearAdminAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractClearAdminAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractClearAdminAuthorization proto.InternalMessageInfo
+
+// ContractInstantiationAuthorization defines authorization for wasm
+// instantiate of selected codes. Since: wasmd 0.42
+type ContractInstantiationAuthorization struct {
+	// Grants for contract instantiations
+	Grants []CodeGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
+	// Instantiate2 when set the authorization applies to MsgInstantiateContract2
+	// instead of MsgInstantiateContract
+	Instantiate2 bool `protobuf:"varint,2,opt,name=instantiate2,proto3" json:"instantiate2,omitempty"`
+}
+
+func (m *ContractInstantiationAuthorization) Reset()         { *m = ContractInstantiationAuthorization{} }
+func (m *ContractInstantiationAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ContractInstantiationAuthorization) ProtoMessage()    {}
+func (*ContractInstantiationAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{4}
+}
+
+func (m *ContractInstantiationAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ContractInstantiationAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractInstantiationAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ContractInstantiationAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractInstantiationAuthorization.Merge(m, src)
+}
+
+func (m *ContractInstantiationAuthorization) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ContractInstantiationAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractInstantiationAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractInstantiationAuthorization proto.InternalMessageInfo
+
 // ContractGrant a granted permission for a single contract
 // Since: wasmd 0.30
 type ContractGrant struct {
-	// Contract is the bech32 address of the smart contract
+	// Contract is the bech32 address of the smart contract. Either contract,
+	// code id or code hash must be set.
 	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
 	// Limit defines execution limits that are enforced and updated when the grant
 	// is applied. When the limit lapsed the grant is removed.
@@ -133,13 +282,24 @@ type ContractGrant struct {
 	// to the contract in the operation. When no filter applies on execution, the
 	// operation is prohibited.
 	Filter *types.Any `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// CodeID targets all contracts that run this code and have not run any
+	// other code before, instead of a single contract. Requires the
+	// ContractCodeLookupDecorator in the ante handler chain of the chain.
+	// Authorizations with this grant fail without it. Since: wasmd 0.42
+	CodeID uint64 `protobuf:"varint,4,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// CodeHash targets all contracts that run a code with this checksum and
+	// have not run a code with any other checksum before, instead of a single
+	// contract. Requires the ContractCodeLookupDecorator in the ante handler
+	// chain of the chain. Authorizations with this grant fail without it.
+	// Since: wasmd 0.42
+	CodeHash []byte `protobuf:"bytes,5,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
 }
 
 func (m *ContractGrant) Reset()         { *m = ContractGrant{} }
 func (m *ContractGrant) String() string { return proto.CompactTextString(m) }
 func (*ContractGrant) ProtoMessage()    {}
 func (*ContractGrant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36ff3a20cf32b258, []int{2}
+	return fileDescriptor_36ff3a20cf32b258, []int{5}
 }
 
 func (m *ContractGrant) XXX_Unmarshal(b []byte) error {
@@ -173,6 +333,59 @@ func (m *ContractGrant) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContractGrant proto.InternalMessageInfo
 
+// CodeGrant a granted permission to instantiate contracts of a single code
+// Since: wasmd 0.42
+type CodeGrant struct {
+	// CodeID is the reference to the stored WASM code
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// Limit defines instantiation limits that are enforced and updated when the
+	// grant is applied. When the limit lapsed the grant is removed.
+	Limit *types.Any `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Filter define more fine-grained control on the init message payload. When
+	// no filter applies on instantiation, the operation is prohibited.
+	Filter *types.Any `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Constraints on the admin and the funds of the new contract. Optional
+	Constraints *InstantiateConstraints `protobuf:"bytes,4,opt,name=constraints,proto3" json:"constraints,omitempty"`
+}
+
+func (m *CodeGrant) Reset()         { *m = CodeGrant{} }
+func (m *CodeGrant) String() string { return proto.CompactTextString(m) }
+func (*CodeGrant) ProtoMessage()    {}
+func (*CodeGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{6}
+}
+
+func (m *CodeGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CodeGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *CodeGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeGrant.Merge(m, src)
+}
+
+func (m *CodeGrant) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *CodeGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeGrant proto.InternalMessageInfo
+
 // MaxCallsLimit limited number of calls to the contract. No funds transferable.
 // Since: wasmd 0.30
 type MaxCallsLimit struct {
@@ -184,7 +397,7 @@ func (m *MaxCallsLimit) Reset()         { *m = MaxCallsLimit{} }
 func (m *MaxCallsLimit) String() string { return proto.CompactTextString(m) }
 func (*MaxCallsLimit) ProtoMessage()    {}
 func (*MaxCallsLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36ff3a20cf32b258, []int{3}
+	return fileDescriptor_36ff3a20cf32b258, []int{7}
 }
 
 func (m *MaxCallsLimit) XXX_Unmarshal(b []byte) error {
@@ -229,7 +442,7 @@ func (m *MaxFundsLimit) Reset()         { *m = MaxFundsLimit{} }
 func (m *MaxFundsLimit) String() string { return proto.CompactTextString(m) }
 func (*MaxFundsLimit) ProtoMessage()    {}
 func (*MaxFundsLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36ff3a20cf32b258, []int{4}
+	return fileDescriptor_36ff3a20cf32b258, []int{8}
 }
 
 func (m *MaxFundsLimit) XXX_Unmarshal(b []byte) error {
@@ -277,7 +490,7 @@ func (m *CombinedLimit) Reset()         { *m = CombinedLimit{} }
 func (m *CombinedLimit) String() string { return proto.CompactTextString(m) }
 func (*CombinedLimit) ProtoMessage()    {}
 func (*CombinedLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36ff3a20cf32b258, []int{5}
+	return fileDescriptor_36ff3a20cf32b258, []int{9}
 }
 
 func (m *CombinedLimit) XXX_Unmarshal(b []byte) error {
@@ -311,6 +524,160 @@ func (m *CombinedLimit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CombinedLimit proto.InternalMessageInfo
 
+// WindowLimit defines the max number of calls and the max amount of tokens
+// transferable to the contract within a window of block time. The limit is
+// not used up but applies again in the next window.
+// Since: wasmd 0.42
+type WindowLimit struct {
+	// Window is the duration of a window
+	Window time.Duration `protobuf:"bytes,1,opt,name=window,proto3,stdduration" json:"window"`
+	// Rolling windows end with the current block time. Otherwise fixed windows
+	// are used that start at multiples of the window duration since the zero
+	// time, for example at UTC midnight for a 24h window.
+	Rolling bool `protobuf:"varint,2,opt,name=rolling,proto3" json:"rolling,omitempty"`
+	// MaxCalls is the max number of calls within a window
+	MaxCalls uint64 `protobuf:"varint,3,opt,name=max_calls,json=maxCalls,proto3" json:"max_calls,omitempty"`
+	// MaxFunds is the max amount of tokens transferable to the contract within a
+	// window. No tokens can be transferred when empty.
+	MaxFunds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=max_funds,json=maxFunds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_funds"`
+	// Usages are the calls of the current window. Set on execution.
+	Usages []WindowUsage `protobuf:"bytes,5,rep,name=usages,proto3" json:"usages"`
+}
+
+func (m *WindowLimit) Reset()         { *m = WindowLimit{} }
+func (m *WindowLimit) String() string { return proto.CompactTextString(m) }
+func (*WindowLimit) ProtoMessage()    {}
+func (*WindowLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{10}
+}
+
+func (m *WindowLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *WindowLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WindowLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *WindowLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindowLimit.Merge(m, src)
+}
+
+func (m *WindowLimit) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *WindowLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindowLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WindowLimit proto.InternalMessageInfo
+
+// WindowUsage is the aggregated usage of a WindowLimit at a block time
+// Since: wasmd 0.42
+type WindowUsage struct {
+	// Time is the block time of the calls. For fixed windows it is the start of
+	// the window.
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// Calls is the number of calls
+	Calls uint64 `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`
+	// Funds is the amount of tokens transferred to the contract
+	Funds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=funds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funds"`
+}
+
+func (m *WindowUsage) Reset()         { *m = WindowUsage{} }
+func (m *WindowUsage) String() string { return proto.CompactTextString(m) }
+func (*WindowUsage) ProtoMessage()    {}
+func (*WindowUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{11}
+}
+
+func (m *WindowUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *WindowUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WindowUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *WindowUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindowUsage.Merge(m, src)
+}
+
+func (m *WindowUsage) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *WindowUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindowUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WindowUsage proto.InternalMessageInfo
+
+// AllOfLimits combines limits. A call is accepted when it is accepted by all
+// of them. The grant is removed when one of them is used up.
+// Since: wasmd 0.42
+type AllOfLimits struct {
+	// Limits that must all accept. Nested AllOfLimits are not supported.
+	Limits []*types.Any `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"`
+}
+
+func (m *AllOfLimits) Reset()         { *m = AllOfLimits{} }
+func (m *AllOfLimits) String() string { return proto.CompactTextString(m) }
+func (*AllOfLimits) ProtoMessage()    {}
+func (*AllOfLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{12}
+}
+
+func (m *AllOfLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *AllOfLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllOfLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *AllOfLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllOfLimits.Merge(m, src)
+}
+
+func (m *AllOfLimits) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *AllOfLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllOfLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllOfLimits proto.InternalMessageInfo
+
 // AllowAllMessagesFilter is a wildcard to allow any type of contract payload
 // message.
 // Since: wasmd 0.30
@@ -320,7 +687,7 @@ func (m *AllowAllMessagesFilter) Reset()         { *m = AllowAllMessagesFilter{}
 func (m *AllowAllMessagesFilter) String() string { return proto.CompactTextString(m) }
 func (*AllowAllMessagesFilter) ProtoMessage()    {}
 func (*AllowAllMessagesFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36ff3a20cf32b258, []int{6}
+	return fileDescriptor_36ff3a20cf32b258, []int{13}
 }
 
 func (m *AllowAllMessagesFilter) XXX_Unmarshal(b []byte) error {
@@ -366,7 +733,7 @@ func (m *AcceptedMessageKeysFilter) Reset()         { *m = AcceptedMessageKeysFi
 func (m *AcceptedMessageKeysFilter) String() string { return proto.CompactTextString(m) }
 func (*AcceptedMessageKeysFilter) ProtoMessage()    {}
 func (*AcceptedMessageKeysFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36ff3a20cf32b258, []int{7}
+	return fileDescriptor_36ff3a20cf32b258, []int{14}
 }
 
 func (m *AcceptedMessageKeysFilter) XXX_Unmarshal(b []byte) error {
@@ -412,7 +779,7 @@ func (m *AcceptedMessagesFilter) Reset()         { *m = AcceptedMessagesFilter{}
 func (m *AcceptedMessagesFilter) String() string { return proto.CompactTextString(m) }
 func (*AcceptedMessagesFilter) ProtoMessage()    {}
 func (*AcceptedMessagesFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36ff3a20cf32b258, []int{8}
+	return fileDescriptor_36ff3a20cf32b258, []int{15}
 }
 
 func (m *AcceptedMessagesFilter) XXX_Unmarshal(b []byte) error {
@@ -446,64 +813,211 @@ func (m *AcceptedMessagesFilter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AcceptedMessagesFilter proto.InternalMessageInfo
 
+// AcceptedMessageFieldsFilter accept only the contract messages where all
+// rules match a field of the json object to be executed.
+// Since: wasmd 0.42
+type AcceptedMessageFieldsFilter struct {
+	// Rules that must all match
+	Rules []MessageFieldRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules"`
+}
+
+func (m *AcceptedMessageFieldsFilter) Reset()         { *m = AcceptedMessageFieldsFilter{} }
+func (m *AcceptedMessageFieldsFilter) String() string { return proto.CompactTextString(m) }
+func (*AcceptedMessageFieldsFilter) ProtoMessage()    {}
+func (*AcceptedMessageFieldsFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{16}
+}
+
+func (m *AcceptedMessageFieldsFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *AcceptedMessageFieldsFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcceptedMessageFieldsFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *AcceptedMessageFieldsFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptedMessageFieldsFilter.Merge(m, src)
+}
+
+func (m *AcceptedMessageFieldsFilter) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *AcceptedMessageFieldsFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptedMessageFieldsFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptedMessageFieldsFilter proto.InternalMessageInfo
+
+// MessageFieldRule compares the value at a path of the json contract message.
+// Exactly one comparison must be set. A message without a value at the path
+// does not match.
+// Since: wasmd 0.42
+type MessageFieldRule struct {
+	// Path is the JSON pointer (RFC 6901) to the value, for example
+	// "/transfer/recipient"
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Equals is the json value that the field must be equal to
+	Equals RawContractMessage `protobuf:"bytes,2,opt,name=equals,proto3,casttype=RawContractMessage" json:"equals,omitempty"`
+	// In is the set of json values that the field must be equal to one of
+	In []RawContractMessage `protobuf:"bytes,3,rep,name=in,proto3,casttype=RawContractMessage" json:"in,omitempty"`
+	// LTE is the decimal number that the field must be less or equal to. The
+	// field can be a json number or a string that contains a number, as used for
+	// Uint128 amounts
+	LTE string `protobuf:"bytes,4,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (m *MessageFieldRule) Reset()         { *m = MessageFieldRule{} }
+func (m *MessageFieldRule) String() string { return proto.CompactTextString(m) }
+func (*MessageFieldRule) ProtoMessage()    {}
+func (*MessageFieldRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{17}
+}
+
+func (m *MessageFieldRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MessageFieldRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageFieldRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MessageFieldRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageFieldRule.Merge(m, src)
+}
+
+func (m *MessageFieldRule) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MessageFieldRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageFieldRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageFieldRule proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ContractExecutionAuthorization)(nil), "cosmwasm.wasm.v1.ContractExecutionAuthorization")
 	proto.RegisterType((*ContractMigrationAuthorization)(nil), "cosmwasm.wasm.v1.ContractMigrationAuthorization")
+	proto.RegisterType((*ContractUpdateAdminAuthorization)(nil), "cosmwasm.wasm.v1.ContractUpdateAdminAuthorization")
+	proto.RegisterType((*ContractClearAdminAuthorization)(nil), "cosmwasm.wasm.v1.ContractClearAdminAuthorization")
+	proto.RegisterType((*ContractInstantiationAuthorization)(nil), "cosmwasm.wasm.v1.ContractInstantiationAuthorization")
 	proto.RegisterType((*ContractGrant)(nil), "cosmwasm.wasm.v1.ContractGrant")
+	proto.RegisterType((*CodeGrant)(nil), "cosmwasm.wasm.v1.CodeGrant")
 	proto.RegisterType((*MaxCallsLimit)(nil), "cosmwasm.wasm.v1.MaxCallsLimit")
 	proto.RegisterType((*MaxFundsLimit)(nil), "cosmwasm.wasm.v1.MaxFundsLimit")
 	proto.RegisterType((*CombinedLimit)(nil), "cosmwasm.wasm.v1.CombinedLimit")
+	proto.RegisterType((*WindowLimit)(nil), "cosmwasm.wasm.v1.WindowLimit")
+	proto.RegisterType((*WindowUsage)(nil), "cosmwasm.wasm.v1.WindowUsage")
+	proto.RegisterType((*AllOfLimits)(nil), "cosmwasm.wasm.v1.AllOfLimits")
 	proto.RegisterType((*AllowAllMessagesFilter)(nil), "cosmwasm.wasm.v1.AllowAllMessagesFilter")
 	proto.RegisterType((*AcceptedMessageKeysFilter)(nil), "cosmwasm.wasm.v1.AcceptedMessageKeysFilter")
 	proto.RegisterType((*AcceptedMessagesFilter)(nil), "cosmwasm.wasm.v1.AcceptedMessagesFilter")
+	proto.RegisterType((*AcceptedMessageFieldsFilter)(nil), "cosmwasm.wasm.v1.AcceptedMessageFieldsFilter")
+	proto.RegisterType((*MessageFieldRule)(nil), "cosmwasm.wasm.v1.MessageFieldRule")
 }
 
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/authz.proto", fileDescriptor_36ff3a20cf32b258) }
 
 var fileDescriptor_36ff3a20cf32b258 = []byte{
-	// 665 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4d, 0x4f, 0xd4, 0x40,
-	0x18, 0xde, 0x01, 0x44, 0x76, 0x10, 0x3f, 0x1a, 0x42, 0x16, 0x24, 0x5d, 0x52, 0xbf, 0x56, 0x92,
-	0x6d, 0xb3, 0x18, 0x2f, 0x7b, 0x31, 0xbb, 0xab, 0x18, 0x23, 0x78, 0x68, 0x4c, 0x20, 0x5e, 0xc8,
-	0xb4, 0x3b, 0x94, 0x91, 0x76, 0x86, 0x74, 0xa6, 0xc0, 0x92, 0xf8, 0x07, 0x3c, 0x79, 0xf3, 0x2f,
-	0x18, 0x4f, 0x1c, 0xf6, 0xe8, 0x0f, 0x20, 0x9c, 0x38, 0x1a, 0x0f, 0xa8, 0x10, 0xc3, 0x7f, 0xf0,
-	0x64, 0x3a, 0x33, 0x5d, 0x58, 0x02, 0x1b, 0xe4, 0xc4, 0x65, 0xda, 0xf7, 0x7d, 0x67, 0x9e, 0xe7,
-	0x79, 0x3f, 0xda, 0x81, 0x93, 0x3e, 0xe3, 0xd1, 0x06, 0xe2, 0x91, 0x23, 0x97, 0xf5, 0x8a, 0x83,
-	0x12, 0xb1, 0xb2, 0x65, 0xaf, 0xc5, 0x4c, 0x30, 0xe3, 0x76, 0x16, 0xb5, 0xe5, 0xb2, 0x5e, 0x99,
-	0x18, 0x0d, 0x58, 0xc0, 0x64, 0xd0, 0x49, 0xdf, 0xd4, 0xbe, 0x89, 0xf1, 0x74, 0x1f, 0xe3, 0x4b,
-	0x2a, 0xa0, 0x0c, 0x1d, 0x32, 0x95, 0xe5, 0x78, 0x88, 0x63, 0x67, 0xbd, 0xe2, 0x61, 0x81, 0x2a,
-	0x8e, 0xcf, 0x08, 0xcd, 0x8e, 0x06, 0x8c, 0x05, 0x21, 0x76, 0xa4, 0xe5, 0x25, 0xcb, 0x0e, 0xa2,
-	0x2d, 0x1d, 0xba, 0x83, 0x22, 0x42, 0x99, 0x23, 0x57, 0xe5, 0xb2, 0xda, 0x00, 0x9a, 0x0d, 0x46,
-	0x45, 0x8c, 0x7c, 0xf1, 0x62, 0x13, 0xfb, 0x89, 0x20, 0x8c, 0xd6, 0x12, 0xb1, 0xc2, 0x62, 0xb2,
-	0x85, 0x52, 0xc3, 0xa8, 0xc3, 0xc1, 0x20, 0x46, 0x54, 0xf0, 0x02, 0x98, 0xea, 0x2f, 0x0d, 0xcf,
-	0x14, 0xed, 0xd3, 0x49, 0xd8, 0x19, 0xc2, 0xcb, 0x74, 0x5f, 0x3d, 0xbf, 0xb3, 0x5f, 0xcc, 0x7d,
-	0x39, 0xda, 0x9e, 0x06, 0xae, 0x3e, 0x59, 0x7d, 0xb3, 0xdb, 0x2e, 0x5b, 0x3a, 0x0d, 0x55, 0x0f,
-	0xad, 0xdc, 0xee, 0xe2, 0xfa, 0x78, 0xb4, 0x3d, 0x7d, 0x4f, 0xd6, 0xad, 0xb7, 0xa6, 0x2e, 0xd9,
-	0xf3, 0x24, 0x88, 0xd1, 0x15, 0x93, 0x7d, 0xb6, 0x26, 0xeb, 0x07, 0x80, 0x23, 0x5d, 0xa4, 0xc6,
-	0x04, 0x1c, 0xf2, 0xb5, 0xa3, 0x00, 0xa6, 0x40, 0x29, 0xef, 0x76, 0x6c, 0xe3, 0x2d, 0xbc, 0x16,
-	0x92, 0x88, 0x88, 0x42, 0xdf, 0x14, 0x28, 0x0d, 0xcf, 0x8c, 0xda, 0xaa, 0xb3, 0x76, 0xd6, 0x59,
-	0xbb, 0x46, 0x5b, 0xf5, 0xd2, 0x6e, 0xbb, 0x7c, 0xff, 0xdc, 0xcc, 0x52, 0xfa, 0xad, 0xb9, 0x14,
-	0x64, 0xd1, 0x55, 0x60, 0xc6, 0x02, 0x1c, 0x5c, 0x26, 0xa1, 0xc0, 0x71, 0xa1, 0xbf, 0x07, 0xec,
-	0xe3, 0xdd, 0x76, 0xf9, 0x41, 0x6f, 0xd8, 0x59, 0x89, 0xb2, 0xe8, 0x6a, 0x38, 0x8b, 0xc2, 0x91,
-	0x79, 0xb4, 0xd9, 0x40, 0x61, 0xc8, 0x25, 0xa3, 0x31, 0x09, 0xf3, 0x31, 0x8e, 0x10, 0xa1, 0x84,
-	0x06, 0x32, 0xb9, 0x01, 0xf7, 0xd8, 0x51, 0x7d, 0x76, 0x51, 0xe1, 0x69, 0x75, 0x0d, 0x59, 0xdd,
-	0x2e, 0x78, 0xeb, 0x1b, 0x90, 0x84, 0xb3, 0x09, 0x6d, 0x6a, 0xc2, 0xf7, 0xf0, 0x3a, 0x8a, 0x58,
-	0x72, 0xdc, 0xf3, 0x71, 0x5b, 0x37, 0x2f, 0xfd, 0x58, 0x3a, 0xbd, 0x6b, 0x30, 0x42, 0xeb, 0x4f,
-	0xd3, 0x6e, 0x7f, 0xfd, 0x59, 0x2c, 0x05, 0x44, 0xac, 0x24, 0x9e, 0xed, 0xb3, 0x48, 0x7f, 0x67,
-	0xfa, 0x51, 0xe6, 0xcd, 0x55, 0x47, 0xb4, 0xd6, 0x30, 0x97, 0x07, 0xb8, 0x9a, 0x8c, 0x8c, 0xe0,
-	0x92, 0xf2, 0x8f, 0xc5, 0x5a, 0x7f, 0xe4, 0x2c, 0x44, 0x1e, 0xa1, 0xb8, 0xa9, 0xe4, 0x3f, 0x82,
-	0xb7, 0xfc, 0x34, 0xbd, 0xa5, 0xd3, 0x55, 0xbb, 0x29, 0xdd, 0x6e, 0xe6, 0x3d, 0x99, 0x67, 0xdf,
-	0x15, 0xcc, 0xb3, 0x2b, 0x2b, 0xcb, 0x87, 0x63, 0xb5, 0x30, 0x64, 0x1b, 0xb5, 0x30, 0x9c, 0xc7,
-	0x9c, 0xa3, 0x00, 0x73, 0x35, 0x39, 0xd5, 0x57, 0x17, 0x9e, 0xb1, 0x14, 0xfb, 0xae, 0xc4, 0x3e,
-	0x1b, 0xca, 0xfa, 0x00, 0xc7, 0x6b, 0xbe, 0x8f, 0xd7, 0x04, 0x6e, 0xea, 0xc8, 0x6b, 0xdc, 0xd2,
-	0x41, 0xc3, 0x80, 0x03, 0xab, 0xb8, 0xa5, 0x66, 0x22, 0xef, 0xca, 0xf7, 0xea, 0xdc, 0x7f, 0x71,
-	0x9b, 0x8a, 0xfb, 0x3c, 0x06, 0xeb, 0x33, 0x80, 0x63, 0xa7, 0xa2, 0x19, 0xf9, 0x0c, 0x1c, 0x8a,
-	0xb4, 0x47, 0x0a, 0xb8, 0x51, 0x1f, 0xfb, 0xbb, 0x5f, 0x34, 0x5c, 0xb4, 0xd1, 0xf9, 0x57, 0xa8,
-	0xb0, 0xdb, 0xd9, 0x77, 0xb9, 0xc2, 0x9c, 0x49, 0x5f, 0x7f, 0xbe, 0xf3, 0xdb, 0xcc, 0xed, 0x1c,
-	0x98, 0x60, 0xef, 0xc0, 0x04, 0xbf, 0x0e, 0x4c, 0xf0, 0xe9, 0xd0, 0xcc, 0xed, 0x1d, 0x9a, 0xb9,
-	0xef, 0x87, 0x66, 0xee, 0xdd, 0xc3, 0x13, 0x43, 0xd1, 0x60, 0x3c, 0x5a, 0xc8, 0xee, 0xad, 0xa6,
-	0xb3, 0xa9, 0xee, 0x2f, 0x39, 0x18, 0xde, 0xa0, 0xfc, 0x37, 0x3c, 0xf9, 0x17, 0x00, 0x00, 0xff,
-	0xff, 0x84, 0xc4, 0x51, 0x3d, 0xdd, 0x06, 0x00, 0x00,
+	// 1240 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x3b, 0x6c, 0x23, 0x45,
+	0x18, 0xce, 0xf8, 0x15, 0x7b, 0x7c, 0x07, 0x61, 0x14, 0x9d, 0x9c, 0xe4, 0xb0, 0xad, 0xbd, 0x97,
+	0x39, 0x29, 0x36, 0x09, 0x42, 0x42, 0x46, 0x3c, 0xbc, 0xbe, 0x0b, 0x04, 0x92, 0x1c, 0x5a, 0x72,
+	0xe4, 0x44, 0x63, 0x8d, 0x77, 0x27, 0xf6, 0x70, 0xfb, 0x30, 0xfb, 0x88, 0x93, 0x48, 0x48, 0xd4,
+	0x54, 0xd7, 0x01, 0x12, 0x25, 0x05, 0x82, 0xe6, 0x84, 0x22, 0x2a, 0x0a, 0xca, 0x28, 0xd5, 0x89,
+	0x8a, 0x02, 0x25, 0x90, 0x08, 0x5d, 0x4d, 0x4b, 0x85, 0xe6, 0xb1, 0x8e, 0x9f, 0x21, 0x09, 0x9c,
+	0x80, 0x66, 0xbd, 0x33, 0xff, 0xff, 0x7f, 0xff, 0xf7, 0x7f, 0x33, 0xff, 0xec, 0x18, 0x5e, 0xd6,
+	0x1d, 0xcf, 0x6a, 0x63, 0xcf, 0x2a, 0xf1, 0xc7, 0xc6, 0x5c, 0x09, 0x07, 0x7e, 0x73, 0xbb, 0xd8,
+	0x72, 0x1d, 0xdf, 0x41, 0x13, 0xa1, 0xb5, 0xc8, 0x1f, 0x1b, 0x73, 0xd3, 0x93, 0x0d, 0xa7, 0xe1,
+	0x70, 0x63, 0x89, 0xbd, 0x09, 0xbf, 0xe9, 0x29, 0xe6, 0xe7, 0x78, 0x35, 0x61, 0x10, 0x03, 0x69,
+	0xca, 0x8a, 0x51, 0xa9, 0x8e, 0x3d, 0x52, 0xda, 0x98, 0xab, 0x13, 0x1f, 0xcf, 0x95, 0x74, 0x87,
+	0xda, 0x61, 0x68, 0xc3, 0x71, 0x1a, 0x26, 0x29, 0xf1, 0x51, 0x3d, 0x58, 0x2f, 0x61, 0x7b, 0x2b,
+	0x0c, 0xed, 0x37, 0x19, 0x81, 0x8b, 0x7d, 0xea, 0x84, 0xa1, 0xb9, 0x7e, 0xbb, 0x4f, 0x2d, 0xe2,
+	0xf9, 0xd8, 0x6a, 0x49, 0x87, 0x67, 0xb0, 0x45, 0x6d, 0xa7, 0xc4, 0x9f, 0x72, 0x6a, 0xb0, 0x5e,
+	0x7f, 0xab, 0x45, 0x24, 0x59, 0x65, 0x07, 0xc0, 0x6c, 0xd5, 0xb1, 0x7d, 0x17, 0xeb, 0xfe, 0xed,
+	0x4d, 0xa2, 0x07, 0x2c, 0x5b, 0x25, 0xf0, 0x9b, 0x8e, 0x4b, 0xb7, 0x79, 0x6a, 0xa4, 0xc2, 0x44,
+	0xc3, 0xc5, 0xb6, 0xef, 0x65, 0x40, 0x3e, 0x5a, 0x48, 0xcf, 0xe7, 0x8a, 0xfd, 0x1a, 0x15, 0x43,
+	0x84, 0x37, 0x98, 0x9f, 0x9a, 0xda, 0xdd, 0xcf, 0x8d, 0x7d, 0xf5, 0xf8, 0xe1, 0x4d, 0xa0, 0xc9,
+	0xc8, 0xf2, 0xca, 0xde, 0xce, 0xac, 0x22, 0x55, 0x12, 0x72, 0x4b, 0x61, 0x8a, 0x3d, 0xb9, 0x3e,
+	0x79, 0xfc, 0xf0, 0xe6, 0x15, 0x4e, 0xf3, 0x64, 0x4e, 0x3d, 0xb4, 0x97, 0x69, 0xc3, 0xc5, 0x03,
+	0x2e, 0xff, 0x2e, 0xed, 0xe1, 0x9c, 0x94, 0x8f, 0x23, 0x30, 0x1f, 0xba, 0xdc, 0x6d, 0x19, 0xd8,
+	0x27, 0x15, 0xc3, 0xa2, 0xff, 0x3c, 0x71, 0xb4, 0x00, 0x11, 0x36, 0x4d, 0xa7, 0x4d, 0x8c, 0x9a,
+	0x4d, 0xda, 0x35, 0xcc, 0xb2, 0x78, 0x99, 0x48, 0x3e, 0x5a, 0x48, 0xa9, 0x99, 0x1f, 0x77, 0x66,
+	0x27, 0x65, 0x51, 0x15, 0xc3, 0x70, 0x89, 0xe7, 0xbd, 0xeb, 0xbb, 0xd4, 0x6e, 0x68, 0x13, 0x32,
+	0x66, 0x85, 0xb4, 0x39, 0x2f, 0xaf, 0xfc, 0xce, 0xe9, 0x05, 0xb8, 0xd6, 0x23, 0xc0, 0xa8, 0xea,
+	0x94, 0xef, 0x00, 0xcc, 0x85, 0x4e, 0x55, 0x93, 0x60, 0xf7, 0xc9, 0x28, 0x50, 0xbe, 0x73, 0x7a,
+	0xe6, 0x57, 0x7b, 0x98, 0x8f, 0x20, 0xa5, 0xfc, 0x0c, 0xa0, 0x12, 0xfa, 0x2c, 0xda, 0x9e, 0x8f,
+	0x6d, 0x9f, 0x0e, 0xd9, 0x76, 0xaf, 0xf6, 0x71, 0x9f, 0x19, 0xc6, 0xdd, 0x20, 0x23, 0x57, 0x4e,
+	0x81, 0x17, 0x68, 0x07, 0x9d, 0xcc, 0x67, 0x22, 0x79, 0x50, 0x48, 0x6a, 0x3d, 0x73, 0x65, 0xed,
+	0xf4, 0xb5, 0xdd, 0xe8, 0xa9, 0x6d, 0x34, 0x6f, 0xe5, 0x8b, 0x08, 0xbc, 0xd8, 0x23, 0x2a, 0x9a,
+	0x86, 0x49, 0x5d, 0x4e, 0x64, 0x40, 0x1e, 0x14, 0x52, 0x5a, 0x67, 0x8c, 0x56, 0x61, 0xdc, 0xa4,
+	0x16, 0xf5, 0x39, 0xbd, 0xf4, 0xfc, 0x64, 0x51, 0x1c, 0x4c, 0xc5, 0xf0, 0x60, 0x2a, 0x56, 0xec,
+	0x2d, 0xb5, 0xb0, 0xb7, 0x33, 0x7b, 0x75, 0xe4, 0xca, 0xb1, 0xf4, 0xdb, 0x4b, 0x0c, 0xe4, 0x9e,
+	0x26, 0xc0, 0xd0, 0x1a, 0x4c, 0xac, 0x53, 0xd3, 0x27, 0x6e, 0x26, 0x7a, 0x02, 0xec, 0x73, 0x7b,
+	0x3b, 0xb3, 0xd7, 0x4e, 0x86, 0x5d, 0xe0, 0x28, 0xf7, 0x34, 0x09, 0x87, 0xae, 0xc0, 0x71, 0xdd,
+	0x31, 0x48, 0x8d, 0x1a, 0x99, 0x58, 0x1e, 0x14, 0x62, 0x2a, 0x3c, 0xdc, 0xcf, 0x25, 0xd8, 0x3a,
+	0x2c, 0xde, 0xd2, 0x12, 0xcc, 0xb4, 0x68, 0xa0, 0x19, 0x98, 0xe2, 0x4e, 0x4d, 0xec, 0x35, 0x33,
+	0xf1, 0x3c, 0x28, 0x5c, 0x60, 0x05, 0x1b, 0xe4, 0x4d, 0xec, 0x35, 0x95, 0x6f, 0x22, 0x30, 0xd5,
+	0x59, 0xb7, 0x6e, 0x3c, 0x30, 0x12, 0xef, 0x7f, 0xa6, 0xd1, 0x5b, 0x30, 0xad, 0x3b, 0xb6, 0xe7,
+	0xbb, 0x98, 0xb2, 0xdd, 0x1b, 0xe3, 0xe8, 0x85, 0xc1, 0xdd, 0x7b, 0xbc, 0x87, 0x48, 0xf5, 0xd8,
+	0x5f, 0xeb, 0x0e, 0x56, 0x6c, 0x78, 0x71, 0x19, 0x6f, 0x56, 0xb1, 0x69, 0x7a, 0x9c, 0x3d, 0xba,
+	0x0c, 0x53, 0x2e, 0xb1, 0x30, 0xb5, 0xa9, 0xdd, 0x10, 0x92, 0x69, 0xc7, 0x13, 0xe5, 0xd7, 0x4e,
+	0x2b, 0x02, 0xdb, 0xd1, 0x88, 0xef, 0xe8, 0x1e, 0x78, 0xe5, 0x7b, 0xc0, 0x13, 0x2e, 0x04, 0xb6,
+	0x21, 0x13, 0x7e, 0x00, 0xc7, 0xb1, 0xe5, 0x04, 0xc7, 0x7d, 0x38, 0x55, 0x94, 0x0d, 0xc3, 0x3e,
+	0xcb, 0x9d, 0x7e, 0xa9, 0x3a, 0xd4, 0x56, 0x5f, 0x64, 0x5d, 0xf8, 0xf5, 0x41, 0xae, 0xd0, 0xa0,
+	0x7e, 0x33, 0xa8, 0x17, 0x75, 0xc7, 0x92, 0x5f, 0x74, 0xf9, 0x33, 0xeb, 0x19, 0xf7, 0xe5, 0x57,
+	0x93, 0x05, 0x78, 0xa2, 0x63, 0xc3, 0x04, 0xe7, 0xa4, 0x7f, 0x4c, 0x56, 0xf9, 0x0d, 0xb0, 0xde,
+	0xb3, 0xea, 0xd4, 0x26, 0x86, 0xa0, 0x7f, 0x03, 0x3e, 0xad, 0xb3, 0xf2, 0x6a, 0xfd, 0xaa, 0x3d,
+	0xc5, 0xa7, 0xb5, 0x70, 0xb6, 0xbb, 0xce, 0xc8, 0x7f, 0xb0, 0xce, 0x9e, 0xaa, 0x94, 0xdf, 0x23,
+	0x30, 0xbd, 0x46, 0x6d, 0xc3, 0x69, 0x8b, 0x2a, 0x5f, 0x87, 0x89, 0x36, 0x1f, 0xf2, 0xe2, 0x18,
+	0xf7, 0xfe, 0xbd, 0x7c, 0x4b, 0xde, 0x7f, 0xd4, 0x8b, 0x8c, 0xfb, 0x67, 0x07, 0x39, 0x20, 0x4f,
+	0x4b, 0x11, 0x87, 0x32, 0x70, 0xdc, 0x75, 0x4c, 0x93, 0xe9, 0x23, 0x0e, 0xca, 0x70, 0xc8, 0xba,
+	0xd9, 0xc2, 0x9b, 0x35, 0x2e, 0x17, 0x6f, 0x95, 0x98, 0x96, 0xb4, 0xe4, 0xa6, 0x41, 0x96, 0x30,
+	0xae, 0xb3, 0x25, 0xc8, 0xc4, 0x9e, 0x90, 0x6e, 0x49, 0x4b, 0x2e, 0x32, 0x7a, 0x19, 0x26, 0x02,
+	0x0f, 0x37, 0x88, 0x97, 0x89, 0xf3, 0x5c, 0xcf, 0x0e, 0x76, 0x95, 0x90, 0xe5, 0x2e, 0xf3, 0x52,
+	0x63, 0x2c, 0x9f, 0x26, 0x43, 0xca, 0xaf, 0x9c, 0x45, 0xf5, 0x09, 0xae, 0x7a, 0x97, 0xc6, 0xca,
+	0x0f, 0x00, 0xa6, 0xbb, 0xc0, 0xd1, 0x4b, 0x30, 0xc6, 0x2e, 0x8d, 0x52, 0xf1, 0xe9, 0x01, 0xc5,
+	0x57, 0xc3, 0x1b, 0xa5, 0x9a, 0x64, 0x34, 0x1e, 0x1c, 0xe4, 0x80, 0xc6, 0x23, 0xd0, 0x24, 0x8c,
+	0x0b, 0x35, 0x23, 0x5c, 0x4d, 0x31, 0x40, 0x18, 0xc6, 0x85, 0x8c, 0xd1, 0xbf, 0x92, 0xf1, 0xf9,
+	0xb3, 0xca, 0xa8, 0x09, 0x64, 0xe5, 0x4b, 0x00, 0xd3, 0x15, 0xd3, 0xbc, 0xb3, 0xce, 0x2b, 0xf2,
+	0xd0, 0x7b, 0x30, 0xc1, 0xcf, 0xc2, 0xb0, 0xb5, 0xff, 0xee, 0xc9, 0x2a, 0xd1, 0xce, 0xa3, 0x74,
+	0x17, 0x2d, 0x45, 0x87, 0x97, 0x2a, 0xec, 0xfe, 0x54, 0x31, 0xcd, 0x65, 0xe2, 0xf1, 0xc5, 0x13,
+	0x67, 0x6c, 0x79, 0xf1, 0xd4, 0xa7, 0x31, 0x43, 0x9e, 0x09, 0x91, 0x87, 0x40, 0x29, 0x1f, 0xc1,
+	0xa9, 0x8a, 0xae, 0x93, 0x96, 0x4f, 0x0c, 0x69, 0x79, 0x9b, 0x6c, 0x49, 0x23, 0x42, 0x30, 0x76,
+	0x9f, 0x6c, 0x09, 0x59, 0x52, 0x1a, 0x7f, 0x2f, 0x2f, 0x9d, 0x29, 0x77, 0x56, 0xe4, 0x1e, 0x95,
+	0x41, 0xf9, 0x14, 0xc0, 0x4b, 0x7d, 0xd6, 0x30, 0xf9, 0x3c, 0x4c, 0x5a, 0x72, 0x86, 0x13, 0xb8,
+	0xa0, 0x5e, 0xfa, 0x63, 0x3f, 0x87, 0x34, 0xdc, 0xee, 0x5c, 0x8a, 0x85, 0x59, 0xeb, 0xf8, 0x9d,
+	0x4f, 0x98, 0xa1, 0xe9, 0x95, 0x6f, 0x01, 0x9c, 0xe9, 0x33, 0x2d, 0x50, 0x62, 0x1a, 0x21, 0xbd,
+	0x2a, 0x8c, 0xbb, 0x81, 0x49, 0xc2, 0x3d, 0xa3, 0x0c, 0xb6, 0x60, 0x77, 0x94, 0x16, 0x98, 0xa4,
+	0xfb, 0x76, 0x26, 0x62, 0xcb, 0x2b, 0x67, 0xe2, 0x9b, 0x1f, 0xc6, 0xb7, 0x9b, 0x94, 0xf2, 0x39,
+	0x80, 0x13, 0xfd, 0x69, 0xd9, 0x2a, 0xb6, 0xb0, 0xdf, 0x94, 0x77, 0x2e, 0xfe, 0x8e, 0x8a, 0x30,
+	0x41, 0x3e, 0x0c, 0xb0, 0x6c, 0xbe, 0xd1, 0xd2, 0x4a, 0x2f, 0x74, 0x1d, 0x46, 0xa8, 0xcd, 0x5b,
+	0x72, 0xb4, 0x6f, 0x84, 0xda, 0x68, 0x0a, 0x46, 0x4d, 0x9f, 0xf0, 0x8f, 0x7d, 0x4a, 0x1d, 0x3f,
+	0xdc, 0xcf, 0x45, 0x97, 0x56, 0x6f, 0x6b, 0x6c, 0x4e, 0xbd, 0xb5, 0xfb, 0x6b, 0x76, 0x6c, 0xf7,
+	0x30, 0x0b, 0x1e, 0x1d, 0x66, 0xc1, 0x2f, 0x87, 0x59, 0xf0, 0xe0, 0x28, 0x3b, 0xf6, 0xe8, 0x28,
+	0x3b, 0xf6, 0xd3, 0x51, 0x76, 0xec, 0xfd, 0xeb, 0x5d, 0x4d, 0x5c, 0x75, 0x3c, 0x6b, 0x2d, 0xfc,
+	0x83, 0x69, 0x94, 0x36, 0xf9, 0xaf, 0x68, 0xe4, 0x7a, 0x82, 0xf7, 0xe4, 0x0b, 0x7f, 0x0e, 0x00,
+	0x36, 0xd7, 0x42, 0xb8, 0x76, 0x0f, 0x00, 0x00,
 }
 
 func (m *ContractExecutionAuthorization) Marshal() (dAtA []byte, err error) {
@@ -580,7 +1094,7 @@ func (m *ContractMigrationAuthorization) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractGrant) Marshal() (dAtA []byte, err error) {
+func (m *ContractUpdateAdminAuthorization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -590,19 +1104,161 @@ func (m *ContractGrant) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractGrant) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractUpdateAdminAuthorization) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContractUpdateAdminAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Filter != nil {
-		{
-			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+	if len(m.AllowedNewAdmins) > 0 {
+		for iNdEx := len(m.AllowedNewAdmins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedNewAdmins[iNdEx])
+			copy(dAtA[i:], m.AllowedNewAdmins[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedNewAdmins[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractClearAdminAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractClearAdminAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractClearAdminAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractInstantiationAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractInstantiationAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractInstantiationAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Instantiate2 {
+		i--
+		if m.Instantiate2 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -634,6 +1290,70 @@ func (m *ContractGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CodeGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Constraints != nil {
+		{
+			size, err := m.Constraints.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != nil {
+		{
+			size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MaxCallsLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -741,7 +1461,7 @@ func (m *CombinedLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AllowAllMessagesFilter) Marshal() (dAtA []byte, err error) {
+func (m *WindowLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -751,20 +1471,71 @@ func (m *AllowAllMessagesFilter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AllowAllMessagesFilter) MarshalTo(dAtA []byte) (int, error) {
+func (m *WindowLimit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllowAllMessagesFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WindowLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Usages) > 0 {
+		for iNdEx := len(m.Usages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MaxFunds) > 0 {
+		for iNdEx := len(m.MaxFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxCalls != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxCalls))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Rolling {
+		i--
+		if m.Rolling {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintAuthz(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *AcceptedMessageKeysFilter) Marshal() (dAtA []byte, err error) {
+func (m *WindowUsage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -774,29 +1545,47 @@ func (m *AcceptedMessageKeysFilter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AcceptedMessageKeysFilter) MarshalTo(dAtA []byte) (int, error) {
+func (m *WindowUsage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AcceptedMessageKeysFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WindowUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Keys[iNdEx])
-			copy(dAtA[i:], m.Keys[iNdEx])
-			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Keys[iNdEx])))
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
 		}
 	}
+	if m.Calls != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Calls))
+		i--
+		dAtA[i] = 0x10
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintAuthz(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *AcceptedMessagesFilter) Marshal() (dAtA []byte, err error) {
+func (m *AllOfLimits) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -806,21 +1595,26 @@ func (m *AcceptedMessagesFilter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AcceptedMessagesFilter) MarshalTo(dAtA []byte) (int, error) {
+func (m *AllOfLimits) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AcceptedMessagesFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AllOfLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Messages[iNdEx])
-			copy(dAtA[i:], m.Messages[iNdEx])
-			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Messages[iNdEx])))
+	if len(m.Limits) > 0 {
+		for iNdEx := len(m.Limits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0xa
 		}
@@ -828,16 +1622,193 @@ func (m *AcceptedMessagesFilter) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuthz(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AllowAllMessagesFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *AllowAllMessagesFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowAllMessagesFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AcceptedMessageKeysFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcceptedMessageKeysFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcceptedMessageKeysFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AcceptedMessagesFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcceptedMessagesFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcceptedMessagesFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Messages[iNdEx])
+			copy(dAtA[i:], m.Messages[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Messages[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AcceptedMessageFieldsFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcceptedMessageFieldsFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcceptedMessageFieldsFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageFieldRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageFieldRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageFieldRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LTE) > 0 {
+		i -= len(m.LTE)
+		copy(dAtA[i:], m.LTE)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.LTE)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.In) > 0 {
+		for iNdEx := len(m.In) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.In[iNdEx])
+			copy(dAtA[i:], m.In[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.In[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Equals) > 0 {
+		i -= len(m.Equals)
+		copy(dAtA[i:], m.Equals)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Equals)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 
 func (m *ContractExecutionAuthorization) Size() (n int) {
@@ -870,6 +1841,60 @@ func (m *ContractMigrationAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *ContractUpdateAdminAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedNewAdmins) > 0 {
+		for _, s := range m.AllowedNewAdmins {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractClearAdminAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractInstantiationAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.Instantiate2 {
+		n += 2
+	}
+	return n
+}
+
 func (m *ContractGrant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -888,6 +1913,37 @@ func (m *ContractGrant) Size() (n int) {
 		l = m.Filter.Size()
 		n += 1 + l + sovAuthz(uint64(l))
 	}
+	if m.CodeID != 0 {
+		n += 1 + sovAuthz(uint64(m.CodeID))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *CodeGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovAuthz(uint64(m.CodeID))
+	}
+	if m.Limit != nil {
+		l = m.Limit.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Constraints != nil {
+		l = m.Constraints.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
 	return n
 }
 
@@ -936,54 +1992,1178 @@ func (m *CombinedLimit) Size() (n int) {
 	return n
 }
 
-func (m *AllowAllMessagesFilter) Size() (n int) {
+func (m *WindowLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovAuthz(uint64(l))
+	if m.Rolling {
+		n += 2
+	}
+	if m.MaxCalls != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxCalls))
+	}
+	if len(m.MaxFunds) > 0 {
+		for _, e := range m.MaxFunds {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.Usages) > 0 {
+		for _, e := range m.Usages {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WindowUsage) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovAuthz(uint64(l))
+	if m.Calls != 0 {
+		n += 1 + sovAuthz(uint64(m.Calls))
+	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *AcceptedMessageKeysFilter) Size() (n int) {
+func (m *AllOfLimits) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Keys) > 0 {
-		for _, s := range m.Keys {
-			l = len(s)
+	if len(m.Limits) > 0 {
+		for _, e := range m.Limits {
+			l = e.Size()
 			n += 1 + l + sovAuthz(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *AllowAllMessagesFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AcceptedMessageKeysFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AcceptedMessagesFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, b := range m.Messages {
+			l = len(b)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AcceptedMessageFieldsFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessageFieldRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.Equals)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.In) > 0 {
+		for _, b := range m.In {
+			l = len(b)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = len(m.LTE)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *ContractExecutionAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractExecutionAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractExecutionAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, ContractGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractMigrationAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractMigrationAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractMigrationAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, ContractGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractUpdateAdminAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractUpdateAdminAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractUpdateAdminAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, ContractGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedNewAdmins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedNewAdmins = append(m.AllowedNewAdmins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractClearAdminAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractClearAdminAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractClearAdminAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, ContractGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractInstantiationAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractInstantiationAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractInstantiationAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, CodeGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instantiate2", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Instantiate2 = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limit == nil {
+				m.Limit = &types.Any{}
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &types.Any{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CodeGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limit == nil {
+				m.Limit = &types.Any{}
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &types.Any{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Constraints == nil {
+				m.Constraints = &InstantiateConstraints{}
+			}
+			if err := m.Constraints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MaxCallsLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaxCallsLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaxCallsLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MaxFundsLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaxFundsLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaxFundsLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, types1.Coin{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *AcceptedMessagesFilter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for _, b := range m.Messages {
-			l = len(b)
-			n += 1 + l + sovAuthz(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovAuthz(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-
-func sozAuthz(x uint64) (n int) {
-	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
 
-func (m *ContractExecutionAuthorization) Unmarshal(dAtA []byte) error {
+func (m *CombinedLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1006,15 +3186,34 @@ func (m *ContractExecutionAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractExecutionAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: CombinedLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractExecutionAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CombinedLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallsRemaining", wireType)
+			}
+			m.CallsRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallsRemaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1041,8 +3240,8 @@ func (m *ContractExecutionAuthorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grants = append(m.Grants, ContractGrant{})
-			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amounts = append(m.Amounts, types1.Coin{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1068,7 +3267,7 @@ func (m *ContractExecutionAuthorization) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *ContractMigrationAuthorization) Unmarshal(dAtA []byte) error {
+func (m *WindowLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1091,15 +3290,15 @@ func (m *ContractMigrationAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractMigrationAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: WindowLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractMigrationAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WindowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1126,8 +3325,114 @@ func (m *ContractMigrationAuthorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grants = append(m.Grants, ContractGrant{})
-			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rolling", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rolling = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCalls", wireType)
+			}
+			m.MaxCalls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCalls |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFunds = append(m.MaxFunds, types1.Coin{})
+			if err := m.MaxFunds[len(m.MaxFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usages = append(m.Usages, WindowUsage{})
+			if err := m.Usages[len(m.Usages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1153,7 +3458,7 @@ func (m *ContractMigrationAuthorization) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *ContractGrant) Unmarshal(dAtA []byte) error {
+func (m *WindowUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1176,17 +3481,17 @@ func (m *ContractGrant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractGrant: wiretype end group for non-group")
+			return fmt.Errorf("proto: WindowUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WindowUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthz
@@ -1196,27 +3501,47 @@ func (m *ContractGrant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuthz
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuthz
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			m.Calls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Calls |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1243,16 +3568,65 @@ func (m *ContractGrant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Limit == nil {
-				m.Limit = &types.Any{}
+			m.Funds = append(m.Funds, types1.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AllOfLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllOfLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllOfLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1279,10 +3653,8 @@ func (m *ContractGrant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &types.Any{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Limits = append(m.Limits, &types.Any{})
+			if err := m.Limits[len(m.Limits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1308,7 +3680,7 @@ func (m *ContractGrant) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MaxCallsLimit) Unmarshal(dAtA []byte) error {
+func (m *AllowAllMessagesFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1331,31 +3703,12 @@ func (m *MaxCallsLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MaxCallsLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllowAllMessagesFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaxCallsLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllowAllMessagesFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
-			}
-			m.Remaining = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuthz
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Remaining |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -1378,7 +3731,7 @@ func (m *MaxCallsLimit) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MaxFundsLimit) Unmarshal(dAtA []byte) error {
+func (m *AcceptedMessageKeysFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1401,17 +3754,17 @@ func (m *MaxFundsLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MaxFundsLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: AcceptedMessageKeysFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaxFundsLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AcceptedMessageKeysFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthz
@@ -1421,25 +3774,23 @@ func (m *MaxFundsLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuthz
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuthz
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amounts = append(m.Amounts, types1.Coin{})
-			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1463,7 +3814,7 @@ func (m *MaxFundsLimit) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *CombinedLimit) Unmarshal(dAtA []byte) error {
+func (m *AcceptedMessagesFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1486,36 +3837,17 @@ func (m *CombinedLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CombinedLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: AcceptedMessagesFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CombinedLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AcceptedMessagesFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallsRemaining", wireType)
-			}
-			m.CallsRemaining = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuthz
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CallsRemaining |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthz
@@ -1525,25 +3857,23 @@ func (m *CombinedLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAuthz
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuthz
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amounts = append(m.Amounts, types1.Coin{})
-			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Messages = append(m.Messages, make([]byte, postIndex-iNdEx))
+			copy(m.Messages[len(m.Messages)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1567,7 +3897,7 @@ func (m *CombinedLimit) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *AllowAllMessagesFilter) Unmarshal(dAtA []byte) error {
+func (m *AcceptedMessageFieldsFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1590,12 +3920,46 @@ func (m *AllowAllMessagesFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowAllMessagesFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: AcceptedMessageFieldsFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowAllMessagesFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AcceptedMessageFieldsFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, MessageFieldRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -1618,7 +3982,7 @@ func (m *AllowAllMessagesFilter) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *AcceptedMessageKeysFilter) Unmarshal(dAtA []byte) error {
+func (m *MessageFieldRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1641,15 +4005,15 @@ func (m *AcceptedMessageKeysFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AcceptedMessageKeysFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessageFieldRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AcceptedMessageKeysFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessageFieldRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1677,62 +4041,45 @@ func (m *AcceptedMessageKeysFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuthz(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Equals", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAuthz
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if byteLen < 0 {
+				return ErrInvalidLengthAuthz
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *AcceptedMessagesFilter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuthz
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Equals = append(m.Equals[:0], dAtA[iNdEx:postIndex]...)
+			if m.Equals == nil {
+				m.Equals = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AcceptedMessagesFilter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AcceptedMessagesFilter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1759,8 +4106,40 @@ func (m *AcceptedMessagesFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, make([]byte, postIndex-iNdEx))
-			copy(m.Messages[len(m.Messages)-1], dAtA[iNdEx:postIndex])
+			m.In = append(m.In, make([]byte, postIndex-iNdEx))
+			copy(m.In[len(m.In)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LTE", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LTE = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26,10 +26,16 @@ const (
 // AuthorizationPolicy is an abstract authorization ruleset defined as an extension point that can be customized by
 // chains
 type AuthorizationPolicy interface {
-	CanCreateCode(chainConfigs ChainAccessConfigs, actor types.AccAddress, contractConfig AccessConfig) bool
-	CanInstantiateContract(c AccessConfig, actor types.AccAddress) bool
+	CanCreateCode(ctx types.Context, chainConfigs ChainAccessConfigs, actor types.AccAddress, contractConfig AccessConfig) bool
+	CanInstantiateContract(ctx types.Context, c AccessConfig, actor types.AccAddress) bool
 	CanModifyContract(admin, actor types.AccAddress) bool
 	CanModifyCodeAccessConfig(creator, actor types.AccAddress, isSubset bool) bool
+	// IsFeeExempt returns true when no upload or instantiate fees are charged
+	IsFeeExempt() bool
+	// IsUploadQuotaExempt returns true when the code uploads of the actor are not limited by the quota
+	IsUploadQuotaExempt(quota UploadQuotaParams, actor types.AccAddress) bool
+	// MaxWasmSize returns the size limit for code uploads
+	MaxWasmSize(limits SizeLimitParams) uint64
 	// SubMessageAuthorizationPolicy returns authorization policy to be used for submessages. Must never be nil
 	SubMessageAuthorizationPolicy(entrypoint AuthorizationPolicyAction) AuthorizationPolicy
 }
@@ -1,14 +1,17 @@
 package types
 
 import (
+	"context"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	errorsmod "cosmossdk.io/errors"
 
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
@@ -73,6 +76,60 @@ func TestContractAuthzFilterValidate(t *testing.T) {
 		"allow all message - always valid": {
 			src: NewAllowAllMessagesFilter(),
 		},
+		"allow fields - equals": {
+			src: NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/transfer/recipient", Equals: []byte(`"foo"`)}),
+		},
+		"allow fields - in": {
+			src: NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/transfer/recipient", In: []RawContractMessage{[]byte(`"foo"`), []byte(`"bar"`)}}),
+		},
+		"allow fields - lte": {
+			src: NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/transfer/amount", LTE: "100"}),
+		},
+		"allow fields - multiple rules": {
+			src: NewAcceptedMessageFieldsFilter(
+				MessageFieldRule{Path: "/transfer/recipient", Equals: []byte(`"foo"`)},
+				MessageFieldRule{Path: "/transfer/amount", LTE: "100.5"},
+			),
+		},
+		"allow fields - whole document": {
+			src: NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "", Equals: []byte(`{}`)}),
+		},
+		"allow fields - empty": {
+			src:    NewAcceptedMessageFieldsFilter(),
+			expErr: true,
+		},
+		"allow fields - no comparison": {
+			src:    NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/transfer"}),
+			expErr: true,
+		},
+		"allow fields - multiple comparisons": {
+			src:    NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/transfer/amount", Equals: []byte(`1`), LTE: "100"}),
+			expErr: true,
+		},
+		"allow fields - invalid path": {
+			src:    NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "transfer", Equals: []byte(`1`)}),
+			expErr: true,
+		},
+		"allow fields - invalid path escape": {
+			src:    NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/tr~ansfer", Equals: []byte(`1`)}),
+			expErr: true,
+		},
+		"allow fields - non json equals": {
+			src:    NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/transfer", Equals: []byte(`foo`)}),
+			expErr: true,
+		},
+		"allow fields - non json in": {
+			src:    NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/transfer", In: []RawContractMessage{[]byte(`foo`)}}),
+			expErr: true,
+		},
+		"allow fields - duplicate in": {
+			src:    NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/transfer", In: []RawContractMessage{[]byte(`1`), []byte(`1`)}}),
+			expErr: true,
+		},
+		"allow fields - invalid lte": {
+			src:    NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/transfer", LTE: "one"}),
+			expErr: true,
+		},
 		"undefined - always invalid": {
 			src:    &UndefinedFilter{},
 			expErr: true,
@@ -167,6 +224,88 @@ func TestContractAuthzFilterAccept(t *testing.T) {
 			src:    []byte(`not json`),
 			expErr: true,
 		},
+		"allow fields - equals": {
+			filter:         NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/transfer/recipient", Equals: []byte(`"foo"`)}),
+			src:            []byte(`{"transfer":{"recipient":"foo","amount":"1"}}`),
+			exp:            true,
+			expGasConsumed: sdk.Gas(len(`{"transfer":{"recipient":"foo","amount":"1"}}`)),
+		},
+		"allow fields - not equal": {
+			filter:         NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/transfer/recipient", Equals: []byte(`"foo"`)}),
+			src:            []byte(`{"transfer":{"recipient":"bar"}}`),
+			expGasConsumed: sdk.Gas(len(`{"transfer":{"recipient":"bar"}}`)),
+		},
+		"allow fields - equals object in other key order": {
+			filter:         NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/transfer", Equals: []byte(`{"a": 1, "b": [true, null]}`)}),
+			src:            []byte(`{"transfer":{"b":[true,null],"a":1}}`),
+			exp:            true,
+			expGasConsumed: sdk.Gas(len(`{"transfer":{"b":[true,null],"a":1}}`)),
+		},
+		"allow fields - string not equal to number": {
+			filter:         NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/amount", Equals: []byte(`1`)}),
+			src:            []byte(`{"amount":"1"}`),
+			expGasConsumed: sdk.Gas(len(`{"amount":"1"}`)),
+		},
+		"allow fields - missing field": {
+			filter:         NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/transfer/recipient", Equals: []byte(`"foo"`)}),
+			src:            []byte(`{"transfer":{}}`),
+			expGasConsumed: sdk.Gas(len(`{"transfer":{}}`)),
+		},
+		"allow fields - in set": {
+			filter:         NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/transfer/recipient", In: []RawContractMessage{[]byte(`"foo"`), []byte(`"bar"`)}}),
+			src:            []byte(`{"transfer":{"recipient":"bar"}}`),
+			exp:            true,
+			expGasConsumed: sdk.Gas(len(`{"transfer":{"recipient":"bar"}}`)),
+		},
+		"allow fields - not in set": {
+			filter:         NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/transfer/recipient", In: []RawContractMessage{[]byte(`"foo"`), []byte(`"bar"`)}}),
+			src:            []byte(`{"transfer":{"recipient":"other"}}`),
+			expGasConsumed: sdk.Gas(len(`{"transfer":{"recipient":"other"}}`)),
+		},
+		"allow fields - lte with string amount": {
+			filter:         NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/transfer/amount", LTE: "100"}),
+			src:            []byte(`{"transfer":{"amount":"100"}}`),
+			exp:            true,
+			expGasConsumed: sdk.Gas(len(`{"transfer":{"amount":"100"}}`)),
+		},
+		"allow fields - lte with number": {
+			filter:         NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/transfer/amount", LTE: "100"}),
+			src:            []byte(`{"transfer":{"amount":99.5}}`),
+			exp:            true,
+			expGasConsumed: sdk.Gas(len(`{"transfer":{"amount":99.5}}`)),
+		},
+		"allow fields - lte exceeded": {
+			filter:         NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/transfer/amount", LTE: "100"}),
+			src:            []byte(`{"transfer":{"amount":"340282366920938463463374607431768211455"}}`),
+			expGasConsumed: sdk.Gas(len(`{"transfer":{"amount":"340282366920938463463374607431768211455"}}`)),
+		},
+		"allow fields - lte not a number": {
+			filter:         NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/transfer/amount", LTE: "100"}),
+			src:            []byte(`{"transfer":{"amount":"foo"}}`),
+			expGasConsumed: sdk.Gas(len(`{"transfer":{"amount":"foo"}}`)),
+		},
+		"allow fields - all rules match": {
+			filter: NewAcceptedMessageFieldsFilter(
+				MessageFieldRule{Path: "/transfer/recipient", Equals: []byte(`"foo"`)},
+				MessageFieldRule{Path: "/transfer/amount", LTE: "100"},
+			),
+			src:            []byte(`{"transfer":{"recipient":"foo","amount":"1"}}`),
+			exp:            true,
+			expGasConsumed: sdk.Gas(len(`{"transfer":{"recipient":"foo","amount":"1"}}`)),
+		},
+		"allow fields - one rule does not match": {
+			filter: NewAcceptedMessageFieldsFilter(
+				MessageFieldRule{Path: "/transfer/recipient", Equals: []byte(`"foo"`)},
+				MessageFieldRule{Path: "/transfer/amount", LTE: "100"},
+			),
+			src:            []byte(`{"transfer":{"recipient":"foo","amount":"101"}}`),
+			expGasConsumed: sdk.Gas(len(`{"transfer":{"recipient":"foo","amount":"101"}}`)),
+		},
+		"allow fields - invalid msg": {
+			filter: NewAcceptedMessageFieldsFilter(MessageFieldRule{Path: "/transfer", Equals: []byte(`1`)}),
+			src:    []byte(`not a json msg`),
+			expErr: true,
+		},
 		"undefined - always errors": {
 			filter: &UndefinedFilter{},
 			src:    []byte(`{"foo":"bar"}`),
@@ -240,6 +379,54 @@ func TestContractAuthzLimitValidate(t *testing.T) {
 			src:    &CombinedLimit{CallsRemaining: 1, Amounts: sdk.Coins{oneToken, oneToken}},
 			expErr: true,
 		},
+		"window": {
+			src: NewWindowLimit(time.Hour, false, 1, oneToken),
+		},
+		"window - without funds": {
+			src: NewWindowLimit(time.Hour, true, 1),
+		},
+		"window - with usage": {
+			src: &WindowLimit{Window: time.Hour, MaxCalls: 1, Usages: []WindowUsage{{Calls: 1}}},
+		},
+		"window - empty window": {
+			src:    NewWindowLimit(0, false, 1),
+			expErr: true,
+		},
+		"window - negative window": {
+			src:    NewWindowLimit(-time.Hour, false, 1),
+			expErr: true,
+		},
+		"window - empty calls": {
+			src:    NewWindowLimit(time.Hour, false, 0, oneToken),
+			expErr: true,
+		},
+		"window - invalid funds": {
+			src:    &WindowLimit{Window: time.Hour, MaxCalls: 1, MaxFunds: sdk.Coins{oneToken, oneToken}},
+			expErr: true,
+		},
+		"window - more usages than calls": {
+			src:    &WindowLimit{Window: time.Hour, MaxCalls: 1, Usages: []WindowUsage{{Calls: 1}, {Calls: 1}}},
+			expErr: true,
+		},
+		"window - usage without calls": {
+			src:    &WindowLimit{Window: time.Hour, MaxCalls: 1, Usages: []WindowUsage{{}}},
+			expErr: true,
+		},
+		"all of": {
+			src: mustAllOfLimits(NewMaxCallsLimit(1), NewWindowLimit(time.Hour, false, 1)),
+		},
+		"all of - empty": {
+			src:    &AllOfLimits{},
+			expErr: true,
+		},
+		"all of - invalid limit": {
+			src:    mustAllOfLimits(NewMaxCallsLimit(1), NewMaxCallsLimit(0)),
+			expErr: true,
+		},
+		"all of - nested": {
+			src:    mustAllOfLimits(NewMaxCallsLimit(1), mustAllOfLimits(NewMaxCallsLimit(1))),
+			expErr: true,
+		},
 		"undefined": {
 			src:    &UndefinedLimit{},
 			expErr: true,
@@ -390,6 +577,169 @@ func TestContractAuthzLimitAccept(t *testing.T) {
 	}
 }
 
+func TestWindowLimitAccept(t *testing.T) {
+	oneToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())
+	twoTokens := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2))
+	otherToken := sdk.NewCoin("other", sdk.OneInt())
+	dayStart := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	now := dayStart.Add(10 * time.Hour)
+
+	specs := map[string]struct {
+		limit WindowLimit
+		funds sdk.Coins
+		exp   *ContractAuthzLimitAcceptResult
+	}{
+		"fixed - first call": {
+			limit: *NewWindowLimit(24*time.Hour, false, 2, oneToken),
+			funds: sdk.NewCoins(oneToken),
+			exp: &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: &WindowLimit{
+				Window: 24 * time.Hour, MaxCalls: 2, MaxFunds: sdk.NewCoins(oneToken),
+				Usages: []WindowUsage{{Time: dayStart, Calls: 1, Funds: sdk.NewCoins(oneToken)}},
+			}},
+		},
+		"fixed - usage aggregated in window": {
+			limit: WindowLimit{
+				Window: 24 * time.Hour, MaxCalls: 3, MaxFunds: sdk.NewCoins(twoTokens),
+				Usages: []WindowUsage{{Time: dayStart, Calls: 1, Funds: sdk.NewCoins(oneToken)}},
+			},
+			funds: sdk.NewCoins(oneToken),
+			exp: &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: &WindowLimit{
+				Window: 24 * time.Hour, MaxCalls: 3, MaxFunds: sdk.NewCoins(twoTokens),
+				Usages: []WindowUsage{{Time: dayStart, Calls: 2, Funds: sdk.NewCoins(twoTokens)}},
+			}},
+		},
+		"fixed - calls exceeded": {
+			limit: WindowLimit{
+				Window: 24 * time.Hour, MaxCalls: 1,
+				Usages: []WindowUsage{{Time: dayStart, Calls: 1}},
+			},
+			exp: &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"fixed - funds exceeded": {
+			limit: WindowLimit{
+				Window: 24 * time.Hour, MaxCalls: 2, MaxFunds: sdk.NewCoins(oneToken),
+				Usages: []WindowUsage{{Time: dayStart, Calls: 1, Funds: sdk.NewCoins(oneToken)}},
+			},
+			funds: sdk.NewCoins(oneToken),
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"fixed - usage of previous window dropped": {
+			limit: WindowLimit{
+				Window: 24 * time.Hour, MaxCalls: 1, MaxFunds: sdk.NewCoins(oneToken),
+				Usages: []WindowUsage{{Time: dayStart.Add(-24 * time.Hour), Calls: 1, Funds: sdk.NewCoins(oneToken)}},
+			},
+			funds: sdk.NewCoins(oneToken),
+			exp: &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: &WindowLimit{
+				Window: 24 * time.Hour, MaxCalls: 1, MaxFunds: sdk.NewCoins(oneToken),
+				Usages: []WindowUsage{{Time: dayStart, Calls: 1, Funds: sdk.NewCoins(oneToken)}},
+			}},
+		},
+		"rolling - usage within window": {
+			limit: WindowLimit{
+				Window: 24 * time.Hour, Rolling: true, MaxCalls: 2,
+				Usages: []WindowUsage{{Time: now.Add(-24*time.Hour + 1), Calls: 1}},
+			},
+			exp: &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: &WindowLimit{
+				Window: 24 * time.Hour, Rolling: true, MaxCalls: 2,
+				Usages: []WindowUsage{{Time: now.Add(-24*time.Hour + 1), Calls: 1}, {Time: now, Calls: 1}},
+			}},
+		},
+		"rolling - calls exceeded": {
+			limit: WindowLimit{
+				Window: 24 * time.Hour, Rolling: true, MaxCalls: 1,
+				Usages: []WindowUsage{{Time: now.Add(-24*time.Hour + 1), Calls: 1}},
+			},
+			exp: &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"rolling - usage out of window dropped": {
+			limit: WindowLimit{
+				Window: 24 * time.Hour, Rolling: true, MaxCalls: 1,
+				Usages: []WindowUsage{{Time: now.Add(-24 * time.Hour), Calls: 1}},
+			},
+			exp: &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: &WindowLimit{
+				Window: 24 * time.Hour, Rolling: true, MaxCalls: 1,
+				Usages: []WindowUsage{{Time: now, Calls: 1}},
+			}},
+		},
+		"no funds allowed": {
+			limit: *NewWindowLimit(24*time.Hour, false, 1),
+			funds: sdk.NewCoins(oneToken),
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"unknown token": {
+			limit: *NewWindowLimit(24*time.Hour, false, 1, oneToken),
+			funds: sdk.NewCoins(otherToken),
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithBlockTime(now)
+			gotResult, gotErr := spec.limit.Accept(ctx, &MsgExecuteContract{Funds: spec.funds})
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResult)
+		})
+	}
+}
+
+func TestAllOfLimitsAccept(t *testing.T) {
+	oneToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())
+	now := time.Date(2023, 8, 1, 10, 0, 0, 0, time.UTC)
+	window := func(calls uint64, usages ...WindowUsage) *WindowLimit {
+		return &WindowLimit{Window: time.Hour, Rolling: true, MaxCalls: calls, MaxFunds: sdk.NewCoins(oneToken), Usages: usages}
+	}
+	specs := map[string]struct {
+		limit  *AllOfLimits
+		funds  sdk.Coins
+		exp    *ContractAuthzLimitAcceptResult
+		expErr bool
+	}{
+		"all updated": {
+			limit: mustAllOfLimits(NewMaxCallsLimit(2), window(1)),
+			exp: &ContractAuthzLimitAcceptResult{
+				Accepted:    true,
+				UpdateLimit: mustAllOfLimits(NewMaxCallsLimit(1), window(1, WindowUsage{Time: now, Calls: 1})),
+			},
+		},
+		"one updated": {
+			limit: mustAllOfLimits(NewMaxFundsLimit(oneToken), window(1)),
+			exp: &ContractAuthzLimitAcceptResult{
+				Accepted:    true,
+				UpdateLimit: mustAllOfLimits(NewMaxFundsLimit(oneToken), window(1, WindowUsage{Time: now, Calls: 1})),
+			},
+		},
+		"one used up": {
+			limit: mustAllOfLimits(NewMaxCallsLimit(1), window(2)),
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, DeleteLimit: true},
+		},
+		"one not accepted": {
+			limit: mustAllOfLimits(NewMaxCallsLimit(2), window(1, WindowUsage{Time: now, Calls: 1})),
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"one not accepted - funds": {
+			limit: mustAllOfLimits(NewMaxCallsLimit(2), window(1)),
+			funds: sdk.NewCoins(oneToken),
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"undefined limit": {
+			limit:  &AllOfLimits{Limits: []*cdctypes.Any{nil}},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithBlockTime(now)
+			gotResult, gotErr := spec.limit.Accept(ctx, &MsgExecuteContract{Funds: spec.funds})
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResult)
+		})
+	}
+}
+
 func TestValidateContractGrant(t *testing.T) {
 	specs := map[string]struct {
 		setup  func(t *testing.T) ContractGrant
@@ -461,6 +811,50 @@ func TestValidateContractGrant(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"code id": {
+			setup: func(t *testing.T) ContractGrant {
+				t.Helper()
+				r, err := NewCodeIDContractGrant(1, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+				require.NoError(t, err)
+				return *r
+			},
+		},
+		"code hash": {
+			setup: func(t *testing.T) ContractGrant {
+				t.Helper()
+				r, err := NewCodeHashContractGrant(randBytes(32), NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+				require.NoError(t, err)
+				return *r
+			},
+		},
+		"invalid code hash": {
+			setup: func(t *testing.T) ContractGrant {
+				t.Helper()
+				r, err := NewCodeHashContractGrant(randBytes(31), NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+				require.NoError(t, err)
+				return *r
+			},
+			expErr: true,
+		},
+		"contract and code id": {
+			setup: func(t *testing.T) ContractGrant {
+				t.Helper()
+				r := mustGrant(randBytes(ContractAddrLen), NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+				r.CodeID = 1
+				return r
+			},
+			expErr: true,
+		},
+		"code id and code hash": {
+			setup: func(t *testing.T) ContractGrant {
+				t.Helper()
+				r, err := NewCodeIDContractGrant(1, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+				require.NoError(t, err)
+				r.CodeHash = randBytes(32)
+				return *r
+			},
+			expErr: true,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -537,6 +931,123 @@ func TestValidateContractAuthorization(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"contract update admin": {
+			setup: func(t *testing.T) validatable {
+				t.Helper()
+				return NewContractUpdateAdminAuthorization(nil, *validGrant)
+			},
+		},
+		"contract update admin - invalid grant": {
+			setup: func(t *testing.T) validatable {
+				t.Helper()
+				return NewContractUpdateAdminAuthorization(nil, *validGrant, *invalidGrant)
+			},
+			expErr: true,
+		},
+		"contract update admin - empty grants": {
+			setup: func(t *testing.T) validatable {
+				t.Helper()
+				return NewContractUpdateAdminAuthorization(nil)
+			},
+			expErr: true,
+		},
+		"contract update admin - allowed new admins": {
+			setup: func(t *testing.T) validatable {
+				t.Helper()
+				return NewContractUpdateAdminAuthorization([]string{sdk.AccAddress(randBytes(SDKAddrLen)).String()}, *validGrant)
+			},
+		},
+		"contract update admin - invalid allowed new admin": {
+			setup: func(t *testing.T) validatable {
+				t.Helper()
+				return NewContractUpdateAdminAuthorization([]string{"invalid"}, *validGrant)
+			},
+			expErr: true,
+		},
+		"contract update admin - duplicate allowed new admins": {
+			setup: func(t *testing.T) validatable {
+				t.Helper()
+				addr := sdk.AccAddress(randBytes(SDKAddrLen)).String()
+				return NewContractUpdateAdminAuthorization([]string{addr, addr}, *validGrant)
+			},
+			expErr: true,
+		},
+		"contract update admin - with filter": {
+			setup: func(t *testing.T) validatable {
+				t.Helper()
+				return NewContractUpdateAdminAuthorization(nil, mustGrant(randBytes(SDKAddrLen), NewMaxCallsLimit(1), NewAcceptedMessageKeysFilter("foo")))
+			},
+			expErr: true,
+		},
+		"contract clear admin": {
+			setup: func(t *testing.T) validatable {
+				t.Helper()
+				return NewContractClearAdminAuthorization(*validGrant)
+			},
+		},
+		"contract clear admin - invalid grant": {
+			setup: func(t *testing.T) validatable {
+				t.Helper()
+				return NewContractClearAdminAuthorization(*validGrant, *invalidGrant)
+			},
+			expErr: true,
+		},
+		"contract clear admin - empty grants": {
+			setup: func(t *testing.T) validatable {
+				t.Helper()
+				return NewContractClearAdminAuthorization()
+			},
+			expErr: true,
+		},
+		"contract clear admin - with filter": {
+			setup: func(t *testing.T) validatable {
+				t.Helper()
+				return NewContractClearAdminAuthorization(mustGrant(randBytes(SDKAddrLen), NewMaxCallsLimit(1), NewAcceptedMessageKeysFilter("foo")))
+			},
+			expErr: true,
+		},
+		"contract instantiation": {
+			setup: func(t *testing.T) validatable {
+				t.Helper()
+				return NewContractInstantiationAuthorization(false, mustCodeGrant(1, NewMaxCallsLimit(1), NewAllowAllMessagesFilter(), nil))
+			},
+		},
+		"contract instantiation - with constraints": {
+			setup: func(t *testing.T) validatable {
+				t.Helper()
+				return NewContractInstantiationAuthorization(true, mustCodeGrant(1, NewMaxCallsLimit(1), NewAllowAllMessagesFilter(), &InstantiateConstraints{NoAdmin: true}))
+			},
+		},
+		"contract instantiation - invalid constraints": {
+			setup: func(t *testing.T) validatable {
+				t.Helper()
+				return NewContractInstantiationAuthorization(false, mustCodeGrant(1, NewMaxCallsLimit(1), NewAllowAllMessagesFilter(), &InstantiateConstraints{Admin: "invalid"}))
+			},
+			expErr: true,
+		},
+		"contract instantiation - empty code id": {
+			setup: func(t *testing.T) validatable {
+				t.Helper()
+				return NewContractInstantiationAuthorization(false, mustCodeGrant(0, NewMaxCallsLimit(1), NewAllowAllMessagesFilter(), nil))
+			},
+			expErr: true,
+		},
+		"contract instantiation - undefined limit": {
+			setup: func(t *testing.T) validatable {
+				t.Helper()
+				g := mustCodeGrant(1, NewMaxCallsLimit(1), NewAllowAllMessagesFilter(), nil)
+				g.Limit = nil
+				return NewContractInstantiationAuthorization(false, g)
+			},
+			expErr: true,
+		},
+		"contract instantiation - empty grants": {
+			setup: func(t *testing.T) validatable {
+				t.Helper()
+				return NewContractInstantiationAuthorization(false)
+			},
+			expErr: true,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -737,6 +1248,427 @@ func TestAcceptGrantedMessage(t *testing.T) {
 	}
 }
 
+func TestAcceptGrantedAdminMessage(t *testing.T) {
+	myContractAddr := sdk.AccAddress(randBytes(SDKAddrLen))
+	myAdmin, otherAdmin := sdk.AccAddress(randBytes(SDKAddrLen)), sdk.AccAddress(randBytes(SDKAddrLen))
+	allowedAdmins := []string{myAdmin.String()}
+	specs := map[string]struct {
+		auth      authztypes.Authorization
+		msg       sdk.Msg
+		expResult authztypes.AcceptResponse
+		expErr    *errorsmod.Error
+	}{
+		"update admin - accepted and updated": {
+			auth: NewContractUpdateAdminAuthorization(allowedAdmins, mustGrant(myContractAddr, NewMaxCallsLimit(2), NewAllowAllMessagesFilter())),
+			msg: &MsgUpdateAdmin{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				NewAdmin: myAdmin.String(),
+				Contract: myContractAddr.String(),
+			},
+			expResult: authztypes.AcceptResponse{
+				Accept:  true,
+				Updated: NewContractUpdateAdminAuthorization(allowedAdmins, mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			},
+		},
+		"update admin - any new admin allowed": {
+			auth: NewContractUpdateAdminAuthorization(nil, mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgUpdateAdmin{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				NewAdmin: otherAdmin.String(),
+				Contract: myContractAddr.String(),
+			},
+			expResult: authztypes.AcceptResponse{Accept: true, Delete: true},
+		},
+		"update admin - not accepted for other admin": {
+			auth: NewContractUpdateAdminAuthorization(allowedAdmins, mustGrant(myContractAddr, NewMaxCallsLimit(2), NewAllowAllMessagesFilter())),
+			msg: &MsgUpdateAdmin{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				NewAdmin: otherAdmin.String(),
+				Contract: myContractAddr.String(),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"update admin - not accepted for other contract": {
+			auth: NewContractUpdateAdminAuthorization(nil, mustGrant(myContractAddr, NewMaxCallsLimit(2), NewAllowAllMessagesFilter())),
+			msg: &MsgUpdateAdmin{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				NewAdmin: myAdmin.String(),
+				Contract: sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"update admin - max funds limit": {
+			auth: NewContractUpdateAdminAuthorization(nil, mustGrant(myContractAddr, NewMaxFundsLimit(sdk.NewInt64Coin("denom", 1)), NewAllowAllMessagesFilter())),
+			msg: &MsgUpdateAdmin{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				NewAdmin: myAdmin.String(),
+				Contract: myContractAddr.String(),
+			},
+			expResult: authztypes.AcceptResponse{Accept: true},
+		},
+		"update admin - invalid msg": {
+			auth: NewContractUpdateAdminAuthorization(nil, mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgUpdateAdmin{
+				Sender:   myAdmin.String(),
+				NewAdmin: myAdmin.String(),
+				Contract: myContractAddr.String(),
+			},
+			expErr: ErrInvalid,
+		},
+		"clear admin - accepted and removed": {
+			auth: NewContractClearAdminAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgClearAdmin{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+			},
+			expResult: authztypes.AcceptResponse{Accept: true, Delete: true},
+		},
+		"clear admin - not accepted for other contract": {
+			auth: NewContractClearAdminAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgClearAdmin{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"clear admin - message type mismatch": {
+			auth: NewContractClearAdminAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgUpdateAdmin{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				NewAdmin: myAdmin.String(),
+				Contract: myContractAddr.String(),
+			},
+			expErr: sdkerrors.ErrInvalidType,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+			gotResult, gotErr := spec.auth.Accept(ctx, spec.msg)
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expResult, gotResult)
+		})
+	}
+}
+
+func TestAcceptGrantedMessageByCode(t *testing.T) {
+	myContractAddr := sdk.AccAddress(randBytes(SDKAddrLen))
+	myCodeHash, otherCodeHash := randBytes(32), randBytes(32)
+	lookup := mockContractCodeLookup{
+		codeHashes: map[uint64][]byte{1: myCodeHash, 2: myCodeHash, 3: otherCodeHash},
+	}
+	historyOf := func(codeIDs ...uint64) []ContractCodeHistoryEntry {
+		r := make([]ContractCodeHistoryEntry, len(codeIDs))
+		for i, id := range codeIDs {
+			r[i] = ContractCodeHistoryEntry{Operation: ContractCodeHistoryOperationTypeMigrate, CodeID: id}
+		}
+		r[0].Operation = ContractCodeHistoryOperationTypeInit
+		return r
+	}
+	mustCodeIDGrant := func(codeID uint64) ContractGrant {
+		g, err := NewCodeIDContractGrant(codeID, NewMaxCallsLimit(2), NewAllowAllMessagesFilter())
+		require.NoError(t, err)
+		return *g
+	}
+	mustCodeHashGrant := func(codeHash []byte) ContractGrant {
+		g, err := NewCodeHashContractGrant(codeHash, NewMaxCallsLimit(2), NewAllowAllMessagesFilter())
+		require.NoError(t, err)
+		return *g
+	}
+	specs := map[string]struct {
+		grant     ContractGrant
+		history   []ContractCodeHistoryEntry
+		noLookup  bool
+		expAccept bool
+		expErr    *errorsmod.Error
+	}{
+		"code id - instantiated": {
+			grant:     mustCodeIDGrant(1),
+			history:   historyOf(1),
+			expAccept: true,
+		},
+		"code id - migrated to same code": {
+			grant:     mustCodeIDGrant(1),
+			history:   historyOf(1, 1),
+			expAccept: true,
+		},
+		"code id - other code": {
+			grant:   mustCodeIDGrant(1),
+			history: historyOf(3),
+		},
+		"code id - migrated away": {
+			grant:   mustCodeIDGrant(1),
+			history: historyOf(1, 3),
+		},
+		"code id - migrated into": {
+			grant:   mustCodeIDGrant(1),
+			history: historyOf(3, 1),
+		},
+		"code id - unknown contract": {
+			grant: mustCodeIDGrant(1),
+		},
+		"code id - no lookup in context": {
+			grant:    mustCodeIDGrant(1),
+			history:  historyOf(1),
+			noLookup: true,
+			expErr:   sdkerrors.ErrLogic,
+		},
+		"code hash - instantiated": {
+			grant:     mustCodeHashGrant(myCodeHash),
+			history:   historyOf(1),
+			expAccept: true,
+		},
+		"code hash - migrated to code with same hash": {
+			grant:     mustCodeHashGrant(myCodeHash),
+			history:   historyOf(1, 2),
+			expAccept: true,
+		},
+		"code hash - other hash": {
+			grant:   mustCodeHashGrant(myCodeHash),
+			history: historyOf(3),
+		},
+		"code hash - migrated into": {
+			grant:   mustCodeHashGrant(myCodeHash),
+			history: historyOf(3, 1),
+		},
+		"code hash - unknown code": {
+			grant:   mustCodeHashGrant(myCodeHash),
+			history: historyOf(4),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithContext(context.Background()).WithGasMeter(sdk.NewInfiniteGasMeter())
+			if !spec.noLookup {
+				lookup.histories = map[string][]ContractCodeHistoryEntry{myContractAddr.String(): spec.history}
+				ctx = WithContractCodeLookup(ctx, lookup)
+			}
+			auth := NewContractExecutionAuthorization(spec.grant)
+			gotResult, gotErr := auth.Accept(ctx, &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+			})
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expAccept, gotResult.Accept)
+			if spec.expAccept {
+				exp, err := spec.grant.WithNewLimits(NewMaxCallsLimit(1))
+				require.NoError(t, err)
+				assert.Equal(t, NewContractExecutionAuthorization(*exp), gotResult.Updated)
+			}
+		})
+	}
+}
+
+var _ ContractCodeLookup = mockContractCodeLookup{}
+
+type mockContractCodeLookup struct {
+	histories  map[string][]ContractCodeHistoryEntry
+	codeHashes map[uint64][]byte
+}
+
+func (m mockContractCodeLookup) GetContractHistory(_ sdk.Context, contractAddr sdk.AccAddress) []ContractCodeHistoryEntry {
+	return m.histories[contractAddr.String()]
+}
+
+func (m mockContractCodeLookup) GetCodeInfo(_ sdk.Context, codeID uint64) *CodeInfo {
+	codeHash, ok := m.codeHashes[codeID]
+	if !ok {
+		return nil
+	}
+	return &CodeInfo{CodeHash: codeHash}
+}
+
+func TestAcceptGrantedInstantiateMessage(t *testing.T) {
+	myAdmin := sdk.AccAddress(randBytes(SDKAddrLen))
+	oneToken := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt()))
+	specs := map[string]struct {
+		auth      authztypes.Authorization
+		msg       sdk.Msg
+		expResult authztypes.AcceptResponse
+		expErr    *errorsmod.Error
+	}{
+		"accepted and updated": {
+			auth: NewContractInstantiationAuthorization(false, mustCodeGrant(1, NewMaxCallsLimit(2), NewAllowAllMessagesFilter(), nil)),
+			msg: &MsgInstantiateContract{
+				Sender: sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{
+				Accept:  true,
+				Updated: NewContractInstantiationAuthorization(false, mustCodeGrant(1, NewMaxCallsLimit(1), NewAllowAllMessagesFilter(), nil)),
+			},
+		},
+		"accepted and removed": {
+			auth: NewContractInstantiationAuthorization(false, mustCodeGrant(1, NewMaxCallsLimit(1), NewAllowAllMessagesFilter(), nil)),
+			msg: &MsgInstantiateContract{
+				Sender: sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{Accept: true, Delete: true},
+		},
+		"accepted and updated - instantiate2": {
+			auth: NewContractInstantiationAuthorization(true, mustCodeGrant(1, NewMaxFundsLimit(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2))), NewAllowAllMessagesFilter(), nil)),
+			msg: &MsgInstantiateContract2{
+				Sender: sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+				Funds:  oneToken,
+				Salt:   []byte("salt"),
+			},
+			expResult: authztypes.AcceptResponse{
+				Accept:  true,
+				Updated: NewContractInstantiationAuthorization(true, mustCodeGrant(1, NewMaxFundsLimit(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())), NewAllowAllMessagesFilter(), nil)),
+			},
+		},
+		"accepted - admin constraint": {
+			auth: NewContractInstantiationAuthorization(false, mustCodeGrant(1, NewMaxFundsLimit(oneToken...), NewAllowAllMessagesFilter(), &InstantiateConstraints{Admin: myAdmin.String()})),
+			msg: &MsgInstantiateContract{
+				Sender: sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Admin:  myAdmin.String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{Accept: true},
+		},
+		"accepted - second grant for code": {
+			auth: NewContractInstantiationAuthorization(false,
+				mustCodeGrant(1, NewMaxCallsLimit(1), NewAllowAllMessagesFilter(), &InstantiateConstraints{NoAdmin: true}),
+				mustCodeGrant(1, NewMaxCallsLimit(1), NewAllowAllMessagesFilter(), &InstantiateConstraints{Admin: myAdmin.String()}),
+			),
+			msg: &MsgInstantiateContract{
+				Sender: sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Admin:  myAdmin.String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{
+				Accept:  true,
+				Updated: NewContractInstantiationAuthorization(false, mustCodeGrant(1, NewMaxCallsLimit(1), NewAllowAllMessagesFilter(), &InstantiateConstraints{NoAdmin: true})),
+			},
+		},
+		"not accepted - other admin": {
+			auth: NewContractInstantiationAuthorization(false, mustCodeGrant(1, NewMaxCallsLimit(1), NewAllowAllMessagesFilter(), &InstantiateConstraints{Admin: myAdmin.String()})),
+			msg: &MsgInstantiateContract{
+				Sender: sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Admin:  sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"not accepted - no admin set": {
+			auth: NewContractInstantiationAuthorization(false, mustCodeGrant(1, NewMaxCallsLimit(1), NewAllowAllMessagesFilter(), &InstantiateConstraints{Admin: myAdmin.String()})),
+			msg: &MsgInstantiateContract{
+				Sender: sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"not accepted - funds exceed limit": {
+			auth: NewContractInstantiationAuthorization(false, mustCodeGrant(1, NewMaxCallsLimit(1), NewAllowAllMessagesFilter(), nil)),
+			msg: &MsgInstantiateContract{
+				Sender: sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+				Funds:  oneToken,
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"not accepted - other code id": {
+			auth: NewContractInstantiationAuthorization(false, mustCodeGrant(1, NewMaxCallsLimit(1), NewAllowAllMessagesFilter(), nil)),
+			msg: &MsgInstantiateContract{
+				Sender: sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				CodeID: 2,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"not accepted - filter rejects": {
+			auth: NewContractInstantiationAuthorization(false, mustCodeGrant(1, NewMaxCallsLimit(1), NewAcceptedMessageKeysFilter("other"), nil)),
+			msg: &MsgInstantiateContract{
+				Sender: sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"error - message type mismatch": {
+			auth: NewContractInstantiationAuthorization(true, mustCodeGrant(1, NewMaxCallsLimit(1), NewAllowAllMessagesFilter(), nil)),
+			msg: &MsgInstantiateContract{
+				Sender: sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+			},
+			expErr: sdkerrors.ErrInvalidType,
+		},
+		"error - invalid msg": {
+			auth: NewContractInstantiationAuthorization(false, mustCodeGrant(1, NewMaxCallsLimit(1), NewAllowAllMessagesFilter(), nil)),
+			msg: &MsgInstantiateContract{
+				Sender: sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				CodeID: 1,
+				Msg:    []byte(`{"foo":"bar"}`),
+			},
+			expErr: ErrEmpty,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+			gotResult, gotErr := spec.auth.Accept(ctx, spec.msg)
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expResult, gotResult)
+		})
+	}
+}
+
+func TestContractInstantiationAuthorizationMsgTypeURL(t *testing.T) {
+	assert.Equal(t, "/cosmwasm.wasm.v1.MsgInstantiateContract", NewContractInstantiationAuthorization(false).MsgTypeURL())
+	assert.Equal(t, "/cosmwasm.wasm.v1.MsgInstantiateContract2", NewContractInstantiationAuthorization(true).MsgTypeURL())
+}
+
+func mustAllOfLimits(limits ...ContractAuthzLimitX) *AllOfLimits {
+	l, err := NewAllOfLimits(limits...)
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+func mustCodeGrant(codeID uint64, limit ContractAuthzLimitX, filter ContractAuthzFilterX, constraints *InstantiateConstraints) CodeGrant {
+	g, err := NewCodeGrant(codeID, limit, filter, constraints)
+	if err != nil {
+		panic(err)
+	}
+	return *g
+}
+
 func mustGrant(contract sdk.AccAddress, limit ContractAuthzLimitX, filter ContractAuthzFilterX) ContractGrant {
 	g, err := NewContractGrant(contract, limit, filter)
 	if err != nil {
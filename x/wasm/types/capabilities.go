@@ -0,0 +1,58 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ValidateBasic performs basic validation
+func (p CapabilityParams) ValidateBasic() error {
+	idx := make(map[string]struct{}, len(p.Enabled))
+	for _, c := range p.Enabled {
+		if c == "" {
+			return errorsmod.Wrap(ErrEmpty, "capability")
+		}
+		if strings.ContainsAny(c, ", \t\n") {
+			return errorsmod.Wrapf(ErrInvalid, "capability: %q", c)
+		}
+		if _, exists := idx[c]; exists {
+			return ErrDuplicate.Wrapf("capability: %s", c)
+		}
+		idx[c] = struct{}{}
+	}
+	return nil
+}
+
+// Missing returns the required capabilities that are not enabled, in the given order
+func (p CapabilityParams) Missing(required []string) []string {
+	var r []string
+	for _, c := range required {
+		if !p.IsEnabled(c) {
+			r = append(r, c)
+		}
+	}
+	return r
+}
+
+// IsEnabled returns true when the capability is enabled
+func (p CapabilityParams) IsEnabled(capability string) bool {
+	for _, c := range p.Enabled {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseCapabilities splits the comma separated capabilities as used by wasmvm.
+// Empty elements are dropped.
+func ParseCapabilities(s string) []string {
+	var r []string
+	for _, c := range strings.Split(s, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			r = append(r, c)
+		}
+	}
+	return r
+}
@@ -50,14 +50,20 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&AllowAllMessagesFilter{}, "wasm/AllowAllMessagesFilter", nil)
 	cdc.RegisterConcrete(&AcceptedMessageKeysFilter{}, "wasm/AcceptedMessageKeysFilter", nil)
 	cdc.RegisterConcrete(&AcceptedMessagesFilter{}, "wasm/AcceptedMessagesFilter", nil)
+	cdc.RegisterConcrete(&AcceptedMessageFieldsFilter{}, "wasm/AcceptedMessageFieldsFilter", nil)
 
 	cdc.RegisterInterface((*ContractAuthzLimitX)(nil), nil)
 	cdc.RegisterConcrete(&MaxCallsLimit{}, "wasm/MaxCallsLimit", nil)
 	cdc.RegisterConcrete(&MaxFundsLimit{}, "wasm/MaxFundsLimit", nil)
 	cdc.RegisterConcrete(&CombinedLimit{}, "wasm/CombinedLimit", nil)
+	cdc.RegisterConcrete(&WindowLimit{}, "wasm/WindowLimit", nil)
+	cdc.RegisterConcrete(&AllOfLimits{}, "wasm/AllOfLimits", nil)
 
 	cdc.RegisterConcrete(&ContractExecutionAuthorization{}, "wasm/ContractExecutionAuthorization", nil)
 	cdc.RegisterConcrete(&ContractMigrationAuthorization{}, "wasm/ContractMigrationAuthorization", nil)
+	cdc.RegisterConcrete(&ContractInstantiationAuthorization{}, "wasm/ContractInstantiationAuthorization", nil)
+	cdc.RegisterConcrete(&ContractUpdateAdminAuthorization{}, "wasm/ContractUpdateAdminAuthorization", nil)
+	cdc.RegisterConcrete(&ContractClearAdminAuthorization{}, "wasm/ContractClearAdminAuthorization", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -105,6 +111,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&AllowAllMessagesFilter{},
 		&AcceptedMessageKeysFilter{},
 		&AcceptedMessagesFilter{},
+		&AcceptedMessageFieldsFilter{},
 	)
 
 	registry.RegisterInterface("cosmwasm.wasm.v1.ContractAuthzLimitX", (*ContractAuthzLimitX)(nil))
@@ -113,12 +120,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MaxCallsLimit{},
 		&MaxFundsLimit{},
 		&CombinedLimit{},
+		&WindowLimit{},
+		&AllOfLimits{},
 	)
 
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
 		&ContractExecutionAuthorization{},
 		&ContractMigrationAuthorization{},
+		&ContractInstantiationAuthorization{},
+		&ContractUpdateAdminAuthorization{},
+		&ContractClearAdminAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -1,6 +1,8 @@
 package types
 
 import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -13,7 +15,15 @@ const (
 	// smart query stack counter to abort query loops
 	contextKeyQueryStackSize contextKey = iota
 	// authorization policy for sub-messages
-	contextKeySubMsgAuthzPolicy = iota
+	contextKeySubMsgAuthzPolicy contextKey = iota
+	// listener for sub-messages returned by contracts
+	contextKeySubMsgListener contextKey = iota
+	// node local gas tracer
+	contextKeyGasTracer contextKey = iota
+	// code id of the contract that is the actor
+	contextKeyCallerCodeID contextKey = iota
+	// contract code details for authz grants
+	contextKeyContractCodeLookup contextKey = iota
 )
 
 // WithTXCounter stores a transaction counter value in the context
@@ -52,3 +62,77 @@ func SubMsgAuthzPolicy(ctx sdk.Context) (AuthorizationPolicy, bool) {
 	val, ok := ctx.Value(contextKeySubMsgAuthzPolicy).(AuthorizationPolicy)
 	return val, ok
 }
+
+// SubMsgListenerFn is notified about the sub-messages returned by a contract before they are dispatched
+type SubMsgListenerFn func(contractAddr sdk.AccAddress, msgs []wasmvmtypes.SubMsg)
+
+// WithSubMsgListener stores a sub-message listener into the context returned
+func WithSubMsgListener(ctx sdk.Context, l SubMsgListenerFn) sdk.Context {
+	if l == nil {
+		panic("listener must not be nil")
+	}
+	return ctx.WithValue(contextKeySubMsgListener, l)
+}
+
+// SubMsgListener reads the sub-message listener from the context
+func SubMsgListener(ctx sdk.Context) (SubMsgListenerFn, bool) {
+	val, ok := ctx.Value(contextKeySubMsgListener).(SubMsgListenerFn)
+	return val, ok
+}
+
+// WithGasTracer stores a gas tracer into the context returned
+func WithGasTracer(ctx sdk.Context, t *GasTracer) sdk.Context {
+	if t == nil {
+		panic("tracer must not be nil")
+	}
+	return ctx.WithValue(contextKeyGasTracer, t)
+}
+
+// GasTracerFromContext reads the gas tracer from the context
+func GasTracerFromContext(ctx sdk.Context) (*GasTracer, bool) {
+	if ctx.Context() == nil { // not initialized
+		return nil, false
+	}
+	val, ok := ctx.Value(contextKeyGasTracer).(*GasTracer)
+	return val, ok
+}
+
+// callerCodeID is the code id of a contract address
+type callerCodeID struct {
+	caller string
+	codeID uint64
+}
+
+// WithCallerCodeID stores the code id of the contract that is the actor of the current operation into the context
+// returned. Used to authorize contracts by their code id.
+func WithCallerCodeID(ctx sdk.Context, caller sdk.AccAddress, codeID uint64) sdk.Context {
+	return ctx.WithValue(contextKeyCallerCodeID, callerCodeID{caller: caller.String(), codeID: codeID})
+}
+
+// CallerCodeID reads the code id of the calling contract from the context. Returns false when not set or for an
+// other actor
+func CallerCodeID(ctx sdk.Context, actor sdk.AccAddress) (uint64, bool) {
+	val, ok := ctx.Value(contextKeyCallerCodeID).(callerCodeID)
+	if !ok || val.caller != actor.String() {
+		return 0, false
+	}
+	return val.codeID, true
+}
+
+// WithContractCodeLookup stores the contract code lookup into the context returned. Used by authz grants that
+// target contracts by code.
+func WithContractCodeLookup(ctx sdk.Context, l ContractCodeLookup) sdk.Context {
+	if l == nil {
+		panic("lookup must not be nil")
+	}
+	return ctx.WithValue(contextKeyContractCodeLookup, l)
+}
+
+// ContractCodeLookupFromContext reads the contract code lookup from the context
+func ContractCodeLookupFromContext(ctx sdk.Context) (ContractCodeLookup, bool) {
+	if ctx.Context() == nil { // not initialized
+		return nil, false
+	}
+	val, ok := ctx.Value(contextKeyContractCodeLookup).(ContractCodeLookup)
+	return val, ok
+}
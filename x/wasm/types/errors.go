@@ -86,6 +86,15 @@ var (
 	ErrNoSuchCodeFn = WasmVMFlavouredErrorFactory(errorsmod.Register(DefaultCodespace, 28, "no such code"),
 		func(id uint64) error { return wasmvmtypes.NoSuchCode{CodeID: id} },
 	)
+
+	// ErrCapabilityDisabled error when a code requires a capability that is not enabled on chain
+	ErrCapabilityDisabled = errorsmod.Register(DefaultCodespace, 29, "capability disabled")
+
+	// ErrQuotaExceeded error when an address exceeds its code upload quota
+	ErrQuotaExceeded = errorsmod.Register(DefaultCodespace, 30, "upload quota exceeded")
+
+	// ErrInstantiateConstraint error when a contract instantiation violates the constraints of the code
+	ErrInstantiateConstraint = errorsmod.Register(DefaultCodespace, 31, "instantiate constraint violated")
 )
 
 // WasmVMErrorable mapped error type in wasmvm and are not redacted
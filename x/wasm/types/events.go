@@ -26,6 +26,8 @@ const (
 	EventTypeUpdateContractAdmin    = "update_contract_admin"
 	EventTypeUpdateCodeAccessConfig = "update_code_access_config"
 	EventTypePacketRecv             = "ibc_packet_received"
+	// EventTypeGasTrace is emitted in tx simulations only when gas tracing is enabled
+	EventTypeGasTrace = "wasm_gas_trace"
 	// add new types to IsAcceptedEventOnRecvPacketErrorAck
 )
 
@@ -63,6 +65,9 @@ const (
 	AttributeKeyNewAdmin            = "new_admin_address"
 	AttributeKeyCodePermission      = "code_permission"
 	AttributeKeyAuthorizedAddresses = "authorized_addresses"
+	AttributeKeyAuthorizedCodeIDs   = "authorized_code_ids"
 	AttributeKeyAckSuccess          = "success"
 	AttributeKeyAckError            = "error"
+	AttributeKeyGasTrace            = "trace"
+	AttributeKeyFee                 = "fee"
 )
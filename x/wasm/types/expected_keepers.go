@@ -54,6 +54,7 @@ type AccountKeeper interface {
 type DistributionKeeper interface {
 	DelegationRewards(ctx context.Context, req *distrtypes.QueryDelegationRewardsRequest) (*distrtypes.QueryDelegationRewardsResponse, error)
 	GetDelegatorWithdrawAddr(ctx sdk.Context, delAddr sdk.AccAddress) sdk.AccAddress
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
 // StakingKeeper defines a subset of methods implemented by the cosmos-sdk staking keeper
@@ -20,10 +20,13 @@ type ViewKeeper interface {
 	IterateContractsByCode(ctx sdk.Context, codeID uint64, cb func(address sdk.AccAddress) bool)
 	IterateContractState(ctx sdk.Context, contractAddress sdk.AccAddress, cb func(key, value []byte) bool)
 	GetCodeInfo(ctx sdk.Context, codeID uint64) *CodeInfo
+	GetCodeAnalysis(ctx sdk.Context, codeID uint64) *CodeAnalysis
 	IterateCodeInfos(ctx sdk.Context, cb func(uint64, CodeInfo) bool)
 	GetByteCode(ctx sdk.Context, codeID uint64) ([]byte, error)
 	IsPinnedCode(ctx sdk.Context, codeID uint64) bool
 	GetParams(ctx sdk.Context) Params
+	// UploadQuota returns the code upload quota usage of the address
+	UploadQuota(ctx sdk.Context, addr sdk.AccAddress) QueryUploadQuotaResponse
 }
 
 // ContractOpsKeeper contains mutable operations on a contract.
@@ -0,0 +1,42 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs basic validation
+func (p FeeParams) ValidateBasic() error {
+	if err := p.UploadFlat.Validate(); err != nil {
+		return errorsmod.Wrap(err, "upload flat")
+	}
+	if err := p.UploadPerByte.Validate(); err != nil {
+		return errorsmod.Wrap(err, "upload per byte")
+	}
+	if err := p.Instantiate.Validate(); err != nil {
+		return errorsmod.Wrap(err, "instantiate")
+	}
+	if _, ok := FeeDestination_name[int32(p.Destination)]; !ok {
+		return errorsmod.Wrapf(ErrInvalid, "destination: %d", p.Destination)
+	}
+	return nil
+}
+
+// UploadFee returns the fee for uploading a code of the given uncompressed size.
+// The per byte amounts are rounded up.
+func (p FeeParams) UploadFee(codeSize int) sdk.Coins {
+	fee := p.UploadFlat
+	for _, c := range p.UploadPerByte.MulDec(sdk.NewDec(int64(codeSize))) {
+		fee = fee.Add(sdk.NewCoin(c.Denom, c.Amount.Ceil().TruncateInt()))
+	}
+	return fee
+}
+
+// FeesOrDefault returns the fee params or empty fees when not set
+func (p Params) FeesOrDefault() FeeParams {
+	if p.Fees == nil {
+		return FeeParams{}
+	}
+	return *p.Fees
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFeeParamsUploadFee(t *testing.T) {
+	specs := map[string]struct {
+		src  FeeParams
+		size int
+		exp  sdk.Coins
+	}{
+		"empty": {
+			size: 100,
+		},
+		"flat only": {
+			src:  FeeParams{UploadFlat: sdk.NewCoins(sdk.NewInt64Coin("alx", 10))},
+			size: 100,
+			exp:  sdk.NewCoins(sdk.NewInt64Coin("alx", 10)),
+		},
+		"per byte only": {
+			src:  FeeParams{UploadPerByte: sdk.NewDecCoins(sdk.NewInt64DecCoin("alx", 2))},
+			size: 100,
+			exp:  sdk.NewCoins(sdk.NewInt64Coin("alx", 200)),
+		},
+		"per byte rounded up": {
+			src:  FeeParams{UploadPerByte: sdk.NewDecCoins(sdk.NewDecCoinFromDec("alx", sdk.NewDecWithPrec(1, 2)))},
+			size: 101,
+			exp:  sdk.NewCoins(sdk.NewInt64Coin("alx", 2)),
+		},
+		"flat and per byte in different denoms": {
+			src: FeeParams{
+				UploadFlat:    sdk.NewCoins(sdk.NewInt64Coin("alx", 10)),
+				UploadPerByte: sdk.NewDecCoins(sdk.NewInt64DecCoin("blx", 1)),
+			},
+			size: 100,
+			exp:  sdk.NewCoins(sdk.NewInt64Coin("alx", 10), sdk.NewInt64Coin("blx", 100)),
+		},
+		"flat and per byte in same denom": {
+			src: FeeParams{
+				UploadFlat:    sdk.NewCoins(sdk.NewInt64Coin("alx", 10)),
+				UploadPerByte: sdk.NewDecCoins(sdk.NewInt64DecCoin("alx", 1)),
+			},
+			size: 100,
+			exp:  sdk.NewCoins(sdk.NewInt64Coin("alx", 110)),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got := spec.src.UploadFee(spec.size)
+			assert.True(t, spec.exp.IsEqual(got), "exp %s got %s", spec.exp, got)
+		})
+	}
+}
+
+func TestFeeParamsValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src    FeeParams
+		expErr bool
+	}{
+		"empty": {},
+		"all set": {
+			src: FeeParams{
+				UploadFlat:    sdk.NewCoins(sdk.NewInt64Coin("alx", 10)),
+				UploadPerByte: sdk.NewDecCoins(sdk.NewInt64DecCoin("alx", 1)),
+				Instantiate:   sdk.NewCoins(sdk.NewInt64Coin("alx", 10)),
+				Destination:   FeeDestinationBurn,
+			},
+		},
+		"invalid upload flat": {
+			src:    FeeParams{UploadFlat: sdk.Coins{sdk.Coin{Denom: "alx", Amount: sdk.ZeroInt()}}},
+			expErr: true,
+		},
+		"invalid upload per byte": {
+			src:    FeeParams{UploadPerByte: sdk.DecCoins{sdk.DecCoin{Denom: "#", Amount: sdk.OneDec()}}},
+			expErr: true,
+		},
+		"invalid instantiate": {
+			src:    FeeParams{Instantiate: sdk.Coins{sdk.NewInt64Coin("blx", 1), sdk.NewInt64Coin("alx", 1)}},
+			expErr: true,
+		},
+		"invalid destination": {
+			src:    FeeParams{Destination: 99},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
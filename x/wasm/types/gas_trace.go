@@ -0,0 +1,119 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GasTraceNode is an operation in the gas trace call tree
+type GasTraceNode struct {
+	// Name of the operation, for example the keeper entrypoint
+	Name string `json:"name"`
+	// Contract address, when the operation is for a contract
+	Contract string `json:"contract,omitempty"`
+	// GasUsed is the sdk gas consumed by the operation including all children
+	GasUsed sdk.Gas `json:"gas_used"`
+	// GasMeterReads is the number of gas meter reads by wasmvm within the operation
+	GasMeterReads uint64 `json:"gas_meter_reads,omitempty"`
+	// LastGasMeterRead is the last value in wasmvm gas read by wasmvm within the operation
+	LastGasMeterRead uint64 `json:"last_gas_meter_read,omitempty"`
+	// Attributes contain additional operation details
+	Attributes map[string]string `json:"attributes,omitempty"`
+	// Children are the nested operations in the order of execution
+	Children []*GasTraceNode `json:"children,omitempty"`
+
+	ctx      sdk.Context
+	gasStart sdk.Gas
+	tracer   *GasTracer
+}
+
+// SetAttribute adds an attribute to the node. Safe to call on a nil node.
+func (n *GasTraceNode) SetAttribute(key, value string) {
+	if n == nil {
+		return
+	}
+	if n.Attributes == nil {
+		n.Attributes = make(map[string]string, 1)
+	}
+	n.Attributes[key] = value
+}
+
+// End closes the operation and sets the gas used. Safe to call on a nil node.
+func (n *GasTraceNode) End() {
+	if n == nil {
+		return
+	}
+	n.GasUsed = n.ctx.GasMeter().GasConsumed() - n.gasStart
+	n.tracer.pop(n)
+}
+
+// GasTraceCompleteFn is called with the root node when a top level operation was completed
+type GasTraceCompleteFn func(ctx sdk.Context, root *GasTraceNode)
+
+// GasTracer records a call tree of the wasm operations with their gas consumption.
+// The tracer is node local and must not modify state or consume gas.
+type GasTracer struct {
+	stack      []*GasTraceNode
+	onComplete GasTraceCompleteFn
+}
+
+// NewGasTracer constructor
+func NewGasTracer(onComplete GasTraceCompleteFn) *GasTracer {
+	if onComplete == nil {
+		panic("callback must not be nil")
+	}
+	return &GasTracer{onComplete: onComplete}
+}
+
+// Start adds a new operation to the tree. The node returned must be closed with `End`.
+func (t *GasTracer) Start(ctx sdk.Context, name string, contractAddr sdk.AccAddress) *GasTraceNode {
+	n := &GasTraceNode{Name: name, ctx: ctx, gasStart: ctx.GasMeter().GasConsumed(), tracer: t}
+	if contractAddr != nil {
+		n.Contract = contractAddr.String()
+	}
+	t.add(n)
+	t.stack = append(t.stack, n)
+	return n
+}
+
+// Record adds a leaf with the given gas to the current operation.
+// Nothing is recorded when there is no operation open.
+func (t *GasTracer) Record(name string, gas sdk.Gas) *GasTraceNode {
+	if len(t.stack) == 0 {
+		return nil
+	}
+	n := &GasTraceNode{Name: name, GasUsed: gas}
+	t.add(n)
+	return n
+}
+
+// RecordGasMeterRead counts a gas meter read by wasmvm for the current operation
+func (t *GasTracer) RecordGasMeterRead(wasmVMGas uint64) {
+	if len(t.stack) == 0 {
+		return
+	}
+	n := t.stack[len(t.stack)-1]
+	n.GasMeterReads++
+	n.LastGasMeterRead = wasmVMGas
+}
+
+func (t *GasTracer) add(n *GasTraceNode) {
+	if len(t.stack) == 0 {
+		return
+	}
+	parent := t.stack[len(t.stack)-1]
+	parent.Children = append(parent.Children, n)
+}
+
+// pop removes the node and all children that were not closed, due to a panic for example, from the stack
+func (t *GasTracer) pop(n *GasTraceNode) {
+	for i := len(t.stack) - 1; i >= 0; i-- {
+		if t.stack[i] != n {
+			continue
+		}
+		t.stack = t.stack[:i]
+		if i == 0 {
+			t.onComplete(n.ctx, n)
+		}
+		return
+	}
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGasTracer(t *testing.T) {
+	var completed []*GasTraceNode
+	tracer := NewGasTracer(func(_ sdk.Context, root *GasTraceNode) {
+		completed = append(completed, root)
+	})
+	ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+	myContract := sdk.AccAddress(randBytes(ContractAddrLen))
+
+	// nothing recorded without open operation
+	assert.Nil(t, tracer.Record("ignored", 1))
+
+	root := tracer.Start(ctx, "execute", myContract)
+	ctx.GasMeter().ConsumeGas(1, "testing")
+	tracer.Record("charge", 2).SetAttribute("foo", "bar")
+	tracer.RecordGasMeterRead(3)
+	tracer.RecordGasMeterRead(4)
+	child := tracer.Start(ctx, "query", nil)
+	ctx.GasMeter().ConsumeGas(5, "testing")
+	child.End()
+	// not closed due to a panic, for example
+	tracer.Start(ctx, "reply", myContract)
+	ctx.GasMeter().ConsumeGas(6, "testing")
+	require.Empty(t, completed)
+	root.End()
+
+	// then
+	require.Len(t, completed, 1)
+	exp := &GasTraceNode{
+		Name:             "execute",
+		Contract:         myContract.String(),
+		GasUsed:          12,
+		GasMeterReads:    2,
+		LastGasMeterRead: 4,
+		Children: []*GasTraceNode{
+			{Name: "charge", GasUsed: 2, Attributes: map[string]string{"foo": "bar"}},
+			{Name: "query", GasUsed: 5},
+			{Name: "reply", Contract: myContract.String()},
+		},
+	}
+	assert.Equal(t, exp.Name, completed[0].Name)
+	assert.Equal(t, exp.Contract, completed[0].Contract)
+	assert.Equal(t, exp.GasUsed, completed[0].GasUsed)
+	assert.Equal(t, exp.GasMeterReads, completed[0].GasMeterReads)
+	assert.Equal(t, exp.LastGasMeterRead, completed[0].LastGasMeterRead)
+	require.Len(t, completed[0].Children, len(exp.Children))
+	for i, v := range exp.Children {
+		got := completed[0].Children[i]
+		assert.Equal(t, v.Name, got.Name)
+		assert.Equal(t, v.Contract, got.Contract)
+		assert.Equal(t, v.GasUsed, got.GasUsed)
+		assert.Equal(t, v.Attributes, got.Attributes)
+	}
+
+	// and next operation starts a new tree
+	tracer.Start(ctx, "sudo", myContract).End()
+	require.Len(t, completed, 2)
+	assert.Equal(t, "sudo", completed[1].Name)
+}
+
+func TestGasTraceNodeNilSafe(t *testing.T) {
+	var n *GasTraceNode
+	assert.NotPanics(t, func() {
+		n.SetAttribute("foo", "bar")
+		n.End()
+	})
+}
@@ -18,21 +18,42 @@ func (s GenesisState) ValidateBasic() error {
 	if err := s.Params.ValidateBasic(); err != nil {
 		return errorsmod.Wrap(err, "params")
 	}
+	limits := s.Params.SizeLimitsOrDefault()
+	codeIDs := make(map[uint64]struct{}, len(s.Codes))
 	for i := range s.Codes {
 		if err := s.Codes[i].ValidateBasic(); err != nil {
 			return errorsmod.Wrapf(err, "code: %d", i)
 		}
+		if err := limits.ValidateProposalWasmSize(s.Codes[i].CodeBytes); err != nil {
+			return errorsmod.Wrapf(err, "code: %d: code bytes", i)
+		}
+		codeIDs[s.Codes[i].CodeID] = struct{}{}
+	}
+	for i := range s.Codes {
+		for _, id := range s.Codes[i].CodeInfo.InstantiateConfig.CodeIDs {
+			if _, ok := codeIDs[id]; !ok {
+				return errorsmod.Wrapf(ErrNotFound, "code: %d: instantiate config: code id %d", i, id)
+			}
+		}
 	}
 	for i := range s.Contracts {
 		if err := s.Contracts[i].ValidateBasic(); err != nil {
 			return errorsmod.Wrapf(err, "contract: %d", i)
 		}
+		if err := limits.ValidateLabelSize(s.Contracts[i].ContractInfo.Label); err != nil {
+			return errorsmod.Wrapf(err, "contract: %d: contract info: label", i)
+		}
 	}
 	for i := range s.Sequences {
 		if err := s.Sequences[i].ValidateBasic(); err != nil {
 			return errorsmod.Wrapf(err, "sequence: %d", i)
 		}
 	}
+	for i := range s.UploadRecords {
+		if err := s.UploadRecords[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "upload record: %d", i)
+		}
+	}
 
 	return nil
 }
@@ -44,7 +65,7 @@ func (c Code) ValidateBasic() error {
 	if err := c.CodeInfo.ValidateBasic(); err != nil {
 		return errorsmod.Wrap(err, "code info")
 	}
-	if err := validateWasmCode(c.CodeBytes, MaxProposalWasmSize); err != nil {
+	if err := validateWasmCode(c.CodeBytes); err != nil {
 		return errorsmod.Wrap(err, "code bytes")
 	}
 	return nil
@@ -77,6 +98,19 @@ func (c Contract) ValidateBasic() error {
 	return nil
 }
 
+func (r UploadRecord) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(r.Creator); err != nil {
+		return errorsmod.Wrap(err, "creator")
+	}
+	if r.UploadTime < 0 {
+		return errorsmod.Wrap(ErrInvalid, "upload time must not be negative")
+	}
+	if r.CodeID == 0 {
+		return errorsmod.Wrap(ErrEmpty, "code id")
+	}
+	return nil
+}
+
 // ValidateGenesis performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
@@ -33,6 +33,8 @@ type GenesisState struct {
 	Codes     []Code     `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
 	Contracts []Contract `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
 	Sequences []Sequence `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
+	// UploadRecords are the code uploads that count against the upload quota
+	UploadRecords []UploadRecord `protobuf:"bytes,5,rep,name=upload_records,json=uploadRecords,proto3" json:"upload_records,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -101,6 +103,13 @@ func (m *GenesisState) GetSequences() []Sequence {
 	return nil
 }
 
+func (m *GenesisState) GetUploadRecords() []UploadRecord {
+	if m != nil {
+		return m.UploadRecords
+	}
+	return nil
+}
+
 // Code struct encompasses CodeInfo and CodeBytes
 type Code struct {
 	CodeID    uint64   `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
@@ -308,53 +317,136 @@ func (m *Sequence) GetValue() uint64 {
 	return 0
 }
 
+// UploadRecord is a code upload that counts against the upload quota of the
+// creator
+type UploadRecord struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// UploadTime is the block time of the upload in unix nanoseconds
+	UploadTime int64  `protobuf:"varint,2,opt,name=upload_time,json=uploadTime,proto3" json:"upload_time,omitempty"`
+	CodeID     uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// CodeSize is the uncompressed size of the code in bytes
+	CodeSize uint64 `protobuf:"varint,4,opt,name=code_size,json=codeSize,proto3" json:"code_size,omitempty"`
+}
+
+func (m *UploadRecord) Reset()         { *m = UploadRecord{} }
+func (m *UploadRecord) String() string { return proto.CompactTextString(m) }
+func (*UploadRecord) ProtoMessage()    {}
+func (*UploadRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ab3f539b23472a6, []int{4}
+}
+
+func (m *UploadRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *UploadRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UploadRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *UploadRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadRecord.Merge(m, src)
+}
+
+func (m *UploadRecord) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *UploadRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadRecord proto.InternalMessageInfo
+
+func (m *UploadRecord) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *UploadRecord) GetUploadTime() int64 {
+	if m != nil {
+		return m.UploadTime
+	}
+	return 0
+}
+
+func (m *UploadRecord) GetCodeID() uint64 {
+	if m != nil {
+		return m.CodeID
+	}
+	return 0
+}
+
+func (m *UploadRecord) GetCodeSize() uint64 {
+	if m != nil {
+		return m.CodeSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmwasm.wasm.v1.GenesisState")
 	proto.RegisterType((*Code)(nil), "cosmwasm.wasm.v1.Code")
 	proto.RegisterType((*Contract)(nil), "cosmwasm.wasm.v1.Contract")
 	proto.RegisterType((*Sequence)(nil), "cosmwasm.wasm.v1.Sequence")
+	proto.RegisterType((*UploadRecord)(nil), "cosmwasm.wasm.v1.UploadRecord")
 }
 
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/genesis.proto", fileDescriptor_2ab3f539b23472a6) }
 
 var fileDescriptor_2ab3f539b23472a6 = []byte{
-	// 563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x9b, 0xae, 0x0d, 0xad, 0x57, 0xd8, 0x30, 0x63, 0x44, 0xd5, 0x48, 0xab, 0x22, 0xa1,
-	0x32, 0xa1, 0x46, 0x1b, 0x47, 0x2e, 0x90, 0x0d, 0x41, 0x98, 0x40, 0x28, 0x3b, 0x20, 0xed, 0x52,
-	0xa5, 0xb1, 0xd7, 0x59, 0x2c, 0x71, 0x88, 0xdd, 0x42, 0xbe, 0x05, 0x9f, 0x02, 0x71, 0xe4, 0xce,
-	0x17, 0xd8, 0x8d, 0x1d, 0x39, 0x55, 0x28, 0x3d, 0x20, 0xf1, 0x29, 0x26, 0xdb, 0x49, 0x16, 0xb5,
-	0xeb, 0xc5, 0x4a, 0xde, 0xfb, 0xbf, 0x9f, 0xed, 0xbf, 0xdf, 0x03, 0xa6, 0x4f, 0x59, 0xf0, 0xc5,
-	0x63, 0x81, 0x25, 0x97, 0xe9, 0x9e, 0x35, 0xc6, 0x21, 0x66, 0x84, 0x0d, 0xa2, 0x98, 0x72, 0x0a,
-	0x37, 0xf3, 0xfc, 0x40, 0x2e, 0xd3, 0xbd, 0xf6, 0xd6, 0x98, 0x8e, 0xa9, 0x4c, 0x5a, 0xe2, 0x4b,
-	0xe9, 0xda, 0x3b, 0x4b, 0x1c, 0x9e, 0x44, 0x38, 0xa3, 0xb4, 0xef, 0x7a, 0x01, 0x09, 0xa9, 0x25,
-	0x57, 0x15, 0xea, 0xfd, 0xae, 0x82, 0xd6, 0x6b, 0xb5, 0xd5, 0x31, 0xf7, 0x38, 0x86, 0xcf, 0x81,
-	0x1e, 0x79, 0xb1, 0x17, 0x30, 0x43, 0xeb, 0x6a, 0xfd, 0xf5, 0x7d, 0x63, 0xb0, 0xb8, 0xf5, 0xe0,
-	0x83, 0xcc, 0xdb, 0xcd, 0x8b, 0x59, 0xa7, 0xf2, 0xe3, 0xdf, 0xcf, 0x5d, 0xcd, 0xcd, 0x4a, 0xe0,
-	0x5b, 0x50, 0xf7, 0x29, 0xc2, 0xcc, 0xa8, 0x76, 0xd7, 0xfa, 0xeb, 0xfb, 0xdb, 0xcb, 0xb5, 0x07,
-	0x14, 0x61, 0x7b, 0x47, 0x54, 0xfe, 0x9f, 0x75, 0x36, 0xa4, 0xf8, 0x29, 0x0d, 0x08, 0xc7, 0x41,
-	0xc4, 0x13, 0x05, 0x53, 0x08, 0x78, 0x02, 0x9a, 0x3e, 0x0d, 0x79, 0xec, 0xf9, 0x9c, 0x19, 0x6b,
-	0x92, 0xd7, 0xbe, 0x89, 0xa7, 0x24, 0x76, 0x37, 0x63, 0xde, 0x2b, 0x8a, 0x16, 0xb9, 0xd7, 0x38,
-	0xc1, 0x66, 0xf8, 0xf3, 0x04, 0x87, 0x3e, 0x66, 0x46, 0x6d, 0x15, 0xfb, 0x38, 0x93, 0x5c, 0xb3,
-	0x8b, 0xa2, 0x25, 0x76, 0x91, 0xe9, 0x7d, 0xd7, 0x40, 0x4d, 0xdc, 0x12, 0x3e, 0x02, 0xb7, 0xc4,
-	0x4d, 0x86, 0x04, 0x49, 0x2b, 0x6b, 0x36, 0x48, 0x67, 0x1d, 0x5d, 0xa4, 0x9c, 0x43, 0x57, 0x17,
-	0x29, 0x07, 0x41, 0x5b, 0xdc, 0x52, 0x88, 0xc2, 0x53, 0x6a, 0x54, 0xa5, 0xe3, 0xed, 0x9b, 0x5d,
-	0x73, 0xc2, 0x53, 0x5a, 0xf6, 0xbc, 0xe1, 0x67, 0x41, 0xf8, 0x10, 0x00, 0xc9, 0x18, 0x25, 0x1c,
-	0x0b, 0xab, 0xb4, 0x7e, 0xcb, 0x95, 0x54, 0x5b, 0x04, 0xe0, 0x36, 0xd0, 0x23, 0x12, 0x86, 0x18,
-	0x19, 0xb5, 0xae, 0xd6, 0x6f, 0xb8, 0xd9, 0x5f, 0xef, 0x57, 0x15, 0x34, 0x72, 0xfb, 0xe0, 0x13,
-	0xb0, 0x99, 0xdb, 0x33, 0xf4, 0x10, 0x8a, 0x31, 0x53, 0x0d, 0xd0, 0x74, 0x37, 0xf2, 0xf8, 0x4b,
-	0x15, 0x86, 0xef, 0xc1, 0xed, 0x42, 0x5a, 0x3a, 0xb6, 0xb9, 0xfa, 0x71, 0x16, 0x8f, 0xde, 0xf2,
-	0x4b, 0x09, 0xe8, 0x80, 0x3b, 0x05, 0x8f, 0x89, 0x1e, 0xcc, 0x5e, 0xfb, 0xc1, 0x32, 0xf0, 0x1d,
-	0x45, 0xf8, 0xbc, 0x4c, 0x2a, 0x4e, 0xa2, 0x9a, 0x97, 0x80, 0xfb, 0x05, 0x4a, 0x5a, 0x72, 0x46,
-	0x18, 0xa7, 0x71, 0x92, 0xbd, 0xf1, 0xee, 0xea, 0x23, 0x0a, 0x87, 0xdf, 0x28, 0xf1, 0xab, 0x90,
-	0xc7, 0x49, 0x79, 0x93, 0xa2, 0xa5, 0x4a, 0xa2, 0x9e, 0x0d, 0x1a, 0x79, 0x7f, 0xc0, 0x2e, 0xd0,
-	0x09, 0x1a, 0x7e, 0xc2, 0x89, 0xb4, 0xac, 0x65, 0x37, 0xd3, 0x59, 0xa7, 0xee, 0x1c, 0x1e, 0xe1,
-	0xc4, 0xad, 0x13, 0x74, 0x84, 0x13, 0xb8, 0x05, 0xea, 0x53, 0xef, 0x7c, 0x82, 0xa5, 0x57, 0x35,
-	0x57, 0xfd, 0xd8, 0x2f, 0x2e, 0x52, 0x53, 0xbb, 0x4c, 0x4d, 0xed, 0x6f, 0x6a, 0x6a, 0xdf, 0xe6,
-	0x66, 0xe5, 0x72, 0x6e, 0x56, 0xfe, 0xcc, 0xcd, 0xca, 0xc9, 0xe3, 0x31, 0xe1, 0x67, 0x93, 0xd1,
-	0xc0, 0xa7, 0x81, 0x75, 0x40, 0x59, 0xf0, 0x31, 0x1f, 0x69, 0x64, 0x7d, 0x55, 0xa3, 0x2d, 0xe7,
-	0x7a, 0xa4, 0xcb, 0x29, 0x7e, 0x76, 0x15, 0x00, 0x00, 0xff, 0xff, 0x45, 0x4b, 0xa7, 0x92, 0x40,
-	0x04, 0x00, 0x00,
+	// 655 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0xf5, 0xc7, 0xda, 0xb7, 0xee, 0x07, 0xde, 0x18, 0x51, 0x19, 0x69, 0x55, 0x04,
+	0x2a, 0x13, 0x6a, 0xb5, 0x71, 0xe4, 0x02, 0xd9, 0x10, 0x94, 0x09, 0x84, 0x32, 0x10, 0xd2, 0x2e,
+	0x55, 0x16, 0x7b, 0x9d, 0x45, 0x13, 0x97, 0xd8, 0x1d, 0x64, 0xff, 0x02, 0x17, 0xfe, 0x0a, 0xc4,
+	0x91, 0x3b, 0xe2, 0xbe, 0xe3, 0x8e, 0x9c, 0x2a, 0xd4, 0x1d, 0x90, 0xf8, 0x2b, 0x50, 0xec, 0x24,
+	0xcb, 0x9a, 0xf5, 0xe2, 0xd6, 0xef, 0x7d, 0xdf, 0xe7, 0xb9, 0xcf, 0xdf, 0x1a, 0x0c, 0x87, 0x71,
+	0xf7, 0x93, 0xcd, 0xdd, 0x8e, 0x5c, 0x4e, 0xb6, 0x3a, 0x7d, 0xe2, 0x11, 0x4e, 0x79, 0x7b, 0xe8,
+	0x33, 0xc1, 0xd0, 0x4a, 0x9c, 0x6f, 0xcb, 0xe5, 0x64, 0xab, 0xb6, 0xd6, 0x67, 0x7d, 0x26, 0x93,
+	0x9d, 0xf0, 0x9b, 0xd2, 0xd5, 0x36, 0x32, 0x1c, 0x11, 0x0c, 0x49, 0x44, 0xa9, 0xdd, 0xb0, 0x5d,
+	0xea, 0xb1, 0x8e, 0x5c, 0x55, 0xa8, 0xf9, 0x2b, 0x0f, 0xd5, 0xe7, 0xaa, 0xd5, 0xbe, 0xb0, 0x05,
+	0x41, 0x8f, 0xa1, 0x34, 0xb4, 0x7d, 0xdb, 0xe5, 0xba, 0xd6, 0xd0, 0x5a, 0x0b, 0xdb, 0x7a, 0x7b,
+	0xba, 0x75, 0xfb, 0x8d, 0xcc, 0x9b, 0x95, 0xb3, 0x71, 0x3d, 0xf7, 0xfd, 0xef, 0x8f, 0x4d, 0xcd,
+	0x8a, 0x4a, 0xd0, 0x4b, 0x28, 0x3a, 0x0c, 0x13, 0xae, 0xcf, 0x35, 0xf2, 0xad, 0x85, 0xed, 0xf5,
+	0x6c, 0xed, 0x0e, 0xc3, 0xc4, 0xdc, 0x08, 0x2b, 0xff, 0x8d, 0xeb, 0xcb, 0x52, 0xfc, 0x90, 0xb9,
+	0x54, 0x10, 0x77, 0x28, 0x02, 0x05, 0x53, 0x08, 0x74, 0x00, 0x15, 0x87, 0x79, 0xc2, 0xb7, 0x1d,
+	0xc1, 0xf5, 0xbc, 0xe4, 0xd5, 0xae, 0xe3, 0x29, 0x89, 0xd9, 0x88, 0x98, 0xab, 0x49, 0xd1, 0x34,
+	0xf7, 0x12, 0x17, 0xb2, 0x39, 0xf9, 0x38, 0x22, 0x9e, 0x43, 0xb8, 0x5e, 0x98, 0xc5, 0xde, 0x8f,
+	0x24, 0x97, 0xec, 0xa4, 0x28, 0xc3, 0x4e, 0x32, 0x68, 0x00, 0x4b, 0xa3, 0xe1, 0x80, 0xd9, 0xb8,
+	0xe7, 0x13, 0x87, 0xf9, 0x98, 0xeb, 0x45, 0xd9, 0xc0, 0xc8, 0x36, 0x78, 0x27, 0x75, 0x96, 0x94,
+	0x99, 0xf7, 0xa2, 0x26, 0xfa, 0xd5, 0xea, 0xe9, 0x4e, 0x8b, 0xa3, 0x54, 0x11, 0x6f, 0x7e, 0xd3,
+	0xa0, 0x10, 0xce, 0x14, 0xdd, 0x85, 0xf9, 0x70, 0x6e, 0x3d, 0x8a, 0xe5, 0xc5, 0x15, 0x4c, 0x98,
+	0x8c, 0xeb, 0xa5, 0x30, 0xd5, 0xdd, 0xb5, 0x4a, 0x61, 0xaa, 0x8b, 0x91, 0x09, 0x15, 0x25, 0xf2,
+	0x8e, 0x98, 0x3e, 0xd7, 0xd0, 0xae, 0xff, 0xdd, 0xb2, 0xc8, 0x3b, 0x62, 0xe9, 0x1b, 0x2e, 0x3b,
+	0x51, 0x10, 0xdd, 0x01, 0x90, 0x8c, 0xc3, 0x40, 0x90, 0xf0, 0x62, 0xb4, 0x56, 0xd5, 0x92, 0x54,
+	0x33, 0x0c, 0xa0, 0x75, 0x28, 0x0d, 0xa9, 0xe7, 0x11, 0xac, 0x17, 0x1a, 0x5a, 0xab, 0x6c, 0x45,
+	0xbb, 0xe6, 0xcf, 0x39, 0x28, 0xc7, 0x97, 0x85, 0x1e, 0xc0, 0x4a, 0x7c, 0x19, 0x3d, 0x1b, 0x63,
+	0x9f, 0x70, 0x65, 0xb7, 0x8a, 0xb5, 0x1c, 0xc7, 0x9f, 0xaa, 0x30, 0x7a, 0x0d, 0x8b, 0x89, 0x34,
+	0x75, 0x6c, 0x63, 0xb6, 0x15, 0xa6, 0x8f, 0x5e, 0x75, 0x52, 0x09, 0xd4, 0x85, 0xa5, 0x84, 0xc7,
+	0x43, 0xc7, 0x47, 0xde, 0xba, 0x95, 0x05, 0xbe, 0x62, 0x98, 0x0c, 0xd2, 0xa4, 0xe4, 0x24, 0xea,
+	0xaf, 0x42, 0xe1, 0x66, 0x82, 0x92, 0x23, 0x39, 0xa6, 0x5c, 0x30, 0x3f, 0x88, 0x1c, 0xb5, 0x39,
+	0xfb, 0x88, 0xe1, 0x84, 0x5f, 0x28, 0xf1, 0x33, 0x4f, 0xf8, 0x41, 0xba, 0xc9, 0xaa, 0x93, 0x15,
+	0x35, 0x4d, 0x28, 0xc7, 0x6e, 0x44, 0x0d, 0x28, 0x51, 0xdc, 0xfb, 0x40, 0x02, 0x39, 0xb2, 0xaa,
+	0x59, 0x99, 0x8c, 0xeb, 0xc5, 0xee, 0xee, 0x1e, 0x09, 0xac, 0x22, 0xc5, 0x7b, 0x24, 0x40, 0x6b,
+	0x50, 0x3c, 0xb1, 0x07, 0x23, 0x22, 0x67, 0x55, 0xb0, 0xd4, 0xa6, 0xf9, 0x45, 0x83, 0x6a, 0xda,
+	0x71, 0x48, 0x87, 0x79, 0xc7, 0x27, 0xb6, 0x60, 0x7e, 0x34, 0xfc, 0x78, 0x8b, 0xea, 0xb0, 0x10,
+	0xb9, 0x50, 0x50, 0x57, 0x61, 0xf2, 0x16, 0xa8, 0xd0, 0x5b, 0xea, 0x5e, 0x71, 0x5b, 0x7e, 0xa6,
+	0xdb, 0x6e, 0x47, 0x6e, 0xe3, 0xf4, 0x94, 0x48, 0x37, 0x14, 0x94, 0x8d, 0xf6, 0xe9, 0x29, 0x31,
+	0x9f, 0x9c, 0x4d, 0x0c, 0xed, 0x7c, 0x62, 0x68, 0x7f, 0x26, 0x86, 0xf6, 0xf5, 0xc2, 0xc8, 0x9d,
+	0x5f, 0x18, 0xb9, 0xdf, 0x17, 0x46, 0xee, 0xe0, 0x7e, 0x9f, 0x8a, 0xe3, 0xd1, 0x61, 0xdb, 0x61,
+	0x6e, 0x67, 0x87, 0x71, 0xf7, 0x7d, 0xfc, 0x9c, 0xe1, 0xce, 0x67, 0xf9, 0xa9, 0xde, 0xb4, 0xc3,
+	0x92, 0x7c, 0xc1, 0x1e, 0xfd, 0x1f, 0x00, 0xab, 0x3d, 0xf5, 0xf1, 0x3c, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -377,6 +469,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UploadRecords) > 0 {
+		for iNdEx := len(m.UploadRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UploadRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Sequences) > 0 {
 		for iNdEx := len(m.Sequences) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -590,6 +696,51 @@ func (m *Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UploadRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UploadRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UploadRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CodeSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CodeSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UploadTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UploadTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -628,6 +779,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.UploadRecords) > 0 {
+		for _, e := range m.UploadRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -695,6 +852,28 @@ func (m *Sequence) Size() (n int) {
 	return n
 }
 
+func (m *UploadRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.UploadTime != 0 {
+		n += 1 + sovGenesis(uint64(m.UploadTime))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovGenesis(uint64(m.CodeID))
+	}
+	if m.CodeSize != 0 {
+		n += 1 + sovGenesis(uint64(m.CodeSize))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -867,6 +1046,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadRecords = append(m.UploadRecords, UploadRecord{})
+			if err := m.UploadRecords[len(m.UploadRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1334,6 +1547,146 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *UploadRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UploadRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UploadRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+			}
+			m.UploadTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeSize", wireType)
+			}
+			m.CodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
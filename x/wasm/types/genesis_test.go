@@ -15,7 +15,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
-const invalidAddress = "invalid address"
+const (
+	invalidAddress = "invalid address"
+	anyAddress     = "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqs2m6sx4"
+)
 
 func TestValidateGenesisState(t *testing.T) {
 	specs := map[string]struct {
@@ -49,6 +52,58 @@ func TestValidateGenesisState(t *testing.T) {
 			},
 			expError: true,
 		},
+		"upload record valid": {
+			srcMutator: func(s *GenesisState) {
+				s.UploadRecords = []UploadRecord{{Creator: anyAddress, UploadTime: 1, CodeID: 1, CodeSize: 1}}
+			},
+		},
+		"upload record invalid creator": {
+			srcMutator: func(s *GenesisState) {
+				s.UploadRecords = []UploadRecord{{Creator: invalidAddress, UploadTime: 1, CodeID: 1, CodeSize: 1}}
+			},
+			expError: true,
+		},
+		"upload record without code id": {
+			srcMutator: func(s *GenesisState) {
+				s.UploadRecords = []UploadRecord{{Creator: anyAddress, UploadTime: 1, CodeSize: 1}}
+			},
+			expError: true,
+		},
+		"upload record with negative upload time": {
+			srcMutator: func(s *GenesisState) {
+				s.UploadRecords = []UploadRecord{{Creator: anyAddress, UploadTime: -1, CodeID: 1, CodeSize: 1}}
+			},
+			expError: true,
+		},
+		"instantiate config with known code id": {
+			srcMutator: func(s *GenesisState) {
+				s.Codes[0].CodeInfo.InstantiateConfig = AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{s.Codes[1].CodeID}}
+			},
+		},
+		"instantiate config with unknown code id": {
+			srcMutator: func(s *GenesisState) {
+				s.Codes[0].CodeInfo.InstantiateConfig = AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{99}}
+			},
+			expError: true,
+		},
+		"code bytes greater default limit": {
+			srcMutator: func(s *GenesisState) {
+				s.Codes[0].CodeBytes = bytes.Repeat([]byte{0x1}, int(DefaultMaxProposalWasmSize)+1)
+			},
+			expError: true,
+		},
+		"code bytes greater params limit": {
+			srcMutator: func(s *GenesisState) {
+				s.Params.SizeLimits = &SizeLimitParams{MaxWasmSize: 1, MaxProposalWasmSize: 1, MaxLabelSize: 128, MaxSaltSize: 1}
+			},
+			expError: true,
+		},
+		"label greater params limit": {
+			srcMutator: func(s *GenesisState) {
+				s.Params.SizeLimits = &SizeLimitParams{MaxWasmSize: 1, MaxProposalWasmSize: 1 << 20, MaxLabelSize: 1, MaxSaltSize: 1}
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -93,12 +148,6 @@ func TestCodeValidateBasic(t *testing.T) {
 			},
 			expError: true,
 		},
-		"codeBytes greater limit": {
-			srcMutator: func(c *Code) {
-				c.CodeBytes = bytes.Repeat([]byte{0x1}, MaxProposalWasmSize+1)
-			},
-			expError: true,
-		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
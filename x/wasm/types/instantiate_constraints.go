@@ -0,0 +1,70 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs basic validation
+func (c InstantiateConstraints) ValidateBasic() error {
+	if c.NoAdmin && c.Admin != "" {
+		return errorsmod.Wrap(ErrInvalid, "admin must not be set with no admin")
+	}
+	if c.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(c.Admin); err != nil {
+			return errorsmod.Wrap(err, "admin")
+		}
+	}
+	if err := c.MaxFunds.Validate(); err != nil {
+		return errorsmod.Wrap(err, "max funds")
+	}
+	return nil
+}
+
+// IsEmpty returns true when no constraint is set
+func (c InstantiateConstraints) IsEmpty() bool {
+	return !c.NoAdmin && c.Admin == "" && c.MaxFunds.Empty()
+}
+
+// Accept returns an error when a contract instantiation with the given admin and funds violates a constraint
+func (c InstantiateConstraints) Accept(admin sdk.AccAddress, funds sdk.Coins) error {
+	if err := c.AcceptAdmin(admin); err != nil {
+		return err
+	}
+	if !c.MaxFunds.Empty() && !funds.IsAllLTE(c.MaxFunds) {
+		return errorsmod.Wrapf(ErrInstantiateConstraint, "funds %s exceed max funds %s", funds, c.MaxFunds)
+	}
+	return nil
+}
+
+// AcceptAdmin returns an error when the given contract admin violates a constraint
+func (c InstantiateConstraints) AcceptAdmin(admin sdk.AccAddress) error {
+	switch {
+	case c.NoAdmin && len(admin) != 0:
+		return errorsmod.Wrapf(ErrInstantiateConstraint, "admin must be empty, got %s", admin)
+	case c.Admin != "" && admin.String() != c.Admin:
+		return errorsmod.Wrapf(ErrInstantiateConstraint, "admin must be %s, got %q", c.Admin, admin.String())
+	}
+	return nil
+}
+
+// IsSubset returns true when the constraints are equal or stricter than the superSet constraints so that
+// every instantiation accepted by them is also accepted by the superSet. Nil means no constraints.
+func (c *InstantiateConstraints) IsSubset(superSet *InstantiateConstraints) bool {
+	if superSet == nil || superSet.IsEmpty() {
+		return true
+	}
+	if c == nil {
+		return false
+	}
+	switch {
+	case superSet.NoAdmin && !c.NoAdmin:
+		return false
+	case superSet.Admin != "" && superSet.Admin != c.Admin:
+		return false
+	case !superSet.MaxFunds.Empty() && (c.MaxFunds.Empty() || !c.MaxFunds.IsAllLTE(superSet.MaxFunds)):
+		return false
+	}
+	return true
+}
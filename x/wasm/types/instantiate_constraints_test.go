@@ -0,0 +1,192 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestInstantiateConstraintsValidateBasic(t *testing.T) {
+	myAddr := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	specs := map[string]struct {
+		src    InstantiateConstraints
+		expErr bool
+	}{
+		"empty": {},
+		"no admin": {
+			src: InstantiateConstraints{NoAdmin: true},
+		},
+		"admin": {
+			src: InstantiateConstraints{Admin: myAddr},
+		},
+		"max funds": {
+			src: InstantiateConstraints{MaxFunds: sdk.NewCoins(sdk.NewInt64Coin("denom", 1))},
+		},
+		"no admin and admin": {
+			src:    InstantiateConstraints{NoAdmin: true, Admin: myAddr},
+			expErr: true,
+		},
+		"invalid admin": {
+			src:    InstantiateConstraints{Admin: "invalid"},
+			expErr: true,
+		},
+		"invalid max funds": {
+			src:    InstantiateConstraints{MaxFunds: sdk.Coins{sdk.Coin{Denom: "denom", Amount: sdk.ZeroInt()}}},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestInstantiateConstraintsAccept(t *testing.T) {
+	myAddr := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	otherAddr := sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+	oneToken := sdk.NewCoins(sdk.NewInt64Coin("denom", 1))
+	specs := map[string]struct {
+		src    InstantiateConstraints
+		admin  sdk.AccAddress
+		funds  sdk.Coins
+		expErr bool
+	}{
+		"empty": {
+			admin: myAddr,
+			funds: oneToken,
+		},
+		"no admin - without admin": {
+			src: InstantiateConstraints{NoAdmin: true},
+		},
+		"no admin - with admin": {
+			src:    InstantiateConstraints{NoAdmin: true},
+			admin:  myAddr,
+			expErr: true,
+		},
+		"admin - matching": {
+			src:   InstantiateConstraints{Admin: myAddr.String()},
+			admin: myAddr,
+		},
+		"admin - other": {
+			src:    InstantiateConstraints{Admin: myAddr.String()},
+			admin:  otherAddr,
+			expErr: true,
+		},
+		"admin - without admin": {
+			src:    InstantiateConstraints{Admin: myAddr.String()},
+			expErr: true,
+		},
+		"max funds - equal": {
+			src:   InstantiateConstraints{MaxFunds: oneToken},
+			funds: oneToken,
+		},
+		"max funds - no funds": {
+			src: InstantiateConstraints{MaxFunds: oneToken},
+		},
+		"max funds - exceeded": {
+			src:    InstantiateConstraints{MaxFunds: oneToken},
+			funds:  sdk.NewCoins(sdk.NewInt64Coin("denom", 2)),
+			expErr: true,
+		},
+		"max funds - other denom": {
+			src:    InstantiateConstraints{MaxFunds: oneToken},
+			funds:  sdk.NewCoins(sdk.NewInt64Coin("other", 1)),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.Accept(spec.admin, spec.funds)
+			if spec.expErr {
+				assert.ErrorIs(t, gotErr, ErrInstantiateConstraint)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestInstantiateConstraintsIsSubset(t *testing.T) {
+	myAddr := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	otherAddr := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	oneToken := sdk.NewCoins(sdk.NewInt64Coin("denom", 1))
+	twoTokens := sdk.NewCoins(sdk.NewInt64Coin("denom", 2))
+	specs := map[string]struct {
+		src      *InstantiateConstraints
+		superSet *InstantiateConstraints
+		exp      bool
+	}{
+		"both nil": {
+			exp: true,
+		},
+		"nil superSet": {
+			src: &InstantiateConstraints{NoAdmin: true},
+			exp: true,
+		},
+		"empty superSet": {
+			superSet: &InstantiateConstraints{},
+			exp:      true,
+		},
+		"nil src": {
+			superSet: &InstantiateConstraints{NoAdmin: true},
+		},
+		"no admin - equal": {
+			src:      &InstantiateConstraints{NoAdmin: true},
+			superSet: &InstantiateConstraints{NoAdmin: true},
+			exp:      true,
+		},
+		"no admin - removed": {
+			src:      &InstantiateConstraints{MaxFunds: oneToken},
+			superSet: &InstantiateConstraints{NoAdmin: true},
+		},
+		"no admin - replaced with admin": {
+			src:      &InstantiateConstraints{Admin: myAddr},
+			superSet: &InstantiateConstraints{NoAdmin: true},
+		},
+		"admin - added": {
+			src:      &InstantiateConstraints{Admin: myAddr},
+			superSet: &InstantiateConstraints{MaxFunds: oneToken},
+		},
+		"admin - equal": {
+			src:      &InstantiateConstraints{Admin: myAddr},
+			superSet: &InstantiateConstraints{Admin: myAddr},
+			exp:      true,
+		},
+		"admin - other": {
+			src:      &InstantiateConstraints{Admin: otherAddr},
+			superSet: &InstantiateConstraints{Admin: myAddr},
+		},
+		"max funds - lower": {
+			src:      &InstantiateConstraints{MaxFunds: oneToken},
+			superSet: &InstantiateConstraints{MaxFunds: twoTokens},
+			exp:      true,
+		},
+		"max funds - higher": {
+			src:      &InstantiateConstraints{MaxFunds: twoTokens},
+			superSet: &InstantiateConstraints{MaxFunds: oneToken},
+		},
+		"max funds - removed": {
+			src:      &InstantiateConstraints{NoAdmin: true},
+			superSet: &InstantiateConstraints{MaxFunds: oneToken},
+		},
+		"max funds - other denom": {
+			src:      &InstantiateConstraints{MaxFunds: sdk.NewCoins(sdk.NewInt64Coin("other", 1))},
+			superSet: &InstantiateConstraints{MaxFunds: oneToken},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, spec.exp, spec.src.IsSubset(spec.superSet))
+		})
+	}
+}
@@ -1,7 +1,12 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 )
 
 // isJSONObjectWithTopLevelKey returns true if the given bytes are a valid JSON object
@@ -32,3 +37,59 @@ func isJSONObjectWithTopLevelKey(jsonBytes RawContractMessage, allowedKeys []str
 
 	panic("Reached unreachable code. This is a bug.")
 }
+
+// parseJSONPointer splits a JSON pointer (RFC 6901) into its unescaped reference tokens.
+// The empty pointer references the whole document.
+func parseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, errors.New("must start with /")
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, t := range tokens {
+		for j := 0; j < len(t); j++ {
+			if t[j] == '~' && (j+1 == len(t) || (t[j+1] != '0' && t[j+1] != '1')) {
+				return nil, fmt.Errorf("invalid escape sequence in %q", t)
+			}
+		}
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// lookupJSONValue returns the value that the reference tokens point to in the decoded json document.
+// Returns false when there is no such value.
+func lookupJSONValue(document interface{}, tokens []string) (interface{}, bool) {
+	for _, t := range tokens {
+		switch v := document.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if document, ok = v[t]; !ok {
+				return nil, false
+			}
+		case []interface{}:
+			i, err := strconv.Atoi(t)
+			if err != nil || i < 0 || i >= len(v) || strconv.Itoa(i) != t {
+				return nil, false
+			}
+			document = v[i]
+		default:
+			return nil, false
+		}
+	}
+	return document, true
+}
+
+// decodeJSON decodes the json bytes into a generic document. Numbers are kept as json.Number
+// so that they do not lose precision.
+func decodeJSON(bz []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	var r interface{}
+	if err := dec.Decode(&r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
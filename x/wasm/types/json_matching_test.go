@@ -131,3 +131,75 @@ func TestDuplicateKeyGivesSameResult(t *testing.T) {
 		assert.Equal(t, "bar", document["event⑨thing"])
 	}
 }
+
+func TestLookupJSONValue(t *testing.T) {
+	document, err := decodeJSON([]byte(`{"a":{"b/c":[1,{"d~e":"foo"}],"":"empty"}}`))
+	require.NoError(t, err)
+	specs := map[string]struct {
+		pointer  string
+		exp      interface{}
+		expFound bool
+		expErr   bool
+	}{
+		"whole document": {
+			pointer:  "",
+			exp:      document,
+			expFound: true,
+		},
+		"escaped slash and array index": {
+			pointer:  "/a/b~1c/0",
+			exp:      json.Number("1"),
+			expFound: true,
+		},
+		"escaped tilde": {
+			pointer:  "/a/b~1c/1/d~0e",
+			exp:      "foo",
+			expFound: true,
+		},
+		"empty key": {
+			pointer:  "/a/",
+			exp:      "empty",
+			expFound: true,
+		},
+		"unknown key": {
+			pointer: "/a/x",
+		},
+		"index out of range": {
+			pointer: "/a/b~1c/2",
+		},
+		"index with leading zero": {
+			pointer: "/a/b~1c/00",
+		},
+		"negative index": {
+			pointer: "/a/b~1c/-1",
+		},
+		"below scalar": {
+			pointer: "/a/b~1c/0/x",
+		},
+		"without leading slash": {
+			pointer: "a",
+			expErr:  true,
+		},
+		"invalid escape": {
+			pointer: "/a~2",
+			expErr:  true,
+		},
+		"trailing tilde": {
+			pointer: "/a~",
+			expErr:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			tokens, gotErr := parseJSONPointer(spec.pointer)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			got, gotFound := lookupJSONValue(document, tokens)
+			assert.Equal(t, spec.expFound, gotFound)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
@@ -33,6 +33,8 @@ var (
 	TXCounterPrefix                                = []byte{0x08}
 	ContractsByCreatorPrefix                       = []byte{0x09}
 	ParamsKey                                      = []byte{0x10}
+	CodeAnalysisPrefix                             = []byte{0x11}
+	UploadRecordPrefix                             = []byte{0x12}
 
 	KeyLastCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
 	KeyLastInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
@@ -44,6 +46,38 @@ func GetCodeKey(codeID uint64) []byte {
 	return append(CodeKeyPrefix, contractIDBz...)
 }
 
+// GetCodeAnalysisKey constructs the key for the static analysis result of the WASM code
+func GetCodeAnalysisKey(codeID uint64) []byte {
+	return append(CodeAnalysisPrefix, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetUploadRecordPrefix returns the key prefix for the code uploads of a creator: `<prefix><creator length><creator>`
+func GetUploadRecordPrefix(creator sdk.AccAddress) []byte {
+	return append(UploadRecordPrefix, address.MustLengthPrefix(creator)...)
+}
+
+// GetUploadRecordKey returns the key for a code upload that counts against the quota of the creator:
+// `<prefix><creator length><creator><block time nanos><codeID>`
+func GetUploadRecordKey(creator sdk.AccAddress, uploadTime int64, codeID uint64) []byte {
+	prefix := GetUploadRecordPrefix(creator)
+	prefixLen := len(prefix)
+	r := make([]byte, prefixLen+16)
+	copy(r[0:], prefix)
+	copy(r[prefixLen:], sdk.Uint64ToBigEndian(uint64(uploadTime)))
+	copy(r[prefixLen+8:], sdk.Uint64ToBigEndian(codeID))
+	return r
+}
+
+// ParseUploadRecordKey returns the creator, block time nanos and code id of an upload record key without the
+// UploadRecordPrefix
+func ParseUploadRecordKey(key []byte) (sdk.AccAddress, int64, uint64) {
+	creatorLen := int(key[0])
+	creator := key[1 : 1+creatorLen]
+	uploadTime := int64(sdk.BigEndianToUint64(key[1+creatorLen : 1+creatorLen+8]))
+	codeID := sdk.BigEndianToUint64(key[1+creatorLen+8:])
+	return creator, uploadTime, codeID
+}
+
 // GetContractAddressKey returns the key for the WASM contract instance
 func GetContractAddressKey(addr sdk.AccAddress) []byte {
 	return append(ContractKeyPrefix, addr...)
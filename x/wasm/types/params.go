@@ -17,6 +17,7 @@ var AllAccessTypes = []AccessType{
 	AccessTypeNobody,
 	AccessTypeAnyOfAddresses,
 	AccessTypeEverybody,
+	AccessTypeAnyOfCodeIDs,
 }
 
 func (a AccessType) With(addrs ...sdk.AccAddress) AccessConfig {
@@ -46,6 +47,8 @@ func (a AccessType) String() string {
 		return "Everybody"
 	case AccessTypeAnyOfAddresses:
 		return "AnyOfAddresses"
+	case AccessTypeAnyOfCodeIDs:
+		return "AnyOfCodeIDs"
 	}
 	return "Unspecified"
 }
@@ -83,14 +86,35 @@ var (
 	AllowNobody         = AccessConfig{Permission: AccessTypeNobody}
 )
 
-// DefaultParams returns default wasm parameters
+// DefaultParams returns default wasm parameters. The capabilities are not set here as they
+// depend on the wasmvm. They are set to the capabilities of the keeper on genesis init.
 func DefaultParams() Params {
+	gasRegister := DefaultGasRegisterParams()
+	sizeLimits := DefaultSizeLimitParams()
 	return Params{
 		CodeUploadAccess:             AllowEverybody,
 		InstantiateDefaultPermission: AccessTypeEverybody,
+		GasRegister:                  &gasRegister,
+		SizeLimits:                   &sizeLimits,
 	}
 }
 
+// GasRegisterOrDefault returns the gas register params or the default values when not set
+func (p Params) GasRegisterOrDefault() GasRegisterParams {
+	if p.GasRegister == nil {
+		return DefaultGasRegisterParams()
+	}
+	return *p.GasRegister
+}
+
+// CapabilitiesOr returns the capability params or the given fallback when not set
+func (p Params) CapabilitiesOr(fallback CapabilityParams) CapabilityParams {
+	if p.Capabilities == nil {
+		return fallback
+	}
+	return *p.Capabilities
+}
+
 func (p Params) String() string {
 	out, err := yaml.Marshal(p)
 	if err != nil {
@@ -104,9 +128,43 @@ func (p Params) ValidateBasic() error {
 	if err := validateAccessType(p.InstantiateDefaultPermission); err != nil {
 		return errors.Wrap(err, "instantiate default permission")
 	}
+	if p.InstantiateDefaultPermission == AccessTypeAnyOfCodeIDs {
+		return errors.Wrap(ErrInvalid, "instantiate default permission: code ids not supported")
+	}
 	if err := validateAccessConfig(p.CodeUploadAccess); err != nil {
 		return errors.Wrap(err, "upload access")
 	}
+	if p.CodeUploadAccess.Permission == AccessTypeAnyOfCodeIDs {
+		return errors.Wrap(ErrInvalid, "upload access: code ids not supported")
+	}
+	if p.CodeUploadAccess.Constraints != nil {
+		return errors.Wrap(ErrInvalid, "upload access: constraints not supported")
+	}
+	if p.GasRegister != nil {
+		if err := p.GasRegister.ValidateBasic(); err != nil {
+			return errors.Wrap(err, "gas register")
+		}
+	}
+	if p.Capabilities != nil {
+		if err := p.Capabilities.ValidateBasic(); err != nil {
+			return errors.Wrap(err, "capabilities")
+		}
+	}
+	if p.Fees != nil {
+		if err := p.Fees.ValidateBasic(); err != nil {
+			return errors.Wrap(err, "fees")
+		}
+	}
+	if p.SizeLimits != nil {
+		if err := p.SizeLimits.ValidateBasic(); err != nil {
+			return errors.Wrap(err, "size limits")
+		}
+	}
+	if p.UploadQuota != nil {
+		if err := p.UploadQuota.ValidateBasic(); err != nil {
+			return errors.Wrap(err, "upload quota")
+		}
+	}
 	return nil
 }
 
@@ -136,17 +194,56 @@ func validateAccessType(i interface{}) error {
 
 // ValidateBasic performs basic validation
 func (a AccessConfig) ValidateBasic() error {
+	if a.Constraints != nil {
+		if err := a.Constraints.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "constraints")
+		}
+	}
 	switch a.Permission {
 	case AccessTypeUnspecified:
 		return errorsmod.Wrap(ErrEmpty, "type")
 	case AccessTypeNobody, AccessTypeEverybody:
+		if len(a.Expiries) != 0 {
+			return errorsmod.Wrapf(ErrInvalid, "expiries not supported for type: %q", a.Permission)
+		}
+		if len(a.CodeIDs) != 0 {
+			return errorsmod.Wrapf(ErrInvalid, "code ids not supported for type: %q", a.Permission)
+		}
 		return nil
 	case AccessTypeAnyOfAddresses:
-		return errorsmod.Wrap(assertValidAddresses(a.Addresses), "addresses")
+		if len(a.CodeIDs) != 0 {
+			return errorsmod.Wrapf(ErrInvalid, "code ids not supported for type: %q", a.Permission)
+		}
+		if err := assertValidAddresses(a.Addresses); err != nil {
+			return errorsmod.Wrap(err, "addresses")
+		}
+		return errorsmod.Wrap(assertValidExpiries(a.Addresses, a.Expiries), "expiries")
+	case AccessTypeAnyOfCodeIDs:
+		if len(a.Addresses) != 0 || len(a.Expiries) != 0 {
+			return errorsmod.Wrapf(ErrInvalid, "addresses not supported for type: %q", a.Permission)
+		}
+		return errorsmod.Wrap(assertValidCodeIDs(a.CodeIDs), "code ids")
 	}
 	return errorsmod.Wrapf(ErrInvalid, "unknown type: %q", a.Permission)
 }
 
+func assertValidCodeIDs(codeIDs []uint64) error {
+	if len(codeIDs) == 0 {
+		return ErrEmpty
+	}
+	idx := make(map[uint64]struct{}, len(codeIDs))
+	for _, id := range codeIDs {
+		if id == 0 {
+			return errorsmod.Wrap(ErrInvalid, "code id must not be 0")
+		}
+		if _, exists := idx[id]; exists {
+			return ErrDuplicate.Wrapf("code id: %d", id)
+		}
+		idx[id] = struct{}{}
+	}
+	return nil
+}
+
 func assertValidAddresses(addrs []string) error {
 	if len(addrs) == 0 {
 		return ErrEmpty
@@ -164,9 +261,10 @@ func assertValidAddresses(addrs []string) error {
 	return nil
 }
 
-// Allowed returns if permission includes the actor.
+// Allowed returns if permission includes the actor at the block of the context. Expired addresses are not allowed.
+// For AccessTypeAnyOfCodeIDs the code id of the actor is read from the context. See WithCallerCodeID.
 // Actor address must be valid and not nil
-func (a AccessConfig) Allowed(actor sdk.AccAddress) bool {
+func (a AccessConfig) Allowed(ctx sdk.Context, actor sdk.AccAddress) bool {
 	switch a.Permission {
 	case AccessTypeNobody:
 		return false
@@ -175,10 +273,13 @@ func (a AccessConfig) Allowed(actor sdk.AccAddress) bool {
 	case AccessTypeAnyOfAddresses:
 		for _, v := range a.Addresses {
 			if v == actor.String() {
-				return true
+				return !a.isExpired(ctx, v)
 			}
 		}
 		return false
+	case AccessTypeAnyOfCodeIDs:
+		codeID, ok := CallerCodeID(ctx, actor)
+		return ok && containsCodeID(a.CodeIDs, codeID)
 	default:
 		panic("unknown type")
 	}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -98,6 +99,104 @@ func TestValidateParams(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"all good with custom gas register": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  &GasRegisterParams{GasMultiplier: 1, UncompressCostDenominator: 1},
+			},
+		},
+		"reject gas register with zero gas multiplier": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  &GasRegisterParams{UncompressCostDenominator: 1},
+			},
+			expErr: true,
+		},
+		"reject gas register with zero uncompress cost denominator": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  &GasRegisterParams{GasMultiplier: 1},
+			},
+			expErr: true,
+		},
+		"all good with capabilities": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				Capabilities:                 &CapabilityParams{Enabled: []string{"iterator", "stargate"}},
+			},
+		},
+		"all good with no capabilities enabled": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				Capabilities:                 &CapabilityParams{},
+			},
+		},
+		"reject empty capability": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				Capabilities:                 &CapabilityParams{Enabled: []string{"iterator", ""}},
+			},
+			expErr: true,
+		},
+		"reject duplicate capability": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				Capabilities:                 &CapabilityParams{Enabled: []string{"iterator", "iterator"}},
+			},
+			expErr: true,
+		},
+		"reject comma separated capabilities": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				Capabilities:                 &CapabilityParams{Enabled: []string{"iterator,stargate"}},
+			},
+			expErr: true,
+		},
+		"reject size limits with zero max wasm size": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				SizeLimits:                   &SizeLimitParams{MaxProposalWasmSize: 1, MaxLabelSize: 1, MaxSaltSize: 1},
+			},
+			expErr: true,
+		},
+		"reject size limits with zero max label size": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				SizeLimits:                   &SizeLimitParams{MaxWasmSize: 1, MaxProposalWasmSize: 1, MaxSaltSize: 1},
+			},
+			expErr: true,
+		},
+		"reject code ids for default instantiate permission": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeAnyOfCodeIDs,
+			},
+			expErr: true,
+		},
+		"reject code ids for upload access": {
+			src: Params{
+				CodeUploadAccess:             AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{1}},
+				InstantiateDefaultPermission: AccessTypeEverybody,
+			},
+			expErr: true,
+		},
+		"reject constraints for upload access": {
+			src: Params{
+				CodeUploadAccess:             AccessConfig{Permission: AccessTypeEverybody, Constraints: &InstantiateConstraints{NoAdmin: true}},
+				InstantiateDefaultPermission: AccessTypeEverybody,
+			},
+			expErr: true,
+		},
 		"reject duplicate address in any of addresses": {
 			src: Params{
 				CodeUploadAccess:             AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{anyAddress.String(), anyAddress.String()}},
@@ -166,9 +265,24 @@ func TestParamsUnmarshalJson(t *testing.T) {
 	}{
 		"defaults": {
 			src: `{"code_upload_access": {"permission": "Everybody"},
-				"instantiate_default_permission": "Everybody"}`,
+				"instantiate_default_permission": "Everybody",
+				"gas_register": {"instance_cost": "60000", "compile_cost": "3",
+					"uncompress_cost_numerator": "15", "uncompress_cost_denominator": "100",
+					"gas_multiplier": "140000000", "event_per_attribute_cost": "10",
+					"event_attribute_data_cost": "1", "event_attribute_data_free_tier": "100",
+					"contract_message_data_cost": "0", "custom_event_cost": "20"},
+				"size_limits": {"max_wasm_size": "819200", "max_proposal_wasm_size": "3145728",
+					"max_label_size": "128", "max_salt_size": "64"}}`,
 			exp: DefaultParams(),
 		},
+		"without gas register and capabilities": {
+			src: `{"code_upload_access": {"permission": "Everybody"},
+				"instantiate_default_permission": "Everybody"}`,
+			exp: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+			},
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -248,3 +362,87 @@ func TestAccessTypeWith(t *testing.T) {
 		})
 	}
 }
+
+func TestAccessConfigValidateBasicWithCodeIDs(t *testing.T) {
+	myAddr := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	specs := map[string]struct {
+		src    AccessConfig
+		expErr bool
+	}{
+		"single": {
+			src: AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{1}},
+		},
+		"multiple": {
+			src: AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{1, 2}},
+		},
+		"empty": {
+			src:    AccessConfig{Permission: AccessTypeAnyOfCodeIDs},
+			expErr: true,
+		},
+		"zero": {
+			src:    AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{0}},
+			expErr: true,
+		},
+		"duplicate": {
+			src:    AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{1, 1}},
+			expErr: true,
+		},
+		"with addresses": {
+			src:    AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{1}, Addresses: []string{myAddr}},
+			expErr: true,
+		},
+		"code ids for any of addresses": {
+			src:    AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{myAddr}, CodeIDs: []uint64{1}},
+			expErr: true,
+		},
+		"code ids for everybody": {
+			src:    AccessConfig{Permission: AccessTypeEverybody, CodeIDs: []uint64{1}},
+			expErr: true,
+		},
+		"code ids for nobody": {
+			src:    AccessConfig{Permission: AccessTypeNobody, CodeIDs: []uint64{1}},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestAccessConfigAllowedWithCodeIDs(t *testing.T) {
+	myAddr := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	otherAddr := sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+	cfg := AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{1, 3}}
+	ctx := sdk.Context{}.WithContext(context.Background())
+
+	specs := map[string]struct {
+		ctx sdk.Context
+		exp bool
+	}{
+		"authorized code id": {
+			ctx: WithCallerCodeID(ctx, myAddr, 3),
+			exp: true,
+		},
+		"other code id": {
+			ctx: WithCallerCodeID(ctx, myAddr, 2),
+		},
+		"code id of other address": {
+			ctx: WithCallerCodeID(ctx, otherAddr, 1),
+		},
+		"not a contract": {
+			ctx: ctx,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, spec.exp, cfg.Allowed(spec.ctx, myAddr))
+		})
+	}
+}
@@ -159,12 +159,6 @@ func TestValidateStoreCodeProposal(t *testing.T) {
 			}),
 			expErr: true,
 		},
-		"wasm code invalid": {
-			src: StoreCodeProposalFixture(func(p *StoreCodeProposal) {
-				p.WASMByteCode = bytes.Repeat([]byte{0x0}, MaxProposalWasmSize+1)
-			}),
-			expErr: true,
-		},
 		"with invalid instantiate permission": {
 			src: StoreCodeProposalFixture(func(p *StoreCodeProposal) {
 				p.InstantiatePermission = &AccessConfig{}
@@ -443,12 +437,6 @@ func TestValidateStoreAndInstantiateContractProposal(t *testing.T) {
 			}),
 			expErr: true,
 		},
-		"wasm code invalid": {
-			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
-				p.WASMByteCode = bytes.Repeat([]byte{0x0}, MaxProposalWasmSize+1)
-			}),
-			expErr: true,
-		},
 		"with invalid instantiate permission": {
 			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
 				p.InstantiatePermission = &AccessConfig{}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// DefaultMaxWasmSize is the default for the largest wasm code that can be stored
+	DefaultMaxWasmSize uint64 = 800 * 1024
+	// DefaultMaxProposalWasmSize is the default for the largest wasm code that can be stored by gov
+	DefaultMaxProposalWasmSize uint64 = 3 * 1024 * 1024
+	// DefaultMaxLabelSize is the default for the longest label that can be used when instantiating a contract
+	DefaultMaxLabelSize uint64 = 128
+	// DefaultMaxSaltSize is the default for the longest salt that can be used when instantiating a contract
+	DefaultMaxSaltSize uint64 = 64
+)
+
+// DefaultSizeLimitParams returns the default size limits
+func DefaultSizeLimitParams() SizeLimitParams {
+	return SizeLimitParams{
+		MaxWasmSize:         DefaultMaxWasmSize,
+		MaxProposalWasmSize: DefaultMaxProposalWasmSize,
+		MaxLabelSize:        DefaultMaxLabelSize,
+		MaxSaltSize:         DefaultMaxSaltSize,
+	}
+}
+
+// ValidateBasic performs basic validation
+func (p SizeLimitParams) ValidateBasic() error {
+	if p.MaxWasmSize == 0 {
+		return errorsmod.Wrap(ErrInvalid, "max wasm size must not be 0")
+	}
+	if p.MaxProposalWasmSize == 0 {
+		return errorsmod.Wrap(ErrInvalid, "max proposal wasm size must not be 0")
+	}
+	if p.MaxLabelSize == 0 {
+		return errorsmod.Wrap(ErrInvalid, "max label size must not be 0")
+	}
+	if p.MaxSaltSize == 0 {
+		return errorsmod.Wrap(ErrInvalid, "max salt size must not be 0")
+	}
+	return nil
+}
+
+// ValidateWasmSize ensures the wasm code does not exceed the max wasm size
+func (p SizeLimitParams) ValidateWasmSize(wasmCode []byte) error {
+	return ValidateWasmSize(wasmCode, p.MaxWasmSize)
+}
+
+// ValidateProposalWasmSize ensures the wasm code does not exceed the max proposal wasm size
+func (p SizeLimitParams) ValidateProposalWasmSize(wasmCode []byte) error {
+	return ValidateWasmSize(wasmCode, p.MaxProposalWasmSize)
+}
+
+// ValidateWasmSize ensures the wasm code does not exceed the given size
+func ValidateWasmSize(wasmCode []byte, maxSize uint64) error {
+	if uint64(len(wasmCode)) > maxSize {
+		return errorsmod.Wrapf(ErrLimit, "cannot be longer than %d bytes", maxSize)
+	}
+	return nil
+}
+
+// ValidateLabelSize ensures the label does not exceed the max label size
+func (p SizeLimitParams) ValidateLabelSize(label string) error {
+	if uint64(len(label)) > p.MaxLabelSize {
+		return ErrLimit.Wrapf("cannot be longer than %d characters", p.MaxLabelSize)
+	}
+	return nil
+}
+
+// ValidateSaltSize ensures the salt does not exceed the max salt size
+func (p SizeLimitParams) ValidateSaltSize(salt []byte) error {
+	if uint64(len(salt)) > p.MaxSaltSize {
+		return ErrLimit.Wrapf("cannot be longer than %d characters", p.MaxSaltSize)
+	}
+	return nil
+}
+
+// SizeLimitsOrDefault returns the size limit params or the default limits when not set
+func (p Params) SizeLimitsOrDefault() SizeLimitParams {
+	if p.SizeLimits == nil {
+		return DefaultSizeLimitParams()
+	}
+	return *p.SizeLimits
+}
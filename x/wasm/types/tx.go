@@ -62,7 +62,7 @@ func (msg MsgStoreCode) ValidateBasic() error {
 		return err
 	}
 
-	if err := validateWasmCode(msg.WASMByteCode, MaxWasmSize); err != nil {
+	if err := validateWasmCode(msg.WASMByteCode); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "code bytes %s", err.Error())
 	}
 
@@ -134,6 +134,26 @@ func (msg MsgInstantiateContract) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{senderAddr}
 }
 
+// GetMsg returns the init message send to the contract
+func (msg MsgInstantiateContract) GetMsg() RawContractMessage {
+	return msg.Msg
+}
+
+// GetFunds returns tokens send to the contract
+func (msg MsgInstantiateContract) GetFunds() sdk.Coins {
+	return msg.Funds
+}
+
+// GetCodeID returns the reference to the code that is instantiated
+func (msg MsgInstantiateContract) GetCodeID() uint64 {
+	return msg.CodeID
+}
+
+// GetAdmin returns the bech32 address of the contract admin
+func (msg MsgInstantiateContract) GetAdmin() string {
+	return msg.Admin
+}
+
 func (msg MsgExecuteContract) Route() string {
 	return RouterKey
 }
@@ -275,6 +295,11 @@ func (msg MsgUpdateAdmin) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{senderAddr}
 }
 
+// GetContract returns the bech32 address of the contract
+func (msg MsgUpdateAdmin) GetContract() string {
+	return msg.Contract
+}
+
 func (msg MsgClearAdmin) Route() string {
 	return RouterKey
 }
@@ -305,6 +330,11 @@ func (msg MsgClearAdmin) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{senderAddr}
 }
 
+// GetContract returns the bech32 address of the contract
+func (msg MsgClearAdmin) GetContract() string {
+	return msg.Contract
+}
+
 func (msg MsgIBCSend) Route() string {
 	return RouterKey
 }
@@ -398,6 +428,26 @@ func (msg MsgInstantiateContract2) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{senderAddr}
 }
 
+// GetMsg returns the init message send to the contract
+func (msg MsgInstantiateContract2) GetMsg() RawContractMessage {
+	return msg.Msg
+}
+
+// GetFunds returns tokens send to the contract
+func (msg MsgInstantiateContract2) GetFunds() sdk.Coins {
+	return msg.Funds
+}
+
+// GetCodeID returns the reference to the code that is instantiated
+func (msg MsgInstantiateContract2) GetCodeID() uint64 {
+	return msg.CodeID
+}
+
+// GetAdmin returns the bech32 address of the contract admin
+func (msg MsgInstantiateContract2) GetAdmin() string {
+	return msg.Admin
+}
+
 func (msg MsgUpdateInstantiateConfig) Route() string {
 	return RouterKey
 }
@@ -605,7 +655,7 @@ func (msg MsgStoreAndInstantiateContract) ValidateBasic() error {
 		return errorsmod.Wrap(err, "payload msg")
 	}
 
-	if err := validateWasmCode(msg.WASMByteCode, MaxWasmSize); err != nil {
+	if err := validateWasmCode(msg.WASMByteCode); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "code bytes %s", err.Error())
 	}
 
@@ -115,13 +115,6 @@ func TestInstantiateContractValidation(t *testing.T) {
 			},
 			valid: false,
 		},
-		"label too long": {
-			msg: MsgInstantiateContract{
-				Sender: goodAddress,
-				Label:  strings.Repeat("food", 33),
-			},
-			valid: false,
-		},
 		"bad sender minimal": {
 			msg: MsgInstantiateContract{
 				Sender: badAddress,
@@ -223,14 +216,6 @@ func TestInstantiateContract2Validation(t *testing.T) {
 			},
 			valid: false,
 		},
-		"label too long": {
-			msg: MsgInstantiateContract2{
-				Sender: goodAddress,
-				Label:  strings.Repeat("food", 33),
-				Salt:   []byte{0},
-			},
-			valid: false,
-		},
 		"bad sender minimal": {
 			msg: MsgInstantiateContract2{
 				Sender: badAddress,
@@ -245,10 +230,10 @@ func TestInstantiateContract2Validation(t *testing.T) {
 			msg: MsgInstantiateContract2{
 				Sender: goodAddress,
 				CodeID: firstCodeID,
-				Label:  strings.Repeat("a", MaxLabelSize),
+				Label:  strings.Repeat("a", int(DefaultMaxLabelSize)),
 				Msg:    []byte(`{"some": "data"}`),
 				Funds:  sdk.Coins{sdk.Coin{Denom: "foobar", Amount: sdk.NewInt(200)}},
-				Salt:   bytes.Repeat([]byte{0}, MaxSaltSize),
+				Salt:   bytes.Repeat([]byte{0}, int(DefaultMaxSaltSize)),
 				FixMsg: true,
 			},
 			valid: true,
@@ -293,16 +278,6 @@ func TestInstantiateContract2Validation(t *testing.T) {
 			},
 			valid: false,
 		},
-		"salt too long": {
-			msg: MsgInstantiateContract2{
-				Sender: goodAddress,
-				CodeID: firstCodeID,
-				Label:  "foo",
-				Msg:    []byte(`{"some": "data"}`),
-				Salt:   bytes.Repeat([]byte{0}, 65),
-			},
-			valid: false,
-		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -1087,15 +1062,6 @@ func TestMsgStoreAndInstantiateContractValidation(t *testing.T) {
 			},
 			valid: false,
 		},
-		"label too long": {
-			msg: MsgStoreAndInstantiateContract{
-				Authority:    goodAddress,
-				Label:        strings.Repeat("food", 33),
-				Msg:          []byte("{}"),
-				WASMByteCode: []byte("foo"),
-			},
-			valid: false,
-		},
 		"bad sender minimal": {
 			msg: MsgStoreAndInstantiateContract{
 				Authority:    badAddress,
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	"github.com/cosmos/gogoproto/proto"
@@ -321,12 +322,50 @@ type WasmConfig struct {
 	SimulationGasLimit *uint64 `mapstructure:"simulation_gas_limit"`
 	// SmartQueryGasLimit is the max gas to be used in a smart query contract call
 	SmartQueryGasLimit uint64 `mapstructure:"query_gas_limit"`
+	// MaxConcurrentSmartQueries is the max number of smart queries via gRPC that are executed in parallel on this node.
+	// Unlimited when 0
+	MaxConcurrentSmartQueries uint32 `mapstructure:"max_concurrent_smart_queries"`
+	// SmartQueryTimeout is the max wall-clock time of a smart query via gRPC on this node. Disabled when 0
+	SmartQueryTimeout time.Duration `mapstructure:"smart_query_timeout"`
+	// SmartQueryCacheSize in MiB for the node local cache of smart query results via gRPC. Disabled when 0
+	SmartQueryCacheSize uint32 `mapstructure:"smart_query_cache_size"`
+	// CodeUsageTracking counts contract instantiations and executions per code in a node local database
+	// for pin recommendations
+	CodeUsageTracking bool `mapstructure:"code_usage_tracking"`
+	// CacheWarmupWorkers is the number of workers that compile all unpinned codes into the wasmvm file cache
+	// in the background on startup. Codes that were compiled by the same libwasmvm version
+	// before are skipped. Disabled when 0
+	CacheWarmupWorkers uint32 `mapstructure:"cache_warmup_workers"`
 	// MemoryCacheSize in MiB not bytes
 	MemoryCacheSize uint32 `mapstructure:"memory_cache_size"`
+	// QueryMemoryCacheSize in MiB not bytes. When set, a second wasmvm instance with its own memory cache of this size
+	// and its own data directory `wasm-query` is used for smart queries via gRPC. Disabled when 0.
+	QueryMemoryCacheSize uint32 `mapstructure:"query_memory_cache_size"`
 	// ContractDebugMode log what contract print
 	ContractDebugMode bool
+	// GasTracing enables a node local call tree of all wasm operations with their gas consumption for
+	// tx simulations. The trace is returned as event with the simulation result.
+	GasTracing bool `mapstructure:"gas_tracing"`
+	// GasTraceFile when set, the gas traces of all delivered txs are appended as json lines to this file
+	GasTraceFile string `mapstructure:"gas_trace_file"`
+	// MetricsMaxCodeIDLabels is the max number of distinct code ids used as label in the contract execution metrics.
+	// Other code ids are reported as "other". The code id label is not used when set to 0.
+	MetricsMaxCodeIDLabels uint32 `mapstructure:"metrics_max_code_id_labels"`
+	// TracingExporter enables OpenTelemetry spans for the wasm operations when set. Supported values
+	// are "otlp" and "stdout"
+	TracingExporter string `mapstructure:"tracing_exporter"`
+	// TracingOTLPEndpoint is the host and port of the OTLP gRPC collector. The exporter default is used when empty
+	TracingOTLPEndpoint string `mapstructure:"tracing_otlp_endpoint"`
+	// TracingOTLPInsecure disables the client transport security for the OTLP exporter
+	TracingOTLPInsecure bool `mapstructure:"tracing_otlp_insecure"`
 }
 
+// Supported span exporters for the wasm config
+const (
+	TracingExporterOTLP   = "otlp"
+	TracingExporterStdout = "stdout"
+)
+
 // DefaultWasmConfig returns the default settings for WasmConfig
 func DefaultWasmConfig() WasmConfig {
 	return WasmConfig{
@@ -353,14 +392,56 @@ func ConfigTemplate(c WasmConfig) string {
 # Smart query gas limit is the max gas to be used in a smart query contract call
 query_gas_limit = %d
 
+# The max number of smart queries via gRPC that are executed in parallel on this node. Unlimited when 0.
+max_concurrent_smart_queries = %d
+
+# The max wall-clock time of a smart query via gRPC on this node, for example "5s". Disabled when 0.
+smart_query_timeout = "%s"
+
+# The size in MiB of the node local cache for smart query results via gRPC. Entries are dropped on every new block. Disabled when 0.
+smart_query_cache_size = %d
+
+# Count the contract instantiations and executions per code on this node for pin recommendations.
+code_usage_tracking = %t
+
+# The number of workers that compile all unpinned codes into the wasmvm file cache in the background on startup. Disabled when 0.
+cache_warmup_workers = %d
+
 # in-memory cache for Wasm contracts. Set to 0 to disable.
 # The value is in MiB not bytes
 memory_cache_size = %d
 
+# When set, a second wasmvm instance with its own in-memory cache of this size is used for smart queries
+# outside of block execution. The value is in MiB not bytes. Set to 0 to disable.
+query_memory_cache_size = %d
+
 # Simulation gas limit is the max gas to be used in a tx simulation call.
 # When not set the consensus max block gas is used instead
 %s
-`, c.SmartQueryGasLimit, c.MemoryCacheSize, simGasLimit)
+
+# Gas tracing records a call tree of the wasm operations with their gas consumption.
+# The trace is node local and returned as event in tx simulations only.
+gas_tracing = %t
+
+# When set, the gas traces of all delivered txs are appended as json lines to this file.
+gas_trace_file = %q
+
+# The max number of distinct code ids used as label in the contract execution metrics.
+# Other code ids are reported as "other". The code id label is not used when set to 0.
+metrics_max_code_id_labels = %d
+
+# OpenTelemetry spans for the wasm operations are exported when set. Supported values are "otlp" and "stdout".
+tracing_exporter = %q
+
+# The host and port of the OTLP gRPC collector. The exporter default is used when empty.
+tracing_otlp_endpoint = %q
+
+# Disables the client transport security for the OTLP exporter.
+tracing_otlp_insecure = %t
+`, c.SmartQueryGasLimit, c.MaxConcurrentSmartQueries, c.SmartQueryTimeout, c.SmartQueryCacheSize, c.CodeUsageTracking, c.CacheWarmupWorkers,
+		c.MemoryCacheSize, c.QueryMemoryCacheSize,
+		simGasLimit, c.GasTracing, c.GasTraceFile, c.MetricsMaxCodeIDLabels,
+		c.TracingExporter, c.TracingOTLPEndpoint, c.TracingOTLPInsecure)
 }
 
 // VerifyAddressLen ensures that the address matches the expected length
@@ -386,18 +467,58 @@ func (a AccessType) IsSubset(superSet AccessType) bool {
 	case AccessTypeAnyOfAddresses:
 		// Nobody or address(es)
 		return a == AccessTypeNobody || a == AccessTypeAnyOfAddresses
+	case AccessTypeAnyOfCodeIDs:
+		// Nobody or code id(s)
+		return a == AccessTypeNobody || a == AccessTypeAnyOfCodeIDs
 	default:
 		return false
 	}
 }
 
 // IsSubset will return true if the caller is the same as the superset,
-// or if the caller is more restrictive than the superset.
-func (a AccessConfig) IsSubset(superSet AccessConfig) bool {
+// or if the caller is more restrictive than the superset at the block of the context.
+// Expired addresses of the caller are ignored. Addresses that expire in the superset must expire
+// no later in the caller.
+func (a AccessConfig) IsSubset(ctx sdk.Context, superSet AccessConfig) bool {
 	switch superSet.Permission {
 	case AccessTypeAnyOfAddresses:
 		// An exact match or nobody
-		return a.Permission == AccessTypeNobody || a.Permission == AccessTypeAnyOfAddresses && isSubset(superSet.Addresses, a.Addresses)
+		if a.Permission == AccessTypeNobody {
+			return true
+		}
+		if a.Permission != AccessTypeAnyOfAddresses {
+			return false
+		}
+		for _, addr := range a.Addresses {
+			if a.isExpired(ctx, addr) {
+				continue
+			}
+			if !containsAddress(superSet.Addresses, addr) || superSet.isExpired(ctx, addr) {
+				return false
+			}
+			superExpiry, ok := superSet.Expiry(addr)
+			if !ok {
+				continue
+			}
+			if expiry, ok := a.Expiry(addr); !ok || !expiry.expiresNotAfter(superExpiry) {
+				return false
+			}
+		}
+		return true
+	case AccessTypeAnyOfCodeIDs:
+		// Nobody or a subset of the code ids
+		if a.Permission == AccessTypeNobody {
+			return true
+		}
+		if a.Permission != AccessTypeAnyOfCodeIDs {
+			return false
+		}
+		for _, id := range a.CodeIDs {
+			if !containsCodeID(superSet.CodeIDs, id) {
+				return false
+			}
+		}
+		return true
 	case AccessTypeUnspecified:
 		return false
 	default:
@@ -405,21 +526,13 @@ func (a AccessConfig) IsSubset(superSet AccessConfig) bool {
 	}
 }
 
-// return true when all elements in sub are also part of super
-func isSubset(super, sub []string) bool {
-	if len(sub) == 0 {
-		return true
-	}
-	var matches int
-	for _, o := range sub {
-		for _, s := range super {
-			if o == s {
-				matches++
-				break
-			}
+func containsCodeID(codeIDs []uint64, codeID uint64) bool {
+	for _, v := range codeIDs {
+		if v == codeID {
+			return true
 		}
 	}
-	return matches == len(sub)
+	return false
 }
 
 // AllAuthorizedAddresses returns the list of authorized addresses. Can be empty.
@@ -9,13 +9,19 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	github_com_cometbft_cometbft_libs_bytes "github.com/cometbft/cometbft/libs/bytes"
 	_ "github.com/cosmos/cosmos-proto"
-	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -23,6 +29,7 @@ var (
 	_ = proto.Marshal
 	_ = fmt.Errorf
 	_ = math.Inf
+	_ = time.Kitchen
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -43,6 +50,8 @@ const (
 	AccessTypeEverybody AccessType = 3
 	// AccessTypeAnyOfAddresses allow any of the addresses
 	AccessTypeAnyOfAddresses AccessType = 4
+	// AccessTypeAnyOfCodeIDs allow any contract instance of the code ids
+	AccessTypeAnyOfCodeIDs AccessType = 5
 )
 
 var AccessType_name = map[int32]string{
@@ -50,6 +59,7 @@ var AccessType_name = map[int32]string{
 	1: "ACCESS_TYPE_NOBODY",
 	3: "ACCESS_TYPE_EVERYBODY",
 	4: "ACCESS_TYPE_ANY_OF_ADDRESSES",
+	5: "ACCESS_TYPE_ANY_OF_CODE_IDS",
 }
 
 var AccessType_value = map[string]int32{
@@ -57,12 +67,41 @@ var AccessType_value = map[string]int32{
 	"ACCESS_TYPE_NOBODY":           1,
 	"ACCESS_TYPE_EVERYBODY":        3,
 	"ACCESS_TYPE_ANY_OF_ADDRESSES": 4,
+	"ACCESS_TYPE_ANY_OF_CODE_IDS":  5,
 }
 
 func (AccessType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e6155d98fa173e02, []int{0}
 }
 
+// FeeDestination defines where the collected fees go to
+type FeeDestination int32
+
+const (
+	// FeeDestinationCommunityPool fees are sent to the community pool
+	FeeDestinationCommunityPool FeeDestination = 0
+	// FeeDestinationBurn fees are burned
+	FeeDestinationBurn FeeDestination = 1
+)
+
+var FeeDestination_name = map[int32]string{
+	0: "FEE_DESTINATION_COMMUNITY_POOL",
+	1: "FEE_DESTINATION_BURN",
+}
+
+var FeeDestination_value = map[string]int32{
+	"FEE_DESTINATION_COMMUNITY_POOL": 0,
+	"FEE_DESTINATION_BURN":           1,
+}
+
+func (x FeeDestination) String() string {
+	return proto.EnumName(FeeDestination_name, int32(x))
+}
+
+func (FeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{1}
+}
+
 // ContractCodeHistoryOperationType actions that caused a code change
 type ContractCodeHistoryOperationType int32
 
@@ -96,7 +135,7 @@ func (x ContractCodeHistoryOperationType) String() string {
 }
 
 func (ContractCodeHistoryOperationType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{1}
+	return fileDescriptor_e6155d98fa173e02, []int{2}
 }
 
 // AccessTypeParam
@@ -146,6 +185,15 @@ var xxx_messageInfo_AccessTypeParam proto.InternalMessageInfo
 type AccessConfig struct {
 	Permission AccessType `protobuf:"varint,1,opt,name=permission,proto3,enum=cosmwasm.wasm.v1.AccessType" json:"permission,omitempty" yaml:"permission"`
 	Addresses  []string   `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
+	// Expiries of the addresses. Addresses without an expiry do not expire.
+	Expiries []AccessExpiry `protobuf:"bytes,4,rep,name=expiries,proto3" json:"expiries" yaml:"expiries"`
+	// CodeIDs of the contracts that are allowed with AccessTypeAnyOfCodeIDs
+	CodeIDs []uint64 `protobuf:"varint,5,rep,packed,name=code_ids,json=codeIds,proto3" json:"code_ids,omitempty" yaml:"code_ids"`
+	// Constraints on the contracts instantiated from a code. Only supported for
+	// the instantiate config of a code. Optional. On an update of the
+	// instantiate config, the existing constraints are kept when not set and
+	// removed when set empty. Loosening them requires gov
+	Constraints *InstantiateConstraints `protobuf:"bytes,6,opt,name=constraints,proto3" json:"constraints,omitempty" yaml:"constraints"`
 }
 
 func (m *AccessConfig) Reset()         { *m = AccessConfig{} }
@@ -186,16 +234,139 @@ func (m *AccessConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AccessConfig proto.InternalMessageInfo
 
+// InstantiateConstraints restrict the admin and the funds of new contract
+// instances. They are enforced for every instantiation, including the ones
+// authored by gov. The admin constraints are also enforced on admin updates
+// and admin clearing of the contract instances. They are not enforced on a
+// migration to the code.
+type InstantiateConstraints struct {
+	// NoAdmin requires contracts to be instantiated without an admin so that
+	// they are immutable
+	NoAdmin bool `protobuf:"varint,1,opt,name=no_admin,json=noAdmin,proto3" json:"no_admin,omitempty" yaml:"no_admin"`
+	// Admin is the address that contracts must be instantiated with as admin.
+	// Optional
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// MaxFunds is the max amount of funds that can be sent to a contract on
+	// instantiation. Optional, not limited when empty
+	MaxFunds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=max_funds,json=maxFunds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_funds" yaml:"max_funds"`
+}
+
+func (m *InstantiateConstraints) Reset()         { *m = InstantiateConstraints{} }
+func (m *InstantiateConstraints) String() string { return proto.CompactTextString(m) }
+func (*InstantiateConstraints) ProtoMessage()    {}
+func (*InstantiateConstraints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{2}
+}
+
+func (m *InstantiateConstraints) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *InstantiateConstraints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InstantiateConstraints.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *InstantiateConstraints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstantiateConstraints.Merge(m, src)
+}
+
+func (m *InstantiateConstraints) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *InstantiateConstraints) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstantiateConstraints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstantiateConstraints proto.InternalMessageInfo
+
+// AccessExpiry defines when an address of an AccessConfig loses its
+// permission. It expires with the first block that reaches the time or the
+// height.
+type AccessExpiry struct {
+	// Address of the AccessConfig that expires
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// Time is the block time from which the address is not allowed anymore.
+	// Optional
+	Time *time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time,omitempty" yaml:"time"`
+	// Height is the block height from which the address is not allowed
+	// anymore. Not set when 0
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+}
+
+func (m *AccessExpiry) Reset()         { *m = AccessExpiry{} }
+func (m *AccessExpiry) String() string { return proto.CompactTextString(m) }
+func (*AccessExpiry) ProtoMessage()    {}
+func (*AccessExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{3}
+}
+
+func (m *AccessExpiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *AccessExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessExpiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *AccessExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessExpiry.Merge(m, src)
+}
+
+func (m *AccessExpiry) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *AccessExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessExpiry proto.InternalMessageInfo
+
 // Params defines the set of wasm parameters.
 type Params struct {
 	CodeUploadAccess             AccessConfig `protobuf:"bytes,1,opt,name=code_upload_access,json=codeUploadAccess,proto3" json:"code_upload_access" yaml:"code_upload_access"`
 	InstantiateDefaultPermission AccessType   `protobuf:"varint,2,opt,name=instantiate_default_permission,json=instantiateDefaultPermission,proto3,enum=cosmwasm.wasm.v1.AccessType" json:"instantiate_default_permission,omitempty" yaml:"instantiate_default_permission"`
+	// GasRegister costs charged for wasm operations. When not set the default
+	// costs are used.
+	GasRegister *GasRegisterParams `protobuf:"bytes,3,opt,name=gas_register,json=gasRegister,proto3" json:"gas_register,omitempty" yaml:"gas_register"`
+	// Capabilities enabled for contracts on chain. When not set in genesis, they
+	// are set to the capabilities of the wasmvm. Updates must only enable
+	// capabilities that the wasmvm supports.
+	Capabilities *CapabilityParams `protobuf:"bytes,4,opt,name=capabilities,proto3" json:"capabilities,omitempty" yaml:"capabilities"`
+	// Fees charged for code uploads and contract instantiations. When not set no
+	// fees are charged.
+	Fees *FeeParams `protobuf:"bytes,5,opt,name=fees,proto3" json:"fees,omitempty" yaml:"fees"`
+	// SizeLimits for wasm code, labels and salts. When not set the default
+	// limits are used.
+	SizeLimits *SizeLimitParams `protobuf:"bytes,6,opt,name=size_limits,json=sizeLimits,proto3" json:"size_limits,omitempty" yaml:"size_limits"`
+	// UploadQuota limits the code uploads per creator. When not set the uploads
+	// are not limited.
+	UploadQuota *UploadQuotaParams `protobuf:"bytes,7,opt,name=upload_quota,json=uploadQuota,proto3" json:"upload_quota,omitempty" yaml:"upload_quota"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{2}
+	return fileDescriptor_e6155d98fa173e02, []int{4}
 }
 
 func (m *Params) XXX_Unmarshal(b []byte) error {
@@ -229,6 +400,285 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// UploadQuotaParams defines the max number of codes and bytes that an address
+// can upload within a rolling window. Uploads authored by gov are exempt.
+type UploadQuotaParams struct {
+	// MaxCodes is the max number of codes uploaded within the window. Not
+	// limited when 0
+	MaxCodes uint64 `protobuf:"varint,1,opt,name=max_codes,json=maxCodes,proto3" json:"max_codes,omitempty" yaml:"max_codes"`
+	// MaxBytes is the max sum of the uncompressed wasm code sizes uploaded within
+	// the window. Not limited when 0
+	MaxBytes uint64 `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty" yaml:"max_bytes"`
+	// Window is the duration in block time that an upload counts against the
+	// quota
+	Window time.Duration `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window" yaml:"window"`
+	// ExemptAddresses are not limited by the quota
+	ExemptAddresses []string `protobuf:"bytes,4,rep,name=exempt_addresses,json=exemptAddresses,proto3" json:"exempt_addresses,omitempty" yaml:"exempt_addresses"`
+}
+
+func (m *UploadQuotaParams) Reset()         { *m = UploadQuotaParams{} }
+func (m *UploadQuotaParams) String() string { return proto.CompactTextString(m) }
+func (*UploadQuotaParams) ProtoMessage()    {}
+func (*UploadQuotaParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{5}
+}
+
+func (m *UploadQuotaParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *UploadQuotaParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UploadQuotaParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *UploadQuotaParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadQuotaParams.Merge(m, src)
+}
+
+func (m *UploadQuotaParams) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *UploadQuotaParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadQuotaParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadQuotaParams proto.InternalMessageInfo
+
+// SizeLimitParams defines the size limits that are enforced for new codes and
+// contracts
+type SizeLimitParams struct {
+	// MaxWasmSize is the largest wasm code in bytes that can be stored. Gzipped
+	// code is limited before and after uncompressing.
+	MaxWasmSize uint64 `protobuf:"varint,1,opt,name=max_wasm_size,json=maxWasmSize,proto3" json:"max_wasm_size,omitempty" yaml:"max_wasm_size"`
+	// MaxProposalWasmSize is the largest wasm code in bytes that can be stored
+	// by gov, including legacy proposals. Gzipped code is limited before and
+	// after uncompressing.
+	MaxProposalWasmSize uint64 `protobuf:"varint,2,opt,name=max_proposal_wasm_size,json=maxProposalWasmSize,proto3" json:"max_proposal_wasm_size,omitempty" yaml:"max_proposal_wasm_size"`
+	// MaxLabelSize is the longest label that can be used when instantiating a
+	// contract
+	MaxLabelSize uint64 `protobuf:"varint,3,opt,name=max_label_size,json=maxLabelSize,proto3" json:"max_label_size,omitempty" yaml:"max_label_size"`
+	// MaxSaltSize is the longest salt that can be used when instantiating a
+	// contract with a predictable address
+	MaxSaltSize uint64 `protobuf:"varint,4,opt,name=max_salt_size,json=maxSaltSize,proto3" json:"max_salt_size,omitempty" yaml:"max_salt_size"`
+}
+
+func (m *SizeLimitParams) Reset()         { *m = SizeLimitParams{} }
+func (m *SizeLimitParams) String() string { return proto.CompactTextString(m) }
+func (*SizeLimitParams) ProtoMessage()    {}
+func (*SizeLimitParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{6}
+}
+
+func (m *SizeLimitParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SizeLimitParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SizeLimitParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *SizeLimitParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SizeLimitParams.Merge(m, src)
+}
+
+func (m *SizeLimitParams) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SizeLimitParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SizeLimitParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SizeLimitParams proto.InternalMessageInfo
+
+// CapabilityParams defines the wasmvm capabilities that contracts can require.
+// See
+// https://github.com/CosmWasm/cosmwasm/blob/main/docs/CAPABILITIES-BUILT-IN.md
+type CapabilityParams struct {
+	// Enabled capabilities. Every capability must be supported by the wasmvm of
+	// the node.
+	Enabled []string `protobuf:"bytes,1,rep,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+}
+
+func (m *CapabilityParams) Reset()         { *m = CapabilityParams{} }
+func (m *CapabilityParams) String() string { return proto.CompactTextString(m) }
+func (*CapabilityParams) ProtoMessage()    {}
+func (*CapabilityParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{7}
+}
+
+func (m *CapabilityParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CapabilityParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CapabilityParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *CapabilityParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapabilityParams.Merge(m, src)
+}
+
+func (m *CapabilityParams) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *CapabilityParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapabilityParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CapabilityParams proto.InternalMessageInfo
+
+// GasRegisterParams defines the gas costs for wasm operations. All costs are
+// in Cosmos SDK gas units.
+type GasRegisterParams struct {
+	// InstanceCost is charged each time a wasm instance is loaded for an unpinned
+	// code
+	InstanceCost uint64 `protobuf:"varint,1,opt,name=instance_cost,json=instanceCost,proto3" json:"instance_cost,omitempty" yaml:"instance_cost"`
+	// CompileCost is charged per byte to persist and compile new wasm code
+	CompileCost uint64 `protobuf:"varint,2,opt,name=compile_cost,json=compileCost,proto3" json:"compile_cost,omitempty" yaml:"compile_cost"`
+	// UncompressCostNumerator is the numerator of the costs charged per byte to
+	// unpack gzipped wasm code
+	UncompressCostNumerator uint64 `protobuf:"varint,3,opt,name=uncompress_cost_numerator,json=uncompressCostNumerator,proto3" json:"uncompress_cost_numerator,omitempty" yaml:"uncompress_cost_numerator"`
+	// UncompressCostDenominator is the denominator of the costs charged per byte
+	// to unpack gzipped wasm code
+	UncompressCostDenominator uint64 `protobuf:"varint,4,opt,name=uncompress_cost_denominator,json=uncompressCostDenominator,proto3" json:"uncompress_cost_denominator,omitempty" yaml:"uncompress_cost_denominator"`
+	// GasMultiplier is how many CosmWasm gas points = 1 Cosmos SDK gas point.
+	// The address conversion and json deserialization costs of the contract
+	// API are converted with it so that they stay the same in SDK gas
+	GasMultiplier uint64 `protobuf:"varint,5,opt,name=gas_multiplier,json=gasMultiplier,proto3" json:"gas_multiplier,omitempty" yaml:"gas_multiplier"`
+	// EventPerAttributeCost is charged per attribute in events
+	EventPerAttributeCost uint64 `protobuf:"varint,6,opt,name=event_per_attribute_cost,json=eventPerAttributeCost,proto3" json:"event_per_attribute_cost,omitempty" yaml:"event_per_attribute_cost"`
+	// EventAttributeDataCost is charged per byte of attribute data in events
+	EventAttributeDataCost uint64 `protobuf:"varint,7,opt,name=event_attribute_data_cost,json=eventAttributeDataCost,proto3" json:"event_attribute_data_cost,omitempty" yaml:"event_attribute_data_cost"`
+	// EventAttributeDataFreeTier is the number of bytes of total attribute data
+	// that is free of charge
+	EventAttributeDataFreeTier uint64 `protobuf:"varint,8,opt,name=event_attribute_data_free_tier,json=eventAttributeDataFreeTier,proto3" json:"event_attribute_data_free_tier,omitempty" yaml:"event_attribute_data_free_tier"`
+	// ContractMessageDataCost is charged per byte of the message that goes to
+	// the contract
+	ContractMessageDataCost uint64 `protobuf:"varint,9,opt,name=contract_message_data_cost,json=contractMessageDataCost,proto3" json:"contract_message_data_cost,omitempty" yaml:"contract_message_data_cost"`
+	// CustomEventCost is charged per custom event
+	CustomEventCost uint64 `protobuf:"varint,10,opt,name=custom_event_cost,json=customEventCost,proto3" json:"custom_event_cost,omitempty" yaml:"custom_event_cost"`
+}
+
+func (m *GasRegisterParams) Reset()         { *m = GasRegisterParams{} }
+func (m *GasRegisterParams) String() string { return proto.CompactTextString(m) }
+func (*GasRegisterParams) ProtoMessage()    {}
+func (*GasRegisterParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{8}
+}
+
+func (m *GasRegisterParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *GasRegisterParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasRegisterParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *GasRegisterParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasRegisterParams.Merge(m, src)
+}
+
+func (m *GasRegisterParams) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *GasRegisterParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasRegisterParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasRegisterParams proto.InternalMessageInfo
+
+// FeeParams defines the fees charged for code uploads and contract
+// instantiations. Uploads and instantiations authored by gov are exempt.
+type FeeParams struct {
+	// UploadFlat is the fee charged for each code upload
+	UploadFlat github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=upload_flat,json=uploadFlat,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"upload_flat" yaml:"upload_flat"`
+	// UploadPerByte is the fee charged per byte of the uncompressed wasm code.
+	// The total is rounded up to whole coins.
+	UploadPerByte github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=upload_per_byte,json=uploadPerByte,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"upload_per_byte" yaml:"upload_per_byte"`
+	// Instantiate is the fee charged for each contract instantiation
+	Instantiate github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=instantiate,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"instantiate" yaml:"instantiate"`
+	// Destination of the collected fees
+	Destination FeeDestination `protobuf:"varint,4,opt,name=destination,proto3,enum=cosmwasm.wasm.v1.FeeDestination" json:"destination,omitempty" yaml:"destination"`
+}
+
+func (m *FeeParams) Reset()         { *m = FeeParams{} }
+func (m *FeeParams) String() string { return proto.CompactTextString(m) }
+func (*FeeParams) ProtoMessage()    {}
+func (*FeeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{9}
+}
+
+func (m *FeeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *FeeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *FeeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeParams.Merge(m, src)
+}
+
+func (m *FeeParams) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *FeeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeParams proto.InternalMessageInfo
+
 // CodeInfo is data for the uploaded contract WASM code
 type CodeInfo struct {
 	// CodeHash is the unique identifier created by wasmvm
@@ -243,7 +693,7 @@ func (m *CodeInfo) Reset()         { *m = CodeInfo{} }
 func (m *CodeInfo) String() string { return proto.CompactTextString(m) }
 func (*CodeInfo) ProtoMessage()    {}
 func (*CodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{3}
+	return fileDescriptor_e6155d98fa173e02, []int{10}
 }
 
 func (m *CodeInfo) XXX_Unmarshal(b []byte) error {
@@ -277,6 +727,58 @@ func (m *CodeInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CodeInfo proto.InternalMessageInfo
 
+// CodeAnalysis is the static analysis result of a stored wasm code
+type CodeAnalysis struct {
+	// HasIBCEntryPoints is true when the code exports all IBC entry points
+	HasIBCEntryPoints bool `protobuf:"varint,1,opt,name=has_ibc_entry_points,json=hasIbcEntryPoints,proto3" json:"has_ibc_entry_points,omitempty"`
+	// RequiredCapabilities are the capabilities that the code requires from the
+	// chain, sorted
+	RequiredCapabilities []string `protobuf:"bytes,2,rep,name=required_capabilities,json=requiredCapabilities,proto3" json:"required_capabilities,omitempty"`
+	// Entrypoints are the contract entry points exported by the code, for
+	// example "migrate", sorted
+	Entrypoints []string `protobuf:"bytes,3,rep,name=entrypoints,proto3" json:"entrypoints,omitempty"`
+	// CodeSize is the size of the uncompressed wasm code in bytes
+	CodeSize uint64 `protobuf:"varint,4,opt,name=code_size,json=codeSize,proto3" json:"code_size,omitempty"`
+}
+
+func (m *CodeAnalysis) Reset()         { *m = CodeAnalysis{} }
+func (m *CodeAnalysis) String() string { return proto.CompactTextString(m) }
+func (*CodeAnalysis) ProtoMessage()    {}
+func (*CodeAnalysis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{11}
+}
+
+func (m *CodeAnalysis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CodeAnalysis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeAnalysis.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *CodeAnalysis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeAnalysis.Merge(m, src)
+}
+
+func (m *CodeAnalysis) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *CodeAnalysis) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeAnalysis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeAnalysis proto.InternalMessageInfo
+
 // ContractInfo stores a WASM contract instance
 type ContractInfo struct {
 	// CodeID is the reference to the stored Wasm code
@@ -292,14 +794,14 @@ type ContractInfo struct {
 	IBCPortID string              `protobuf:"bytes,6,opt,name=ibc_port_id,json=ibcPortId,proto3" json:"ibc_port_id,omitempty"`
 	// Extension is an extension point to store custom metadata within the
 	// persistence model.
-	Extension *types.Any `protobuf:"bytes,7,opt,name=extension,proto3" json:"extension,omitempty"`
+	Extension *types1.Any `protobuf:"bytes,7,opt,name=extension,proto3" json:"extension,omitempty"`
 }
 
 func (m *ContractInfo) Reset()         { *m = ContractInfo{} }
 func (m *ContractInfo) String() string { return proto.CompactTextString(m) }
 func (*ContractInfo) ProtoMessage()    {}
 func (*ContractInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{4}
+	return fileDescriptor_e6155d98fa173e02, []int{12}
 }
 
 func (m *ContractInfo) XXX_Unmarshal(b []byte) error {
@@ -347,7 +849,7 @@ func (m *ContractCodeHistoryEntry) Reset()         { *m = ContractCodeHistoryEnt
 func (m *ContractCodeHistoryEntry) String() string { return proto.CompactTextString(m) }
 func (*ContractCodeHistoryEntry) ProtoMessage()    {}
 func (*ContractCodeHistoryEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{5}
+	return fileDescriptor_e6155d98fa173e02, []int{13}
 }
 
 func (m *ContractCodeHistoryEntry) XXX_Unmarshal(b []byte) error {
@@ -395,7 +897,7 @@ func (m *AbsoluteTxPosition) Reset()         { *m = AbsoluteTxPosition{} }
 func (m *AbsoluteTxPosition) String() string { return proto.CompactTextString(m) }
 func (*AbsoluteTxPosition) ProtoMessage()    {}
 func (*AbsoluteTxPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{6}
+	return fileDescriptor_e6155d98fa173e02, []int{14}
 }
 
 func (m *AbsoluteTxPosition) XXX_Unmarshal(b []byte) error {
@@ -441,7 +943,7 @@ func (m *Model) Reset()         { *m = Model{} }
 func (m *Model) String() string { return proto.CompactTextString(m) }
 func (*Model) ProtoMessage()    {}
 func (*Model) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{7}
+	return fileDescriptor_e6155d98fa173e02, []int{15}
 }
 
 func (m *Model) XXX_Unmarshal(b []byte) error {
@@ -477,11 +979,20 @@ var xxx_messageInfo_Model proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterEnum("cosmwasm.wasm.v1.AccessType", AccessType_name, AccessType_value)
+	proto.RegisterEnum("cosmwasm.wasm.v1.FeeDestination", FeeDestination_name, FeeDestination_value)
 	proto.RegisterEnum("cosmwasm.wasm.v1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
 	proto.RegisterType((*AccessTypeParam)(nil), "cosmwasm.wasm.v1.AccessTypeParam")
 	proto.RegisterType((*AccessConfig)(nil), "cosmwasm.wasm.v1.AccessConfig")
+	proto.RegisterType((*InstantiateConstraints)(nil), "cosmwasm.wasm.v1.InstantiateConstraints")
+	proto.RegisterType((*AccessExpiry)(nil), "cosmwasm.wasm.v1.AccessExpiry")
 	proto.RegisterType((*Params)(nil), "cosmwasm.wasm.v1.Params")
+	proto.RegisterType((*UploadQuotaParams)(nil), "cosmwasm.wasm.v1.UploadQuotaParams")
+	proto.RegisterType((*SizeLimitParams)(nil), "cosmwasm.wasm.v1.SizeLimitParams")
+	proto.RegisterType((*CapabilityParams)(nil), "cosmwasm.wasm.v1.CapabilityParams")
+	proto.RegisterType((*GasRegisterParams)(nil), "cosmwasm.wasm.v1.GasRegisterParams")
+	proto.RegisterType((*FeeParams)(nil), "cosmwasm.wasm.v1.FeeParams")
 	proto.RegisterType((*CodeInfo)(nil), "cosmwasm.wasm.v1.CodeInfo")
+	proto.RegisterType((*CodeAnalysis)(nil), "cosmwasm.wasm.v1.CodeAnalysis")
 	proto.RegisterType((*ContractInfo)(nil), "cosmwasm.wasm.v1.ContractInfo")
 	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "cosmwasm.wasm.v1.ContractCodeHistoryEntry")
 	proto.RegisterType((*AbsoluteTxPosition)(nil), "cosmwasm.wasm.v1.AbsoluteTxPosition")
@@ -491,80 +1002,168 @@ func init() {
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/types.proto", fileDescriptor_e6155d98fa173e02) }
 
 var fileDescriptor_e6155d98fa173e02 = []byte{
-	// 1167 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xda, 0x4e, 0x62, 0x4f, 0x03, 0x6c, 0x87, 0x94, 0x3a, 0x26, 0xb2, 0xcd, 0x52, 0x4a,
-	0x9a, 0xb6, 0x76, 0x1b, 0x10, 0x87, 0x1e, 0x2a, 0x79, 0xed, 0x6d, 0xb3, 0x95, 0x62, 0x5b, 0x63,
-	0x97, 0x12, 0xa4, 0xb2, 0xda, 0x1f, 0x63, 0x67, 0x55, 0x7b, 0xc7, 0xda, 0x19, 0xa7, 0xde, 0xff,
-	0x00, 0x59, 0x42, 0xe2, 0xc0, 0x81, 0x8b, 0x25, 0xa4, 0x22, 0xe8, 0x91, 0x03, 0x7f, 0x44, 0xc5,
-	0x01, 0xf5, 0xc8, 0xc9, 0x82, 0xf4, 0x00, 0xe7, 0x1c, 0x38, 0x94, 0x0b, 0xda, 0x99, 0xb8, 0xbb,
-	0xa2, 0x4d, 0x63, 0x2e, 0xab, 0x99, 0xf7, 0xde, 0xf7, 0xcd, 0x37, 0xdf, 0xcc, 0x1b, 0x2d, 0xd8,
-	0xb0, 0x09, 0x1d, 0x3c, 0x34, 0xe9, 0xa0, 0xc2, 0x3f, 0x07, 0xd7, 0x2b, 0x2c, 0x18, 0x62, 0x5a,
-	0x1e, 0xfa, 0x84, 0x11, 0x28, 0xcf, 0xb3, 0x65, 0xfe, 0x39, 0xb8, 0x9e, 0x5f, 0x0f, 0x23, 0x84,
-	0x1a, 0x3c, 0x5f, 0x11, 0x13, 0x51, 0x9c, 0x5f, 0xeb, 0x91, 0x1e, 0x11, 0xf1, 0x70, 0x74, 0x1c,
-	0x5d, 0xef, 0x11, 0xd2, 0xeb, 0xe3, 0x0a, 0x9f, 0x59, 0xa3, 0x6e, 0xc5, 0xf4, 0x82, 0xe3, 0xd4,
-	0x59, 0x73, 0xe0, 0x7a, 0xa4, 0xc2, 0xbf, 0x22, 0xa4, 0xdc, 0x07, 0x6f, 0x55, 0x6d, 0x1b, 0x53,
-	0xda, 0x09, 0x86, 0xb8, 0x65, 0xfa, 0xe6, 0x00, 0xd6, 0xc1, 0xd2, 0x81, 0xd9, 0x1f, 0xe1, 0x9c,
-	0x54, 0x92, 0x36, 0xdf, 0xdc, 0xde, 0x28, 0xff, 0x57, 0x53, 0x39, 0x42, 0xa8, 0xf2, 0xd1, 0xac,
-	0xb8, 0x1a, 0x98, 0x83, 0xfe, 0x0d, 0x85, 0x83, 0x14, 0x24, 0xc0, 0x37, 0xd2, 0xdf, 0x7e, 0x57,
-	0x94, 0x94, 0x47, 0x12, 0x58, 0x15, 0xd5, 0x35, 0xe2, 0x75, 0xdd, 0x1e, 0x6c, 0x03, 0x30, 0xc4,
-	0xfe, 0xc0, 0xa5, 0xd4, 0x25, 0xde, 0x42, 0x2b, 0x9c, 0x3b, 0x9a, 0x15, 0xcf, 0x8a, 0x15, 0x22,
-	0xa4, 0x82, 0x62, 0x34, 0x70, 0x1b, 0x64, 0x4d, 0xc7, 0xf1, 0x31, 0xa5, 0x98, 0xe6, 0x52, 0xa5,
-	0xd4, 0x66, 0x56, 0x5d, 0x3b, 0x9a, 0x15, 0x65, 0x81, 0x7a, 0x91, 0x52, 0x50, 0x54, 0x26, 0xf4,
-	0xdd, 0x49, 0x67, 0x92, 0x72, 0x4a, 0xf9, 0x26, 0x09, 0x96, 0xf9, 0xde, 0x29, 0x64, 0x00, 0xda,
-	0xc4, 0xc1, 0xc6, 0x68, 0xd8, 0x27, 0xa6, 0x63, 0x98, 0x5c, 0x07, 0xd7, 0x79, 0x66, 0xbb, 0x70,
-	0x92, 0x4e, 0xb1, 0x37, 0xf5, 0xe2, 0x93, 0x59, 0x31, 0x71, 0x34, 0x2b, 0xae, 0x8b, 0x75, 0x5f,
-	0xe6, 0x51, 0x1e, 0xff, 0xf9, 0xd3, 0x96, 0x84, 0xe4, 0x30, 0x73, 0x97, 0x27, 0x04, 0x1e, 0x7e,
-	0x25, 0x81, 0x82, 0xeb, 0x51, 0x66, 0x7a, 0xcc, 0x35, 0x19, 0x36, 0x1c, 0xdc, 0x35, 0x47, 0x7d,
-	0x66, 0xc4, 0xac, 0x4a, 0x2e, 0x60, 0xd5, 0xa5, 0xa3, 0x59, 0xf1, 0x03, 0xb1, 0xf8, 0xeb, 0xd9,
-	0x14, 0xb4, 0x11, 0x2b, 0xa8, 0x8b, 0x7c, 0xeb, 0x45, 0x9a, 0x9b, 0x93, 0x50, 0x7e, 0x94, 0x40,
-	0xa6, 0x46, 0x1c, 0xac, 0x7b, 0x5d, 0x02, 0xdf, 0x05, 0x59, 0xbe, 0xa1, 0x7d, 0x93, 0xee, 0x73,
-	0x3f, 0x56, 0x51, 0x26, 0x0c, 0xec, 0x98, 0x74, 0x1f, 0xe6, 0xc0, 0x8a, 0xed, 0x63, 0x93, 0x11,
-	0x9f, 0xeb, 0xcc, 0xa2, 0xf9, 0x14, 0x7e, 0x06, 0x60, 0x5c, 0x8a, 0xcd, 0x9d, 0xca, 0x2d, 0x2d,
-	0xe4, 0x67, 0x36, 0xf4, 0x53, 0x58, 0x76, 0x36, 0x46, 0x22, 0xb2, 0x77, 0xd2, 0x99, 0x94, 0x9c,
-	0xbe, 0x93, 0xce, 0xa4, 0xe5, 0x25, 0xe5, 0xd7, 0x24, 0x58, 0xad, 0x11, 0x8f, 0xf9, 0xa6, 0xcd,
-	0xb8, 0xda, 0xf7, 0xc1, 0x0a, 0x57, 0xeb, 0x3a, 0x5c, 0x6b, 0x5a, 0x05, 0x87, 0xb3, 0xe2, 0x32,
-	0xdf, 0x4c, 0x1d, 0x2d, 0x87, 0x29, 0xdd, 0x79, 0x8d, 0xea, 0x35, 0xb0, 0x64, 0x3a, 0x03, 0xd7,
-	0xcb, 0xa5, 0x78, 0x5c, 0x4c, 0xc2, 0x68, 0xdf, 0xb4, 0x70, 0x3f, 0x97, 0x16, 0x51, 0x3e, 0x81,
-	0x37, 0x8f, 0x59, 0xb0, 0x73, 0xbc, 0xad, 0x0b, 0xaf, 0xd8, 0x96, 0x45, 0x49, 0x7f, 0xc4, 0x70,
-	0x67, 0xdc, 0x22, 0xd4, 0x65, 0x2e, 0xf1, 0xd0, 0x1c, 0x04, 0xaf, 0x82, 0x33, 0xae, 0x65, 0x1b,
-	0x43, 0xe2, 0xb3, 0x50, 0xee, 0x72, 0xc8, 0xad, 0xbe, 0x71, 0x38, 0x2b, 0x66, 0x75, 0xb5, 0xd6,
-	0x22, 0x3e, 0xd3, 0xeb, 0x28, 0xeb, 0x5a, 0x36, 0x1f, 0x3a, 0xf0, 0x0b, 0x90, 0xc5, 0x63, 0x86,
-	0x3d, 0x7e, 0x29, 0x56, 0xf8, 0x82, 0x6b, 0x65, 0xd1, 0xf2, 0xe5, 0x79, 0xcb, 0x97, 0xab, 0x5e,
-	0xa0, 0x6e, 0xfd, 0xf2, 0xf3, 0xd5, 0x8b, 0x2f, 0x29, 0x89, 0xbb, 0xa4, 0xcd, 0x79, 0x50, 0x44,
-	0x79, 0x23, 0xfd, 0x57, 0xd8, 0xb7, 0xff, 0x48, 0x20, 0x37, 0x2f, 0x0d, 0x5d, 0xdb, 0x71, 0x29,
-	0x23, 0x7e, 0xa0, 0x79, 0xcc, 0x0f, 0x60, 0x0b, 0x64, 0xc9, 0x10, 0xfb, 0x26, 0x8b, 0x5a, 0x78,
-	0xbb, 0x7c, 0xe2, 0x4a, 0x31, 0x78, 0x73, 0x8e, 0x0a, 0x6f, 0x2b, 0x8a, 0x48, 0xe2, 0xc7, 0x95,
-	0x3c, 0xf1, 0xb8, 0x6e, 0x82, 0x95, 0xd1, 0xd0, 0xe1, 0x46, 0xa7, 0xfe, 0x8f, 0xd1, 0xc7, 0x20,
-	0xb8, 0x09, 0x52, 0x03, 0xda, 0xe3, 0x87, 0xb7, 0xaa, 0xbe, 0xf3, 0x7c, 0x56, 0x84, 0xc8, 0x7c,
-	0x38, 0x57, 0xb9, 0x8b, 0x29, 0x35, 0x7b, 0x18, 0x85, 0x25, 0x0a, 0x02, 0xf0, 0x65, 0x22, 0xf8,
-	0x1e, 0x58, 0xb5, 0xfa, 0xc4, 0x7e, 0x60, 0xec, 0x63, 0xb7, 0xb7, 0xcf, 0xc4, 0xc5, 0x42, 0x67,
-	0x78, 0x6c, 0x87, 0x87, 0xe0, 0x3a, 0xc8, 0xb0, 0xb1, 0xe1, 0x7a, 0x0e, 0x1e, 0x8b, 0x8d, 0xa0,
-	0x15, 0x36, 0xd6, 0xc3, 0xa9, 0x82, 0xc1, 0xd2, 0x2e, 0x71, 0x70, 0x1f, 0xde, 0x02, 0xa9, 0x07,
-	0x38, 0x10, 0x2d, 0xa4, 0x7e, 0xfc, 0x7c, 0x56, 0xbc, 0xd6, 0x73, 0xd9, 0xfe, 0xc8, 0x2a, 0xdb,
-	0x64, 0x50, 0xb1, 0xc9, 0x00, 0x33, 0xab, 0xcb, 0xa2, 0x41, 0xdf, 0xb5, 0x68, 0xc5, 0x0a, 0x18,
-	0xa6, 0xe5, 0x1d, 0x3c, 0x56, 0xc3, 0x01, 0x0a, 0x09, 0xc2, 0xdb, 0x28, 0x9e, 0xe9, 0x24, 0x6f,
-	0x46, 0x31, 0xd9, 0xfa, 0x5b, 0x02, 0x20, 0x7a, 0x11, 0xe0, 0x27, 0xe0, 0x7c, 0xb5, 0x56, 0xd3,
-	0xda, 0x6d, 0xa3, 0xb3, 0xd7, 0xd2, 0x8c, 0xbb, 0x8d, 0x76, 0x4b, 0xab, 0xe9, 0xb7, 0x74, 0xad,
-	0x2e, 0x27, 0xf2, 0xeb, 0x93, 0x69, 0xe9, 0x5c, 0x54, 0x7c, 0xd7, 0xa3, 0x43, 0x6c, 0xbb, 0x5d,
-	0x17, 0x3b, 0xf0, 0x0a, 0x80, 0x71, 0x5c, 0xa3, 0xa9, 0x36, 0xeb, 0x7b, 0xb2, 0x94, 0x5f, 0x9b,
-	0x4c, 0x4b, 0x72, 0x04, 0x69, 0x10, 0x8b, 0x38, 0x01, 0xdc, 0x06, 0xe7, 0xe2, 0xd5, 0xda, 0xa7,
-	0x1a, 0xda, 0xe3, 0x80, 0x54, 0xfe, 0xfc, 0x64, 0x5a, 0x7a, 0x3b, 0x02, 0x68, 0x07, 0xd8, 0x0f,
-	0x38, 0xe6, 0x26, 0xd8, 0x88, 0x63, 0xaa, 0x8d, 0x3d, 0xa3, 0x79, 0xcb, 0xa8, 0xd6, 0xeb, 0x48,
-	0x6b, 0xb7, 0xb5, 0xb6, 0x9c, 0xce, 0x6f, 0x4c, 0xa6, 0xa5, 0x5c, 0x04, 0xad, 0x7a, 0x41, 0xb3,
-	0x5b, 0x9d, 0xbf, 0xdf, 0xf9, 0xcc, 0x97, 0x8f, 0x0a, 0x89, 0xc7, 0xdf, 0x17, 0x12, 0x4a, 0xf8,
-	0x86, 0x27, 0xb7, 0x7e, 0x48, 0x81, 0xd2, 0x69, 0x57, 0x0e, 0x62, 0x70, 0xad, 0xd6, 0x6c, 0x74,
-	0x50, 0xb5, 0xd6, 0x31, 0x6a, 0xcd, 0xba, 0x66, 0xec, 0xe8, 0xed, 0x4e, 0x13, 0xed, 0x19, 0xcd,
-	0x96, 0x86, 0xaa, 0x1d, 0xbd, 0xd9, 0x78, 0x95, 0x4f, 0x95, 0xc9, 0xb4, 0x74, 0xf9, 0x34, 0xee,
-	0xb8, 0x7b, 0xf7, 0xc0, 0xa5, 0x85, 0x96, 0xd1, 0x1b, 0x7a, 0x47, 0x96, 0xf2, 0x9b, 0x93, 0x69,
-	0xe9, 0xc2, 0x69, 0xfc, 0xba, 0xe7, 0x32, 0x78, 0x1f, 0x5c, 0x59, 0x88, 0x78, 0x57, 0xbf, 0x8d,
-	0xaa, 0x1d, 0x4d, 0x4e, 0xe6, 0x2f, 0x4f, 0xa6, 0xa5, 0x0f, 0x4f, 0xe3, 0xde, 0x75, 0x7b, 0xbe,
-	0xc9, 0xf0, 0xc2, 0xf4, 0xb7, 0xb5, 0x86, 0xd6, 0xd6, 0xdb, 0x72, 0x6a, 0x31, 0xfa, 0xdb, 0xd8,
-	0xc3, 0xd4, 0xa5, 0xf9, 0x74, 0x78, 0x64, 0xea, 0xce, 0x93, 0x3f, 0x0a, 0x89, 0xc7, 0x87, 0x05,
-	0xe9, 0xc9, 0x61, 0x41, 0x7a, 0x7a, 0x58, 0x90, 0x7e, 0x3f, 0x2c, 0x48, 0x5f, 0x3f, 0x2b, 0x24,
-	0x9e, 0x3e, 0x2b, 0x24, 0x7e, 0x7b, 0x56, 0x48, 0x7c, 0x7e, 0x31, 0xd6, 0x10, 0x35, 0x42, 0x07,
-	0xf7, 0xe6, 0x7f, 0x4b, 0x4e, 0x65, 0x2c, 0xfe, 0x9a, 0xf8, 0x2f, 0x93, 0xb5, 0xcc, 0xdf, 0xbb,
-	0x8f, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x0e, 0x96, 0x9d, 0x53, 0x09, 0x00, 0x00,
+	// 2566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0xf9, 0xd6, 0x92, 0xd4, 0x07, 0x87, 0xb2, 0x4d, 0x8d, 0xf5, 0x41, 0xd1, 0xfa, 0x71, 0xe9, 0x4d,
+	0xe2, 0x9f, 0xe2, 0xc4, 0x54, 0xac, 0x14, 0x05, 0x9a, 0xb6, 0x69, 0xf8, 0x25, 0x8b, 0x69, 0x24,
+	0x32, 0x43, 0x3a, 0xa9, 0x83, 0x26, 0xdb, 0x21, 0x77, 0x48, 0x2d, 0xc2, 0xdd, 0x65, 0x76, 0x96,
+	0x36, 0x19, 0xf4, 0xde, 0x40, 0x40, 0x80, 0xdc, 0x9a, 0x8b, 0x80, 0x02, 0x2d, 0xda, 0xa0, 0xe8,
+	0xa1, 0x48, 0xf3, 0x47, 0x04, 0x3d, 0x14, 0x41, 0x81, 0x02, 0x3d, 0x31, 0xad, 0x72, 0x68, 0xcf,
+	0x44, 0x4f, 0x69, 0x0f, 0xc5, 0x7c, 0x2c, 0x77, 0x29, 0x4a, 0xb6, 0xd2, 0x8b, 0xb4, 0x33, 0xef,
+	0xf3, 0x3c, 0x33, 0xf3, 0xce, 0xfb, 0xbe, 0x33, 0x43, 0xb0, 0xd5, 0x72, 0xa8, 0xf5, 0x08, 0x53,
+	0x6b, 0x87, 0xff, 0x79, 0x78, 0x77, 0xc7, 0x1b, 0xf6, 0x08, 0xcd, 0xf5, 0x5c, 0xc7, 0x73, 0x60,
+	0xd2, 0xb7, 0xe6, 0xf8, 0x9f, 0x87, 0x77, 0xd3, 0x9b, 0xac, 0xc7, 0xa1, 0x3a, 0xb7, 0xef, 0x88,
+	0x86, 0x00, 0xa7, 0x57, 0x3b, 0x4e, 0xc7, 0x11, 0xfd, 0xec, 0x4b, 0xf6, 0x6e, 0x76, 0x1c, 0xa7,
+	0xd3, 0x25, 0x3b, 0xbc, 0xd5, 0xec, 0xb7, 0x77, 0xb0, 0x3d, 0x94, 0xa6, 0xcc, 0x59, 0x93, 0xd1,
+	0x77, 0xb1, 0x67, 0x3a, 0xb6, 0xb4, 0xab, 0x67, 0xed, 0x9e, 0x69, 0x11, 0xea, 0x61, 0xab, 0x27,
+	0x01, 0x2b, 0xd8, 0x32, 0x6d, 0x67, 0x87, 0xff, 0xf5, 0x35, 0xc5, 0x94, 0x76, 0x9a, 0x98, 0x92,
+	0x9d, 0x87, 0x77, 0x9b, 0xc4, 0xc3, 0x77, 0x77, 0x5a, 0x8e, 0x29, 0x35, 0xb5, 0xb7, 0xc1, 0xb5,
+	0x7c, 0xab, 0x45, 0x28, 0x6d, 0x0c, 0x7b, 0xa4, 0x86, 0x5d, 0x6c, 0xc1, 0x12, 0x98, 0x7f, 0x88,
+	0xbb, 0x7d, 0x92, 0x52, 0xb2, 0xca, 0xf6, 0xd5, 0xdd, 0xad, 0xdc, 0xd9, 0x45, 0xe7, 0x02, 0x46,
+	0x21, 0x39, 0x1e, 0xa9, 0xcb, 0x43, 0x6c, 0x75, 0x5f, 0xd2, 0x38, 0x49, 0x43, 0x82, 0xfc, 0x52,
+	0xec, 0xe3, 0x5f, 0xa8, 0x8a, 0xf6, 0xf3, 0x28, 0x58, 0x16, 0xe8, 0xa2, 0x63, 0xb7, 0xcd, 0x0e,
+	0xac, 0x03, 0xd0, 0x23, 0xae, 0x65, 0x52, 0x6a, 0x3a, 0xf6, 0xa5, 0x46, 0x58, 0x1b, 0x8f, 0xd4,
+	0x15, 0x31, 0x42, 0xc0, 0xd4, 0x50, 0x48, 0x06, 0xee, 0x82, 0x38, 0x36, 0x0c, 0x97, 0x50, 0x4a,
+	0x68, 0x2a, 0x9a, 0x8d, 0x6e, 0xc7, 0x0b, 0xab, 0xe3, 0x91, 0x9a, 0x14, 0xac, 0x89, 0x49, 0x43,
+	0x01, 0x0c, 0xd6, 0xc1, 0x12, 0x19, 0xf4, 0x4c, 0xd7, 0x24, 0x34, 0x15, 0xcb, 0x46, 0xb7, 0x13,
+	0xbb, 0x99, 0x8b, 0xa6, 0x51, 0x66, 0xb8, 0x61, 0x61, 0xe3, 0xf3, 0x91, 0x3a, 0x37, 0x1e, 0xa9,
+	0xd7, 0x84, 0xac, 0xcf, 0xd6, 0xd0, 0x44, 0x08, 0x7e, 0x07, 0x2c, 0xb5, 0x1c, 0x83, 0xe8, 0xa6,
+	0x41, 0x53, 0xf3, 0xd9, 0xe8, 0x76, 0xac, 0x90, 0x39, 0x1d, 0xa9, 0x8b, 0x45, 0xc7, 0x20, 0x95,
+	0x12, 0x0d, 0xb8, 0x3e, 0x48, 0x43, 0x8b, 0xec, 0xb3, 0x62, 0x50, 0xd8, 0x04, 0x89, 0x96, 0x63,
+	0x53, 0xcf, 0xc5, 0xa6, 0xed, 0xd1, 0xd4, 0x42, 0x56, 0xd9, 0x4e, 0xec, 0x6e, 0xcf, 0x4e, 0xa9,
+	0x62, 0x53, 0x0f, 0xdb, 0x9e, 0x89, 0x3d, 0x52, 0x0c, 0xf0, 0x85, 0xf5, 0xf1, 0x48, 0x85, 0xbe,
+	0xf8, 0xa4, 0x5b, 0x43, 0x61, 0x51, 0xb1, 0x27, 0xaf, 0xc6, 0x96, 0x22, 0xc9, 0xa8, 0xf6, 0x2f,
+	0x05, 0xac, 0x9f, 0xaf, 0x05, 0x73, 0x60, 0xc9, 0x76, 0x74, 0x6c, 0x58, 0xa6, 0xd8, 0xa1, 0xa5,
+	0xc2, 0xf5, 0x60, 0xea, 0xbe, 0x45, 0x43, 0x8b, 0xb6, 0x93, 0x67, 0x5f, 0xf0, 0x16, 0x98, 0x17,
+	0xe0, 0x48, 0x56, 0xd9, 0x8e, 0x87, 0x43, 0x42, 0x22, 0x85, 0x19, 0xfe, 0x14, 0xc4, 0x2d, 0x3c,
+	0xd0, 0xdb, 0x7d, 0xdb, 0x10, 0xdb, 0x94, 0xd8, 0xdd, 0xcc, 0xc9, 0x94, 0x61, 0xf1, 0x99, 0x93,
+	0xf1, 0x99, 0x2b, 0x3a, 0xa6, 0x5d, 0x28, 0x49, 0x77, 0xcb, 0x5d, 0x9c, 0x30, 0xb5, 0xdf, 0x7e,
+	0xa9, 0x6e, 0x77, 0x4c, 0xef, 0xa8, 0xdf, 0xcc, 0xb5, 0x1c, 0x4b, 0xe6, 0x9c, 0xfc, 0x77, 0x87,
+	0x1a, 0xef, 0xca, 0x8c, 0x65, 0x22, 0x14, 0x2d, 0x59, 0x78, 0xb0, 0xc7, 0x68, 0x32, 0x20, 0x3f,
+	0x55, 0xc0, 0x72, 0x78, 0x57, 0xe1, 0xf3, 0x60, 0x51, 0x06, 0x05, 0x5f, 0x6b, 0xbc, 0x00, 0xc7,
+	0x23, 0xf5, 0xea, 0x54, 0xe4, 0x68, 0xc8, 0x87, 0xc0, 0x22, 0x88, 0xb1, 0xa4, 0xe3, 0x2b, 0x4d,
+	0xec, 0xa6, 0x73, 0x22, 0x23, 0x73, 0x7e, 0x46, 0xe6, 0x1a, 0x7e, 0x46, 0x72, 0x97, 0x25, 0x84,
+	0x0c, 0x63, 0x68, 0x1f, 0x7d, 0xa9, 0x2a, 0x88, 0x93, 0xe1, 0xb3, 0x60, 0xe1, 0x88, 0x98, 0x9d,
+	0x23, 0x2f, 0x15, 0xcd, 0x2a, 0xdb, 0xb1, 0xc2, 0xca, 0x78, 0xa4, 0x5e, 0x11, 0x50, 0xd1, 0xaf,
+	0x21, 0x09, 0x90, 0x93, 0xfe, 0xcb, 0x3c, 0x58, 0xe0, 0xb9, 0x49, 0xa1, 0x07, 0x20, 0x0f, 0x9e,
+	0x7e, 0xaf, 0xeb, 0x60, 0x43, 0xc7, 0xad, 0x96, 0x3f, 0xf3, 0xc7, 0x04, 0xb0, 0xc8, 0xbd, 0xc2,
+	0x2d, 0xe9, 0xd1, 0xcd, 0x50, 0x10, 0x4e, 0xe9, 0x68, 0x9f, 0xfc, 0xe3, 0xf7, 0xb7, 0x15, 0x94,
+	0x64, 0x96, 0xfb, 0xdc, 0x20, 0xf8, 0xf0, 0x43, 0x05, 0x64, 0xcc, 0x20, 0x58, 0x74, 0x83, 0xb4,
+	0x71, 0xbf, 0xeb, 0xe9, 0xa1, 0x54, 0x8e, 0x5c, 0x22, 0x95, 0x9f, 0x1d, 0x8f, 0xd4, 0x67, 0xc4,
+	0xe0, 0x8f, 0x57, 0xd3, 0xd0, 0x56, 0x08, 0x50, 0x12, 0xf6, 0x5a, 0x90, 0xf0, 0x3a, 0x58, 0xee,
+	0x60, 0xaa, 0xbb, 0xa4, 0x63, 0x52, 0x8f, 0xb8, 0xdc, 0x8f, 0x89, 0xdd, 0xa7, 0x66, 0x07, 0xbf,
+	0x87, 0x29, 0x92, 0x20, 0xe1, 0xc0, 0xc2, 0xc6, 0x78, 0xa4, 0x5e, 0x17, 0x73, 0x08, 0x4b, 0x68,
+	0x28, 0xd1, 0x09, 0xb0, 0x6c, 0x80, 0x16, 0xee, 0xe1, 0xa6, 0xd9, 0x35, 0x3d, 0x51, 0x21, 0xd8,
+	0x00, 0xda, 0xec, 0x00, 0x45, 0x1f, 0x35, 0x9c, 0xd5, 0x0f, 0x2b, 0x68, 0x68, 0x4a, 0x10, 0xbe,
+	0x02, 0x62, 0x6d, 0x42, 0x58, 0x95, 0x60, 0xc2, 0x37, 0x66, 0x85, 0xf7, 0x08, 0x91, 0x8a, 0xd7,
+	0x82, 0x48, 0x62, 0x14, 0x0d, 0x71, 0x26, 0x7c, 0x0b, 0x24, 0xa8, 0xf9, 0x3e, 0xd1, 0xbb, 0xa6,
+	0x65, 0x4e, 0x0a, 0xc6, 0xcd, 0x59, 0xa1, 0xba, 0xf9, 0x3e, 0x79, 0x8d, 0x61, 0xa4, 0x5c, 0xa8,
+	0x52, 0x84, 0xf8, 0x1a, 0x02, 0xd4, 0x07, 0x52, 0xb6, 0x7c, 0x19, 0x18, 0xef, 0xf5, 0x1d, 0x0f,
+	0xa7, 0x16, 0x2f, 0xf2, 0xaf, 0x88, 0x92, 0xd7, 0x19, 0x68, 0x76, 0xfd, 0x61, 0x09, 0x0d, 0x25,
+	0xfa, 0x01, 0x96, 0xc7, 0xf5, 0x9c, 0xf6, 0x69, 0x04, 0xac, 0xcc, 0x28, 0xc0, 0xbb, 0xa2, 0x4c,
+	0xb0, 0x20, 0x14, 0x91, 0x1d, 0x0b, 0x57, 0xf3, 0x89, 0x49, 0xe3, 0xb9, 0xcd, 0x4a, 0xeb, 0x84,
+	0xd2, 0x1c, 0x7a, 0x84, 0xa6, 0x22, 0xe7, 0x51, 0xb8, 0x49, 0x50, 0x0a, 0xec, 0x13, 0xbe, 0x0e,
+	0x16, 0x1e, 0x99, 0xb6, 0xe1, 0x3c, 0x92, 0xc1, 0xb3, 0x39, 0x93, 0xcb, 0x25, 0x79, 0xfa, 0x16,
+	0x32, 0x32, 0x6f, 0x64, 0x8e, 0x0a, 0x9a, 0xf6, 0xf1, 0x97, 0xaa, 0x22, 0xf2, 0x45, 0x0a, 0xc1,
+	0x77, 0x40, 0x92, 0x0c, 0x88, 0xd5, 0xf3, 0xf4, 0xe0, 0x34, 0x8a, 0xf1, 0xd3, 0xe8, 0xc5, 0xf1,
+	0x48, 0xdd, 0xf0, 0x8f, 0x8d, 0x69, 0x84, 0xf6, 0xe7, 0xcf, 0xee, 0xac, 0xca, 0x22, 0x98, 0x17,
+	0x9d, 0x75, 0xcf, 0x35, 0xed, 0x0e, 0xba, 0x26, 0xa0, 0x79, 0x1f, 0x29, 0x8b, 0xc1, 0xef, 0x22,
+	0xe0, 0xda, 0x99, 0x3d, 0x85, 0xdf, 0x03, 0x57, 0xd8, 0x22, 0xd9, 0xae, 0xe8, 0x6c, 0x1b, 0xa5,
+	0xdb, 0x52, 0xe3, 0x91, 0xba, 0x1a, 0xf8, 0x60, 0x62, 0xd6, 0x50, 0xc2, 0xc2, 0x83, 0x37, 0x31,
+	0xb5, 0x98, 0x10, 0x7c, 0x03, 0xac, 0x33, 0x73, 0xcf, 0x75, 0x7a, 0x0e, 0xc5, 0xdd, 0x90, 0x8c,
+	0x70, 0xe5, 0xcd, 0xf1, 0x48, 0xfd, 0xbf, 0x40, 0x66, 0x16, 0xa7, 0xa1, 0xeb, 0x16, 0x1e, 0xd4,
+	0x64, 0xff, 0x44, 0xf7, 0x07, 0xe0, 0x2a, 0xc3, 0x77, 0x71, 0x93, 0x74, 0x85, 0x9e, 0xa8, 0x77,
+	0x9b, 0xe3, 0x91, 0xba, 0x16, 0xe8, 0x05, 0x76, 0x0d, 0x2d, 0x5b, 0x78, 0xf0, 0x1a, 0x6b, 0x73,
+	0x01, 0xb9, 0x2c, 0x8a, 0xbb, 0x9e, 0xe0, 0xc7, 0xce, 0x5b, 0xd6, 0xc4, 0x2c, 0x96, 0x55, 0xc7,
+	0x5d, 0x8f, 0xb1, 0xa5, 0xbb, 0xf6, 0x40, 0xf2, 0x6c, 0x8e, 0xb2, 0x9a, 0x4f, 0x6c, 0xdc, 0xec,
+	0x12, 0x23, 0xa5, 0x64, 0xa3, 0xd3, 0x35, 0x5f, 0x1a, 0x34, 0xe4, 0x43, 0xa4, 0xce, 0x7f, 0x16,
+	0xc0, 0xca, 0x4c, 0x35, 0x81, 0xdf, 0x07, 0x57, 0x44, 0xa1, 0x6a, 0x11, 0xbd, 0xe5, 0x50, 0x6f,
+	0xd6, 0xf1, 0x53, 0x66, 0x0d, 0x2d, 0xfb, 0xed, 0xa2, 0x43, 0x3d, 0xf8, 0x12, 0x58, 0x6e, 0x39,
+	0x56, 0xcf, 0xec, 0x4a, 0xb6, 0xf0, 0x77, 0xb8, 0x84, 0x84, 0xac, 0xfc, 0x30, 0xe7, 0x4d, 0xce,
+	0xfd, 0x09, 0xd8, 0xec, 0xdb, 0xac, 0x83, 0x45, 0x07, 0x07, 0xe8, 0x76, 0xdf, 0x22, 0x2e, 0xf6,
+	0x1c, 0x57, 0x3a, 0xfa, 0xe9, 0xf1, 0x48, 0xcd, 0xca, 0x5c, 0xbc, 0x08, 0xaa, 0xa1, 0x8d, 0xc0,
+	0xc6, 0x84, 0x0f, 0x7d, 0x0b, 0x6c, 0x83, 0x1b, 0x67, 0x69, 0x06, 0xb1, 0x1d, 0xcb, 0xb4, 0xf9,
+	0x18, 0x62, 0x33, 0x6e, 0x8d, 0x47, 0xaa, 0x76, 0xfe, 0x18, 0x21, 0xb0, 0x86, 0x36, 0xa7, 0x47,
+	0x29, 0x05, 0x36, 0xf8, 0x0a, 0xb8, 0xca, 0x4a, 0xb1, 0xd5, 0xef, 0x7a, 0x66, 0xaf, 0x6b, 0x12,
+	0x37, 0x35, 0x7f, 0x36, 0x4e, 0xa6, 0xed, 0x1a, 0xba, 0xd2, 0xc1, 0xf4, 0x60, 0xd2, 0x86, 0x3f,
+	0x06, 0x29, 0xf2, 0x90, 0xd8, 0xfc, 0x08, 0xd1, 0xb1, 0xe7, 0xb9, 0x66, 0xb3, 0xef, 0x49, 0x9f,
+	0x2e, 0x70, 0xad, 0xa7, 0xc6, 0x23, 0x55, 0x95, 0x3b, 0x7c, 0x01, 0x52, 0x43, 0x6b, 0xdc, 0x54,
+	0x23, 0x6e, 0xde, 0x37, 0x70, 0x4f, 0xeb, 0x60, 0x53, 0x70, 0x02, 0xbc, 0x81, 0x3d, 0x2c, 0xe4,
+	0x17, 0xcf, 0x7a, 0xfa, 0x42, 0xa8, 0x86, 0xd6, 0xb9, 0x6d, 0x22, 0x5e, 0xc2, 0x1e, 0xe6, 0x03,
+	0x58, 0x20, 0x73, 0x2e, 0xab, 0xed, 0x12, 0xa2, 0x7b, 0xcc, 0x21, 0x4b, 0x7c, 0x94, 0xd0, 0xf9,
+	0xf9, 0x78, 0xbc, 0x86, 0xd2, 0xb3, 0x43, 0xed, 0xb9, 0x84, 0x34, 0x98, 0xb7, 0x9a, 0x20, 0xdd,
+	0x72, 0x6c, 0xcf, 0xc5, 0x2d, 0x4f, 0xb7, 0x08, 0xa5, 0xb8, 0x13, 0x5e, 0x50, 0x9c, 0x0f, 0xf5,
+	0xcc, 0x78, 0xa4, 0xde, 0x9c, 0xdc, 0x27, 0x2f, 0xc0, 0x6a, 0x68, 0xc3, 0x37, 0x1e, 0x08, 0xdb,
+	0x64, 0x49, 0xfb, 0x60, 0xa5, 0xd5, 0xa7, 0x9e, 0x63, 0xe9, 0x62, 0xa6, 0x5c, 0x1a, 0x70, 0xe9,
+	0xad, 0xf1, 0x48, 0x4d, 0x49, 0xe9, 0xb3, 0x10, 0x0d, 0x5d, 0x13, 0x7d, 0x65, 0xd6, 0xc5, 0x94,
+	0x64, 0xfa, 0x9d, 0xc4, 0x40, 0x7c, 0x72, 0x24, 0xc2, 0x9f, 0x29, 0x40, 0x1e, 0x27, 0x7a, 0xbb,
+	0x8b, 0x3d, 0x9e, 0xc5, 0x8f, 0xbd, 0x4c, 0xfe, 0x50, 0x96, 0x70, 0x38, 0x75, 0x32, 0x31, 0xee,
+	0x37, 0xba, 0x4e, 0x8a, 0x7a, 0x0f, 0x04, 0x7d, 0xaf, 0x8b, 0x3d, 0xf8, 0xb1, 0x02, 0xae, 0x49,
+	0x35, 0x16, 0x51, 0xec, 0x98, 0x49, 0x45, 0xf8, 0x6c, 0xb6, 0xce, 0x9d, 0x4d, 0x89, 0xb4, 0xf8,
+	0x84, 0x90, 0x9c, 0xd0, 0xfa, 0xd4, 0x84, 0x7c, 0x09, 0x36, 0xa9, 0xe7, 0x2e, 0x31, 0x29, 0xa9,
+	0x26, 0xe7, 0x75, 0x45, 0xa8, 0xd4, 0x88, 0xcb, 0x8e, 0x38, 0xf8, 0x81, 0x02, 0x12, 0xa1, 0x5b,
+	0x54, 0x2a, 0xfa, 0x0d, 0x9d, 0x14, 0xe2, 0xfe, 0x0f, 0x4e, 0x0a, 0x0f, 0xcd, 0xee, 0x2a, 0x06,
+	0xa1, 0x1e, 0xcb, 0x77, 0x76, 0x57, 0x8c, 0xf1, 0xbb, 0x62, 0xf6, 0xdc, 0x4b, 0x4f, 0x29, 0xc0,
+	0x85, 0xaf, 0x2a, 0x21, 0xba, 0x86, 0xc2, 0x62, 0x32, 0x3e, 0x7e, 0xa3, 0x80, 0x25, 0xfe, 0xcc,
+	0xb2, 0xdb, 0x0e, 0xbc, 0x01, 0xe2, 0xfc, 0x72, 0x7b, 0x84, 0xe9, 0x11, 0xaf, 0xc8, 0xcb, 0x88,
+	0xbf, 0xcb, 0xf6, 0x31, 0x3d, 0x82, 0x29, 0xb0, 0xd8, 0x72, 0x09, 0xaf, 0x60, 0xfc, 0xbd, 0x82,
+	0xfc, 0x26, 0xfc, 0x11, 0x80, 0xe1, 0x6b, 0x69, 0x8b, 0xdf, 0x9a, 0x53, 0xf3, 0x97, 0xba, 0x5b,
+	0xc7, 0x99, 0xef, 0xc4, 0xca, 0x57, 0xcc, 0xa9, 0x67, 0x55, 0xdb, 0xec, 0xbc, 0x1a, 0x5b, 0x8a,
+	0x26, 0x63, 0xaf, 0xc6, 0x96, 0x62, 0xc9, 0x79, 0xed, 0x0b, 0x05, 0x2c, 0xb3, 0x99, 0xe6, 0x6d,
+	0xdc, 0x1d, 0x52, 0x93, 0xc2, 0x3d, 0xb0, 0x7a, 0x84, 0xa9, 0x6e, 0x36, 0x5b, 0x3a, 0xb1, 0x3d,
+	0x77, 0xa8, 0xf7, 0x1c, 0xfe, 0x04, 0x14, 0x4f, 0xaf, 0xb5, 0xd3, 0x91, 0xba, 0xb2, 0x8f, 0x69,
+	0xa5, 0x50, 0x2c, 0x33, 0x6b, 0x8d, 0x1b, 0xd1, 0xca, 0x11, 0xa6, 0x95, 0x66, 0x2b, 0xd4, 0x05,
+	0x5f, 0x04, 0x6b, 0x2e, 0x79, 0xaf, 0x6f, 0xba, 0xc4, 0xd0, 0xa7, 0x2e, 0xaf, 0x2c, 0x1e, 0xe3,
+	0x68, 0xd5, 0x37, 0x16, 0x43, 0x36, 0x98, 0x05, 0x09, 0x3e, 0xa8, 0x1c, 0x93, 0x3f, 0x9e, 0x51,
+	0xb8, 0x6b, 0xe2, 0xcc, 0xe0, 0x00, 0x16, 0xce, 0x14, 0x67, 0xec, 0x3f, 0x99, 0xf3, 0xff, 0x14,
+	0x61, 0x4b, 0x12, 0x85, 0x80, 0x6f, 0xc0, 0x53, 0x60, 0x51, 0x3e, 0x71, 0xe5, 0x81, 0x08, 0x4e,
+	0x47, 0xea, 0x82, 0x78, 0x06, 0xa3, 0x05, 0xf1, 0xe4, 0x7d, 0xcc, 0x46, 0xac, 0xfa, 0x0f, 0xca,
+	0x28, 0xef, 0x17, 0x0d, 0xd6, 0xcb, 0xaf, 0x0a, 0x7c, 0x12, 0x71, 0x24, 0x1a, 0xf0, 0x65, 0xa9,
+	0x42, 0x0c, 0xb9, 0x53, 0x4f, 0x9f, 0xb3, 0x53, 0x4d, 0xea, 0x74, 0xfb, 0x1e, 0x69, 0x0c, 0x6a,
+	0x0e, 0x35, 0x59, 0xd4, 0x20, 0x9f, 0x04, 0xef, 0x80, 0x04, 0xf3, 0x7c, 0xcf, 0x71, 0x3d, 0x36,
+	0xdd, 0x05, 0xfe, 0x06, 0xbc, 0x72, 0x3a, 0x52, 0xe3, 0x95, 0x42, 0xb1, 0xe6, 0xb8, 0x5e, 0xa5,
+	0x84, 0xe2, 0x66, 0xb3, 0xc5, 0x3f, 0x0d, 0xf8, 0x0e, 0x88, 0x93, 0x81, 0x47, 0x6c, 0xfe, 0xe6,
+	0x11, 0xd7, 0xe2, 0xd5, 0x99, 0x9b, 0x63, 0xde, 0x1e, 0x16, 0x6e, 0xff, 0xf1, 0xb3, 0x3b, 0xb7,
+	0x66, 0x9f, 0x0b, 0x21, 0x2f, 0x95, 0x7d, 0x1d, 0x14, 0x48, 0x4a, 0x87, 0xfe, 0x5b, 0x01, 0x29,
+	0x1f, 0xca, 0xbc, 0xb6, 0x6f, 0x52, 0xcf, 0x71, 0x87, 0x7c, 0xb7, 0x61, 0x0d, 0xc4, 0x9d, 0x1e,
+	0x11, 0x77, 0x53, 0xf9, 0x0b, 0xca, 0x6e, 0xee, 0xc2, 0x91, 0x42, 0xf4, 0xaa, 0xcf, 0x62, 0x8f,
+	0x31, 0x14, 0x88, 0x84, 0xb7, 0x2b, 0x72, 0xe1, 0x76, 0xbd, 0x0c, 0x16, 0xfb, 0x3d, 0x83, 0x3b,
+	0x3a, 0xfa, 0x4d, 0x1c, 0x2d, 0x49, 0x70, 0x1b, 0x44, 0x2d, 0xda, 0xe1, 0x9b, 0xb7, 0x5c, 0x58,
+	0xff, 0x7a, 0xa4, 0x42, 0x84, 0x1f, 0x15, 0xa7, 0x8f, 0x0f, 0xc4, 0x20, 0x1a, 0x02, 0x70, 0x56,
+	0x08, 0xde, 0x04, 0xcb, 0xcd, 0xae, 0xd3, 0x7a, 0x57, 0x97, 0x6f, 0x67, 0x1e, 0x58, 0x28, 0xc1,
+	0xfb, 0xf6, 0x79, 0x17, 0xdc, 0x04, 0x4b, 0xde, 0x40, 0x37, 0x6d, 0x83, 0x0c, 0xc4, 0x42, 0xd0,
+	0xa2, 0x37, 0xa8, 0xb0, 0xa6, 0x46, 0xc0, 0xfc, 0x81, 0x63, 0x90, 0x2e, 0xdc, 0x03, 0xd1, 0x77,
+	0xc9, 0x50, 0x54, 0x85, 0xc2, 0xb7, 0xbe, 0x1e, 0xa9, 0x2f, 0x4c, 0xd5, 0x35, 0x8b, 0x78, 0xcd,
+	0xb6, 0x17, 0x7c, 0x74, 0xcd, 0x26, 0xdd, 0xe1, 0x8f, 0x87, 0xdc, 0x3e, 0x11, 0x4f, 0x07, 0xc4,
+	0x04, 0x58, 0x34, 0x8a, 0x5f, 0xc9, 0x22, 0xbc, 0xbe, 0x88, 0xc6, 0xed, 0x3f, 0x44, 0x00, 0x08,
+	0x1e, 0xbc, 0xf0, 0xdb, 0x60, 0x23, 0x5f, 0x2c, 0x96, 0xeb, 0x75, 0xbd, 0xf1, 0xa0, 0x56, 0xd6,
+	0xef, 0x1f, 0xd6, 0x6b, 0xe5, 0x62, 0x65, 0xaf, 0x52, 0x2e, 0x25, 0xe7, 0xd2, 0x9b, 0xc7, 0x27,
+	0xd9, 0xb5, 0x00, 0x7c, 0xdf, 0xa6, 0x3d, 0xd2, 0x32, 0xdb, 0x26, 0x31, 0xe0, 0xf3, 0x00, 0x86,
+	0x79, 0x87, 0xd5, 0x42, 0xb5, 0xf4, 0x20, 0xa9, 0xa4, 0x57, 0x8f, 0x4f, 0xb2, 0xc9, 0x80, 0x72,
+	0xe8, 0x34, 0x1d, 0x63, 0x08, 0x77, 0xc1, 0x5a, 0x18, 0x5d, 0x7e, 0xa3, 0x8c, 0x1e, 0x70, 0x42,
+	0x34, 0xbd, 0x71, 0x7c, 0x92, 0xbd, 0x1e, 0x10, 0xca, 0x0f, 0x89, 0x3b, 0xe4, 0x9c, 0x97, 0xc1,
+	0x56, 0x98, 0x93, 0x3f, 0x7c, 0xa0, 0x57, 0xf7, 0xf4, 0x7c, 0xa9, 0x84, 0xca, 0xf5, 0x7a, 0xb9,
+	0x9e, 0x8c, 0xa5, 0xb7, 0x8e, 0x4f, 0xb2, 0xa9, 0x80, 0x9a, 0xb7, 0x87, 0xd5, 0xf6, 0xe4, 0x2d,
+	0x02, 0xbf, 0x0b, 0x6e, 0x9c, 0xc3, 0x2f, 0x56, 0x4b, 0x65, 0xbd, 0x52, 0xaa, 0x27, 0xe7, 0xd3,
+	0xe9, 0xe3, 0x93, 0xec, 0xfa, 0x19, 0xba, 0xfc, 0x1d, 0x2c, 0xbd, 0xf4, 0xc1, 0x2f, 0x33, 0x73,
+	0x9f, 0xfc, 0x2a, 0x33, 0xa7, 0xb1, 0xdf, 0xa2, 0x22, 0xb7, 0x3f, 0x54, 0xc0, 0xd5, 0xe9, 0xd2,
+	0x0f, 0x8b, 0x20, 0xb3, 0x57, 0x2e, 0xeb, 0xa5, 0x72, 0xbd, 0x51, 0x39, 0xcc, 0x37, 0x2a, 0xd5,
+	0x43, 0xbd, 0x58, 0x3d, 0x38, 0xb8, 0x7f, 0x58, 0x69, 0x3c, 0xd0, 0x6b, 0xd5, 0xea, 0x6b, 0xc9,
+	0xb9, 0xb4, 0x7a, 0x7c, 0x92, 0xbd, 0x31, 0xcd, 0x2b, 0x3a, 0x96, 0xd5, 0xb7, 0xd9, 0x4d, 0xdf,
+	0x71, 0xba, 0xf0, 0x05, 0xb0, 0x7a, 0x56, 0xa4, 0x70, 0x1f, 0x1d, 0x26, 0x95, 0xf4, 0xfa, 0xf1,
+	0x49, 0x16, 0x9e, 0x39, 0x6d, 0xfa, 0xae, 0x9d, 0x8e, 0xb1, 0x99, 0xdd, 0xfe, 0x75, 0x14, 0x64,
+	0x9f, 0x94, 0x3f, 0x90, 0x80, 0x17, 0x8a, 0xd5, 0xc3, 0x06, 0xca, 0x17, 0x1b, 0x62, 0xdd, 0xfb,
+	0x95, 0x7a, 0xa3, 0x8a, 0x1e, 0xe8, 0xd5, 0x5a, 0x19, 0x89, 0xc1, 0xce, 0xd9, 0xf4, 0x9d, 0xe3,
+	0x93, 0xec, 0x73, 0x4f, 0xd2, 0x0e, 0x87, 0xc2, 0x9b, 0xe0, 0xd9, 0x4b, 0x0d, 0x53, 0x39, 0xac,
+	0x34, 0x92, 0x4a, 0x7a, 0xfb, 0xf8, 0x24, 0xfb, 0xf4, 0x93, 0xf4, 0x2b, 0xb6, 0xe9, 0xc1, 0xb7,
+	0xc1, 0xf3, 0x97, 0x12, 0x3e, 0xa8, 0xdc, 0x43, 0xf9, 0x46, 0x39, 0x19, 0x49, 0x3f, 0x77, 0x7c,
+	0x92, 0xfd, 0xff, 0x27, 0x69, 0x1f, 0x98, 0x1d, 0x97, 0x1d, 0xf9, 0x97, 0x95, 0xbf, 0x57, 0x3e,
+	0x2c, 0xd7, 0x2b, 0xf5, 0x64, 0xf4, 0x72, 0xf2, 0xf7, 0x88, 0x4d, 0xa8, 0x49, 0xc5, 0x46, 0x15,
+	0xf6, 0x3f, 0xff, 0x7b, 0x66, 0xee, 0x93, 0xd3, 0x8c, 0xf2, 0xf9, 0x69, 0x46, 0xf9, 0xe2, 0x34,
+	0xa3, 0xfc, 0xed, 0x34, 0xa3, 0x7c, 0xf4, 0x55, 0x66, 0xee, 0x8b, 0xaf, 0x32, 0x73, 0x7f, 0xfd,
+	0x2a, 0x33, 0xf7, 0xd6, 0xad, 0x50, 0x76, 0x17, 0x1d, 0x6a, 0xbd, 0xe9, 0xff, 0xb4, 0x6f, 0xec,
+	0x0c, 0xf8, 0x7f, 0x71, 0x73, 0x69, 0x2e, 0xf0, 0xe2, 0xfd, 0xe2, 0x7f, 0x07, 0x00, 0x42, 0x14,
+	0x93, 0xa7, 0x00, 0x18, 0x00, 0x00,
 }
 
 func (this *AccessTypeParam) Equal(that interface{}) bool {
@@ -622,17 +1221,36 @@ func (this *AccessConfig) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.Expiries) != len(that1.Expiries) {
+		return false
+	}
+	for i := range this.Expiries {
+		if !this.Expiries[i].Equal(&that1.Expiries[i]) {
+			return false
+		}
+	}
+	if len(this.CodeIDs) != len(that1.CodeIDs) {
+		return false
+	}
+	for i := range this.CodeIDs {
+		if this.CodeIDs[i] != that1.CodeIDs[i] {
+			return false
+		}
+	}
+	if !this.Constraints.Equal(that1.Constraints) {
+		return false
+	}
 	return true
 }
 
-func (this *Params) Equal(that interface{}) bool {
+func (this *InstantiateConstraints) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Params)
+	that1, ok := that.(*InstantiateConstraints)
 	if !ok {
-		that2, ok := that.(Params)
+		that2, ok := that.(InstantiateConstraints)
 		if ok {
 			that1 = &that2
 		} else {
@@ -644,21 +1262,311 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.CodeUploadAccess.Equal(&that1.CodeUploadAccess) {
+	if this.NoAdmin != that1.NoAdmin {
 		return false
 	}
-	if this.InstantiateDefaultPermission != that1.InstantiateDefaultPermission {
+	if this.Admin != that1.Admin {
+		return false
+	}
+	if len(this.MaxFunds) != len(that1.MaxFunds) {
 		return false
 	}
+	for i := range this.MaxFunds {
+		if !this.MaxFunds[i].Equal(&that1.MaxFunds[i]) {
+			return false
+		}
+	}
 	return true
 }
 
-func (this *CodeInfo) Equal(that interface{}) bool {
+func (this *AccessExpiry) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CodeInfo)
+	that1, ok := that.(*AccessExpiry)
+	if !ok {
+		that2, ok := that.(AccessExpiry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if that1.Time == nil {
+		if this.Time != nil {
+			return false
+		}
+	} else if !this.Time.Equal(*that1.Time) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.CodeUploadAccess.Equal(&that1.CodeUploadAccess) {
+		return false
+	}
+	if this.InstantiateDefaultPermission != that1.InstantiateDefaultPermission {
+		return false
+	}
+	if !this.GasRegister.Equal(that1.GasRegister) {
+		return false
+	}
+	if !this.Capabilities.Equal(that1.Capabilities) {
+		return false
+	}
+	if !this.Fees.Equal(that1.Fees) {
+		return false
+	}
+	if !this.SizeLimits.Equal(that1.SizeLimits) {
+		return false
+	}
+	if !this.UploadQuota.Equal(that1.UploadQuota) {
+		return false
+	}
+	return true
+}
+
+func (this *UploadQuotaParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UploadQuotaParams)
+	if !ok {
+		that2, ok := that.(UploadQuotaParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxCodes != that1.MaxCodes {
+		return false
+	}
+	if this.MaxBytes != that1.MaxBytes {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	if len(this.ExemptAddresses) != len(that1.ExemptAddresses) {
+		return false
+	}
+	for i := range this.ExemptAddresses {
+		if this.ExemptAddresses[i] != that1.ExemptAddresses[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *SizeLimitParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SizeLimitParams)
+	if !ok {
+		that2, ok := that.(SizeLimitParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxWasmSize != that1.MaxWasmSize {
+		return false
+	}
+	if this.MaxProposalWasmSize != that1.MaxProposalWasmSize {
+		return false
+	}
+	if this.MaxLabelSize != that1.MaxLabelSize {
+		return false
+	}
+	if this.MaxSaltSize != that1.MaxSaltSize {
+		return false
+	}
+	return true
+}
+
+func (this *CapabilityParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CapabilityParams)
+	if !ok {
+		that2, ok := that.(CapabilityParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Enabled) != len(that1.Enabled) {
+		return false
+	}
+	for i := range this.Enabled {
+		if this.Enabled[i] != that1.Enabled[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *GasRegisterParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GasRegisterParams)
+	if !ok {
+		that2, ok := that.(GasRegisterParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.InstanceCost != that1.InstanceCost {
+		return false
+	}
+	if this.CompileCost != that1.CompileCost {
+		return false
+	}
+	if this.UncompressCostNumerator != that1.UncompressCostNumerator {
+		return false
+	}
+	if this.UncompressCostDenominator != that1.UncompressCostDenominator {
+		return false
+	}
+	if this.GasMultiplier != that1.GasMultiplier {
+		return false
+	}
+	if this.EventPerAttributeCost != that1.EventPerAttributeCost {
+		return false
+	}
+	if this.EventAttributeDataCost != that1.EventAttributeDataCost {
+		return false
+	}
+	if this.EventAttributeDataFreeTier != that1.EventAttributeDataFreeTier {
+		return false
+	}
+	if this.ContractMessageDataCost != that1.ContractMessageDataCost {
+		return false
+	}
+	if this.CustomEventCost != that1.CustomEventCost {
+		return false
+	}
+	return true
+}
+
+func (this *FeeParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeParams)
+	if !ok {
+		that2, ok := that.(FeeParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.UploadFlat) != len(that1.UploadFlat) {
+		return false
+	}
+	for i := range this.UploadFlat {
+		if !this.UploadFlat[i].Equal(&that1.UploadFlat[i]) {
+			return false
+		}
+	}
+	if len(this.UploadPerByte) != len(that1.UploadPerByte) {
+		return false
+	}
+	for i := range this.UploadPerByte {
+		if !this.UploadPerByte[i].Equal(&that1.UploadPerByte[i]) {
+			return false
+		}
+	}
+	if len(this.Instantiate) != len(that1.Instantiate) {
+		return false
+	}
+	for i := range this.Instantiate {
+		if !this.Instantiate[i].Equal(&that1.Instantiate[i]) {
+			return false
+		}
+	}
+	if this.Destination != that1.Destination {
+		return false
+	}
+	return true
+}
+
+func (this *CodeInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CodeInfo)
 	if !ok {
 		that2, ok := that.(CodeInfo)
 		if ok {
@@ -684,6 +1592,50 @@ func (this *CodeInfo) Equal(that interface{}) bool {
 	return true
 }
 
+func (this *CodeAnalysis) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CodeAnalysis)
+	if !ok {
+		that2, ok := that.(CodeAnalysis)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HasIBCEntryPoints != that1.HasIBCEntryPoints {
+		return false
+	}
+	if len(this.RequiredCapabilities) != len(that1.RequiredCapabilities) {
+		return false
+	}
+	for i := range this.RequiredCapabilities {
+		if this.RequiredCapabilities[i] != that1.RequiredCapabilities[i] {
+			return false
+		}
+	}
+	if len(this.Entrypoints) != len(that1.Entrypoints) {
+		return false
+	}
+	for i := range this.Entrypoints {
+		if this.Entrypoints[i] != that1.Entrypoints[i] {
+			return false
+		}
+	}
+	if this.CodeSize != that1.CodeSize {
+		return false
+	}
+	return true
+}
+
 func (this *ContractInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -865,6 +1817,50 @@ func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Constraints != nil {
+		{
+			size, err := m.Constraints.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CodeIDs) > 0 {
+		dAtA3 := make([]byte, len(m.CodeIDs)*10)
+		var j2 int
+		for _, num := range m.CodeIDs {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTypes(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Expiries) > 0 {
+		for iNdEx := len(m.Expiries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Expiries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Addresses) > 0 {
 		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Addresses[iNdEx])
@@ -882,7 +1878,7 @@ func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *InstantiateConstraints) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -892,35 +1888,51 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *InstantiateConstraints) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InstantiateConstraints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.InstantiateDefaultPermission != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.InstantiateDefaultPermission))
+	if len(m.MaxFunds) > 0 {
+		for iNdEx := len(m.MaxFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	{
-		size, err := m.CodeUploadAccess.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.NoAdmin {
+		i--
+		if m.NoAdmin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
+func (m *AccessExpiry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -930,44 +1942,42 @@ func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *AccessExpiry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AccessExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.InstantiateConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+	if m.Time != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTypes(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.CodeHash) > 0 {
-		i -= len(m.CodeHash)
-		copy(dAtA[i:], m.CodeHash)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeHash)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractInfo) Marshal() (dAtA []byte, err error) {
+func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -977,19 +1987,19 @@ func (m *ContractInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Extension != nil {
+	if m.UploadQuota != nil {
 		{
-			size, err := m.Extension.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.UploadQuota.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -999,16 +2009,21 @@ func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3a
 	}
-	if len(m.IBCPortID) > 0 {
-		i -= len(m.IBCPortID)
-		copy(dAtA[i:], m.IBCPortID)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.IBCPortID)))
+	if m.SizeLimits != nil {
+		{
+			size, err := m.SizeLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x32
 	}
-	if m.Created != nil {
+	if m.Fees != nil {
 		{
-			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1018,36 +2033,49 @@ func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Label)))
+	if m.Capabilities != nil {
+		{
+			size, err := m.Capabilities.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+	if m.GasRegister != nil {
+		{
+			size, err := m.GasRegister.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+	if m.InstantiateDefaultPermission != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InstantiateDefaultPermission))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.CodeID != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
-		i--
-		dAtA[i] = 0x8
+	{
+		size, err := m.CodeUploadAccess.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractCodeHistoryEntry) Marshal() (dAtA []byte, err error) {
+func (m *UploadQuotaParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1057,49 +2085,47 @@ func (m *ContractCodeHistoryEntry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractCodeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+func (m *UploadQuotaParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractCodeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UploadQuotaParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Updated != nil {
-		{
-			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	if len(m.ExemptAddresses) > 0 {
+		for iNdEx := len(m.ExemptAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptAddresses[iNdEx])
+			copy(dAtA[i:], m.ExemptAddresses[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.ExemptAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.CodeID != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTypes(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxBytes))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Operation != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Operation))
+	if m.MaxCodes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxCodes))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AbsoluteTxPosition) Marshal() (dAtA []byte, err error) {
+func (m *SizeLimitParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1109,30 +2135,40 @@ func (m *AbsoluteTxPosition) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AbsoluteTxPosition) MarshalTo(dAtA []byte) (int, error) {
+func (m *SizeLimitParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AbsoluteTxPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SizeLimitParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TxIndex != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
+	if m.MaxSaltSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxSaltSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxLabelSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxLabelSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxProposalWasmSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxProposalWasmSize))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+	if m.MaxWasmSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxWasmSize))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Model) Marshal() (dAtA []byte, err error) {
+func (m *CapabilityParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1142,208 +2178,2125 @@ func (m *Model) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Model) MarshalTo(dAtA []byte) (int, error) {
+func (m *CapabilityParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CapabilityParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Enabled) > 0 {
+		for iNdEx := len(m.Enabled) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Enabled[iNdEx])
+			copy(dAtA[i:], m.Enabled[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Enabled[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GasRegisterParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
 
-func (m *AccessTypeParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovTypes(uint64(m.Value))
-	}
-	return n
+func (m *GasRegisterParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccessConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GasRegisterParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Permission != 0 {
-		n += 1 + sovTypes(uint64(m.Permission))
+	if m.CustomEventCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CustomEventCost))
+		i--
+		dAtA[i] = 0x50
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if m.ContractMessageDataCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ContractMessageDataCost))
+		i--
+		dAtA[i] = 0x48
 	}
-	return n
-}
-
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.EventAttributeDataFreeTier != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventAttributeDataFreeTier))
+		i--
+		dAtA[i] = 0x40
 	}
-	var l int
-	_ = l
-	l = m.CodeUploadAccess.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.InstantiateDefaultPermission != 0 {
-		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
+	if m.EventAttributeDataCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventAttributeDataCost))
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
-}
-
-func (m *CodeInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.EventPerAttributeCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventPerAttributeCost))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.GasMultiplier != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasMultiplier))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.UncompressCostDenominator != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UncompressCostDenominator))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.InstantiateConfig.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	if m.UncompressCostNumerator != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UncompressCostNumerator))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CompileCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CompileCost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InstanceCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InstanceCost))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FeeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CodeID != 0 {
-		n += 1 + sovTypes(uint64(m.CodeID))
+	if m.Destination != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Instantiate) > 0 {
+		for iNdEx := len(m.Instantiate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Instantiate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.UploadPerByte) > 0 {
+		for iNdEx := len(m.UploadPerByte) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UploadPerByte[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.UploadFlat) > 0 {
+		for iNdEx := len(m.UploadFlat) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UploadFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Created != nil {
-		l = m.Created.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InstantiateConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	l = len(m.IBCPortID)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Extension != nil {
-		l = m.Extension.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractCodeHistoryEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CodeAnalysis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeAnalysis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeAnalysis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Operation != 0 {
-		n += 1 + sovTypes(uint64(m.Operation))
+	if m.CodeSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeSize))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.CodeID != 0 {
-		n += 1 + sovTypes(uint64(m.CodeID))
+	if len(m.Entrypoints) > 0 {
+		for iNdEx := len(m.Entrypoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Entrypoints[iNdEx])
+			copy(dAtA[i:], m.Entrypoints[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Entrypoints[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Updated != nil {
-		l = m.Updated.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.RequiredCapabilities) > 0 {
+		for iNdEx := len(m.RequiredCapabilities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredCapabilities[iNdEx])
+			copy(dAtA[i:], m.RequiredCapabilities[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.RequiredCapabilities[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.HasIBCEntryPoints {
+		i--
+		if m.HasIBCEntryPoints {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AbsoluteTxPosition) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.BlockHeight))
+	if m.Extension != nil {
+		{
+			size, err := m.Extension.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.TxIndex != 0 {
-		n += 1 + sovTypes(uint64(m.TxIndex))
+	if len(m.IBCPortID) > 0 {
+		i -= len(m.IBCPortID)
+		copy(dAtA[i:], m.IBCPortID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IBCPortID)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
-}
-
-func (m *Model) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Created != nil {
+		{
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
+func (m *ContractCodeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCodeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCodeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Updated != nil {
+		{
+			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Operation != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Operation))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AbsoluteTxPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AbsoluteTxPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AbsoluteTxPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Model) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Model) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *AccessTypeParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovTypes(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *AccessConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		n += 1 + sovTypes(uint64(m.Permission))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Expiries) > 0 {
+		for _, e := range m.Expiries {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.CodeIDs) > 0 {
+		l = 0
+		for _, e := range m.CodeIDs {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if m.Constraints != nil {
+		l = m.Constraints.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *InstantiateConstraints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoAdmin {
+		n += 2
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.MaxFunds) > 0 {
+		for _, e := range m.MaxFunds {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccessExpiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CodeUploadAccess.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.InstantiateDefaultPermission != 0 {
+		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
+	}
+	if m.GasRegister != nil {
+		l = m.GasRegister.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Capabilities != nil {
+		l = m.Capabilities.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Fees != nil {
+		l = m.Fees.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SizeLimits != nil {
+		l = m.SizeLimits.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.UploadQuota != nil {
+		l = m.UploadQuota.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *UploadQuotaParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxCodes != 0 {
+		n += 1 + sovTypes(uint64(m.MaxCodes))
+	}
+	if m.MaxBytes != 0 {
+		n += 1 + sovTypes(uint64(m.MaxBytes))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.ExemptAddresses) > 0 {
+		for _, s := range m.ExemptAddresses {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SizeLimitParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxWasmSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxWasmSize))
+	}
+	if m.MaxProposalWasmSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxProposalWasmSize))
+	}
+	if m.MaxLabelSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxLabelSize))
+	}
+	if m.MaxSaltSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxSaltSize))
+	}
+	return n
+}
+
+func (m *CapabilityParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Enabled) > 0 {
+		for _, s := range m.Enabled {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasRegisterParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InstanceCost != 0 {
+		n += 1 + sovTypes(uint64(m.InstanceCost))
+	}
+	if m.CompileCost != 0 {
+		n += 1 + sovTypes(uint64(m.CompileCost))
+	}
+	if m.UncompressCostNumerator != 0 {
+		n += 1 + sovTypes(uint64(m.UncompressCostNumerator))
+	}
+	if m.UncompressCostDenominator != 0 {
+		n += 1 + sovTypes(uint64(m.UncompressCostDenominator))
+	}
+	if m.GasMultiplier != 0 {
+		n += 1 + sovTypes(uint64(m.GasMultiplier))
+	}
+	if m.EventPerAttributeCost != 0 {
+		n += 1 + sovTypes(uint64(m.EventPerAttributeCost))
+	}
+	if m.EventAttributeDataCost != 0 {
+		n += 1 + sovTypes(uint64(m.EventAttributeDataCost))
+	}
+	if m.EventAttributeDataFreeTier != 0 {
+		n += 1 + sovTypes(uint64(m.EventAttributeDataFreeTier))
+	}
+	if m.ContractMessageDataCost != 0 {
+		n += 1 + sovTypes(uint64(m.ContractMessageDataCost))
+	}
+	if m.CustomEventCost != 0 {
+		n += 1 + sovTypes(uint64(m.CustomEventCost))
+	}
+	return n
+}
+
+func (m *FeeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UploadFlat) > 0 {
+		for _, e := range m.UploadFlat {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.UploadPerByte) > 0 {
+		for _, e := range m.UploadPerByte {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Instantiate) > 0 {
+		for _, e := range m.Instantiate {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Destination != 0 {
+		n += 1 + sovTypes(uint64(m.Destination))
+	}
+	return n
+}
+
+func (m *CodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.InstantiateConfig.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *CodeAnalysis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasIBCEntryPoints {
+		n += 2
+	}
+	if len(m.RequiredCapabilities) > 0 {
+		for _, s := range m.RequiredCapabilities {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Entrypoints) > 0 {
+		for _, s := range m.Entrypoints {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.CodeSize != 0 {
+		n += 1 + sovTypes(uint64(m.CodeSize))
+	}
+	return n
+}
+
+func (m *ContractInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.IBCPortID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Extension != nil {
+		l = m.Extension.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCodeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != 0 {
+		n += 1 + sovTypes(uint64(m.Operation))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	if m.Updated != nil {
+		l = m.Updated.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AbsoluteTxPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTypes(uint64(m.TxIndex))
+	}
+	return n
+}
+
+func (m *Model) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessTypeParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessTypeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expiries = append(m.Expiries, AccessExpiry{})
+			if err := m.Expiries[len(m.Expiries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CodeIDs = append(m.CodeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CodeIDs) == 0 {
+					m.CodeIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CodeIDs = append(m.CodeIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeIDs", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Constraints == nil {
+				m.Constraints = &InstantiateConstraints{}
+			}
+			if err := m.Constraints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *InstantiateConstraints) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InstantiateConstraints: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InstantiateConstraints: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoAdmin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoAdmin = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFunds = append(m.MaxFunds, types.Coin{})
+			if err := m.MaxFunds[len(m.MaxFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AccessExpiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessExpiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeUploadAccess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CodeUploadAccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateDefaultPermission", wireType)
+			}
+			m.InstantiateDefaultPermission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstantiateDefaultPermission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRegister", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasRegister == nil {
+				m.GasRegister = &GasRegisterParams{}
+			}
+			if err := m.GasRegister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Capabilities == nil {
+				m.Capabilities = &CapabilityParams{}
+			}
+			if err := m.Capabilities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fees == nil {
+				m.Fees = &FeeParams{}
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SizeLimits == nil {
+				m.SizeLimits = &SizeLimitParams{}
+			}
+			if err := m.SizeLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UploadQuota == nil {
+				m.UploadQuota = &UploadQuotaParams{}
+			}
+			if err := m.UploadQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *UploadQuotaParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UploadQuotaParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UploadQuotaParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCodes", wireType)
+			}
+			m.MaxCodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCodes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptAddresses = append(m.ExemptAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SizeLimitParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SizeLimitParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SizeLimitParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWasmSize", wireType)
+			}
+			m.MaxWasmSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWasmSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProposalWasmSize", wireType)
+			}
+			m.MaxProposalWasmSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxProposalWasmSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLabelSize", wireType)
+			}
+			m.MaxLabelSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLabelSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSaltSize", wireType)
+			}
+			m.MaxSaltSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSaltSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CapabilityParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CapabilityParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CapabilityParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enabled = append(m.Enabled, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *GasRegisterParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1353,30 +4306,201 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTypes
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasRegisterParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasRegisterParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceCost", wireType)
+			}
+			m.InstanceCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstanceCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompileCost", wireType)
+			}
+			m.CompileCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompileCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressCostNumerator", wireType)
+			}
+			m.UncompressCostNumerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressCostNumerator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressCostDenominator", wireType)
+			}
+			m.UncompressCostDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressCostDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMultiplier", wireType)
+			}
+			m.GasMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventPerAttributeCost", wireType)
+			}
+			m.EventPerAttributeCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventPerAttributeCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventAttributeDataCost", wireType)
+			}
+			m.EventAttributeDataCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventAttributeDataCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventAttributeDataFreeTier", wireType)
+			}
+			m.EventAttributeDataFreeTier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventAttributeDataFreeTier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractMessageDataCost", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ContractMessageDataCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractMessageDataCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AccessTypeParam: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessTypeParam: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomEventCost", wireType)
 			}
-			m.Value = 0
+			m.CustomEventCost = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1386,7 +4510,7 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= AccessType(b&0x7F) << shift
+				m.CustomEventCost |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1413,7 +4537,7 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+func (m *FeeParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1436,17 +4560,17 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadFlat", wireType)
 			}
-			m.Permission = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1456,16 +4580,65 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permission |= AccessType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadFlat = append(m.UploadFlat, types.Coin{})
+			if err := m.UploadFlat[len(m.UploadFlat)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadPerByte", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadPerByte = append(m.UploadPerByte, types.DecCoin{})
+			if err := m.UploadPerByte[len(m.UploadPerByte)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Instantiate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1475,24 +4648,45 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Instantiate = append(m.Instantiate, types.Coin{})
+			if err := m.Instantiate[len(m.Instantiate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= FeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1515,7 +4709,7 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1538,17 +4732,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeUploadAccess", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1558,30 +4752,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CodeUploadAccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateDefaultPermission", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			m.InstantiateDefaultPermission = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1591,11 +4786,57 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InstantiateDefaultPermission |= AccessType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1618,7 +4859,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+func (m *CodeAnalysis) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1641,17 +4882,17 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CodeAnalysis: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CodeAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasIBCEntryPoints", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1661,29 +4902,15 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeHash == nil {
-				m.CodeHash = []byte{}
-			}
-			iNdEx = postIndex
+			m.HasIBCEntryPoints = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredCapabilities", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1711,13 +4938,13 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.RequiredCapabilities = append(m.RequiredCapabilities, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entrypoints", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1727,25 +4954,43 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Entrypoints = append(m.Entrypoints, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeSize", wireType)
+			}
+			m.CodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2010,7 +5255,7 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Extension == nil {
-				m.Extension = &types.Any{}
+				m.Extension = &types1.Any{}
 			}
 			if err := m.Extension.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
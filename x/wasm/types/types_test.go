@@ -3,7 +3,6 @@ package types
 import (
 	"bytes"
 	"context"
-	"strings"
 	"testing"
 	"time"
 
@@ -48,10 +47,6 @@ func TestContractInfoValidateBasic(t *testing.T) {
 			srcMutator: func(c *ContractInfo) { c.Label = "" },
 			expError:   true,
 		},
-		"label exceeds limit": {
-			srcMutator: func(c *ContractInfo) { c.Label = strings.Repeat("a", MaxLabelSize+1) },
-			expError:   true,
-		},
 		"invalid extension": {
 			srcMutator: func(c *ContractInfo) {
 				// any protobuf type with ValidateBasic method
@@ -444,7 +439,53 @@ func TestAccessConfigSubset(t *testing.T) {
 			check:    AccessConfig{Permission: AccessTypeUnspecified},
 			isSubSet: false,
 		},
+		// code ids
+		"nobody < codeIDs": {
+			superSet: AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{1}},
+			check:    AccessConfig{Permission: AccessTypeNobody},
+			isSubSet: true,
+		},
+		"codeIDs(multiple) < codeIDs(multiple)": {
+			superSet: AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{1, 2}},
+			check:    AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{2, 1}},
+			isSubSet: true,
+		},
+		"codeIDs < codeIDs(multiple)": {
+			superSet: AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{1, 2}},
+			check:    AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{2}},
+			isSubSet: true,
+		},
+		"codeIDs(multiple) !< codeIDs": {
+			superSet: AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{1}},
+			check:    AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{1, 2}},
+			isSubSet: false,
+		},
+		"anyOf !< codeIDs": {
+			superSet: AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{1}},
+			check:    AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"foobar"}},
+			isSubSet: false,
+		},
+		"everybody !< codeIDs": {
+			superSet: AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{1}},
+			check:    AccessConfig{Permission: AccessTypeEverybody},
+			isSubSet: false,
+		},
+		"codeIDs !< anyOf": {
+			superSet: AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"owner"}},
+			check:    AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{1}},
+			isSubSet: false,
+		},
+		"codeIDs !< nobody": {
+			superSet: AccessConfig{Permission: AccessTypeNobody},
+			check:    AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{1}},
+			isSubSet: false,
+		},
 		// everybody
+		"codeIDs < everybody": {
+			superSet: AccessConfig{Permission: AccessTypeEverybody},
+			check:    AccessConfig{Permission: AccessTypeAnyOfCodeIDs, CodeIDs: []uint64{1}},
+			isSubSet: true,
+		},
 		"nobody < everybody": {
 			superSet: AccessConfig{Permission: AccessTypeEverybody},
 			check:    AccessConfig{Permission: AccessTypeNobody},
@@ -489,7 +530,7 @@ func TestAccessConfigSubset(t *testing.T) {
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			subset := spec.check.IsSubset(spec.superSet)
+			subset := spec.check.IsSubset(sdk.Context{}, spec.superSet)
 			require.Equal(t, spec.isSubSet, subset)
 		})
 	}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs basic validation
+func (p UploadQuotaParams) ValidateBasic() error {
+	if p.Window < 0 {
+		return errorsmod.Wrap(ErrInvalid, "window must not be negative")
+	}
+	if p.IsLimited() && p.Window == 0 {
+		return errorsmod.Wrap(ErrInvalid, "window must be set when uploads are limited")
+	}
+	idx := make(map[string]struct{}, len(p.ExemptAddresses))
+	for _, a := range p.ExemptAddresses {
+		if _, err := sdk.AccAddressFromBech32(a); err != nil {
+			return errorsmod.Wrapf(err, "exempt address: %s", a)
+		}
+		if _, exists := idx[a]; exists {
+			return ErrDuplicate.Wrapf("exempt address: %s", a)
+		}
+		idx[a] = struct{}{}
+	}
+	return nil
+}
+
+// IsLimited returns true when the number of codes or bytes is limited
+func (p UploadQuotaParams) IsLimited() bool {
+	return p.MaxCodes != 0 || p.MaxBytes != 0
+}
+
+// IsExempt returns true when the address is in the exempt addresses
+func (p UploadQuotaParams) IsExempt(actor sdk.AccAddress) bool {
+	for _, a := range p.ExemptAddresses {
+		if addr, err := sdk.AccAddressFromBech32(a); err == nil && addr.Equals(actor) {
+			return true
+		}
+	}
+	return false
+}
+
+// Remaining returns the number of codes and bytes that can still be uploaded for the given usage.
+// The result is 0 for an unlimited dimension.
+func (p UploadQuotaParams) Remaining(codesUsed, bytesUsed uint64) (codes, bytes uint64) {
+	if p.MaxCodes > codesUsed {
+		codes = p.MaxCodes - codesUsed
+	}
+	if p.MaxBytes > bytesUsed {
+		bytes = p.MaxBytes - bytesUsed
+	}
+	return
+}
+
+// Allows returns an error when another code of the given size would exceed the quota for the given usage
+func (p UploadQuotaParams) Allows(codesUsed, bytesUsed, codeSize uint64) error {
+	if p.MaxCodes != 0 && codesUsed+1 > p.MaxCodes {
+		return ErrQuotaExceeded.Wrapf("max %d codes within %s", p.MaxCodes, p.Window)
+	}
+	if p.MaxBytes != 0 && bytesUsed+codeSize > p.MaxBytes {
+		return ErrQuotaExceeded.Wrapf("max %d bytes within %s", p.MaxBytes, p.Window)
+	}
+	return nil
+}
+
+// UploadQuotaOrDefault returns the upload quota params or an unlimited quota when not set
+func (p Params) UploadQuotaOrDefault() UploadQuotaParams {
+	if p.UploadQuota == nil {
+		return UploadQuotaParams{}
+	}
+	return *p.UploadQuota
+}
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestUploadQuotaParamsValidateBasic(t *testing.T) {
+	myAddr := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	specs := map[string]struct {
+		src    UploadQuotaParams
+		expErr bool
+	}{
+		"empty": {},
+		"all set": {
+			src: UploadQuotaParams{MaxCodes: 1, MaxBytes: 2, Window: time.Hour, ExemptAddresses: []string{myAddr}},
+		},
+		"max codes only": {
+			src: UploadQuotaParams{MaxCodes: 1, Window: time.Hour},
+		},
+		"max bytes only": {
+			src: UploadQuotaParams{MaxBytes: 1, Window: time.Hour},
+		},
+		"limited without window": {
+			src:    UploadQuotaParams{MaxCodes: 1},
+			expErr: true,
+		},
+		"negative window": {
+			src:    UploadQuotaParams{Window: -time.Second},
+			expErr: true,
+		},
+		"invalid exempt address": {
+			src:    UploadQuotaParams{MaxCodes: 1, Window: time.Hour, ExemptAddresses: []string{"invalid"}},
+			expErr: true,
+		},
+		"duplicate exempt address": {
+			src:    UploadQuotaParams{MaxCodes: 1, Window: time.Hour, ExemptAddresses: []string{myAddr, myAddr}},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestUploadQuotaParamsAllows(t *testing.T) {
+	specs := map[string]struct {
+		src                                  UploadQuotaParams
+		codesUsed, bytesUsed, codeSize       uint64
+		expErr                               bool
+		expCodesRemaining, expBytesRemaining uint64
+	}{
+		"not limited": {
+			codesUsed: 100, bytesUsed: 100, codeSize: 100,
+		},
+		"within max codes": {
+			src:       UploadQuotaParams{MaxCodes: 2},
+			codesUsed: 1, codeSize: 100,
+			expCodesRemaining: 1,
+		},
+		"exceeds max codes": {
+			src:       UploadQuotaParams{MaxCodes: 2},
+			codesUsed: 2, codeSize: 100,
+			expErr: true,
+		},
+		"within max bytes": {
+			src:       UploadQuotaParams{MaxBytes: 100},
+			codesUsed: 1, bytesUsed: 60, codeSize: 40,
+			expBytesRemaining: 40,
+		},
+		"exceeds max bytes": {
+			src:       UploadQuotaParams{MaxBytes: 100},
+			codesUsed: 1, bytesUsed: 60, codeSize: 41,
+			expErr: true, expBytesRemaining: 40,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.Allows(spec.codesUsed, spec.bytesUsed, spec.codeSize)
+			if spec.expErr {
+				require.ErrorIs(t, gotErr, ErrQuotaExceeded)
+			} else {
+				require.NoError(t, gotErr)
+			}
+			gotCodes, gotBytes := spec.src.Remaining(spec.codesUsed, spec.bytesUsed)
+			assert.Equal(t, spec.expCodesRemaining, gotCodes)
+			assert.Equal(t, spec.expBytesRemaining, gotBytes)
+		})
+	}
+}
+
+func TestUploadQuotaParamsIsExempt(t *testing.T) {
+	myAddr := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	otherAddr := sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+	quota := UploadQuotaParams{ExemptAddresses: []string{myAddr.String()}}
+	assert.True(t, quota.IsExempt(myAddr))
+	assert.False(t, quota.IsExempt(otherAddr))
+	assert.False(t, UploadQuotaParams{}.IsExempt(myAddr))
+}
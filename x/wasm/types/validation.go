@@ -10,51 +10,29 @@ import (
 	errorsmod "cosmossdk.io/errors"
 )
 
-// MaxSaltSize is the longest salt that can be used when instantiating a contract
-const MaxSaltSize = 64
-
-var (
-	// MaxLabelSize is the longest label that can be used when instantiating a contract
-	MaxLabelSize = 128 // extension point for chains to customize via compile flag.
-
-	// MaxWasmSize is the largest a compiled contract code can be when storing code on chain
-	MaxWasmSize = 800 * 1024 // extension point for chains to customize via compile flag.
-
-	// MaxProposalWasmSize is the largest a gov proposal compiled contract code can be when storing code on chain
-	MaxProposalWasmSize = 3 * 1024 * 1024 // extension point for chains to customize via compile flag.
-)
-
-func validateWasmCode(s []byte, maxSize int) error {
+// validateWasmCode ensures the wasm code is set. The size limits are params, see SizeLimitParams.
+func validateWasmCode(s []byte) error {
 	if len(s) == 0 {
 		return errorsmod.Wrap(ErrEmpty, "is required")
 	}
-	if len(s) > maxSize {
-		return errorsmod.Wrapf(ErrLimit, "cannot be longer than %d bytes", maxSize)
-	}
 	return nil
 }
 
-// ValidateLabel ensure label constraints
+// ValidateLabel ensure label constraints. The size limit is a param, see SizeLimitParams.
 func ValidateLabel(label string) error {
 	if label == "" {
 		return errorsmod.Wrap(ErrEmpty, "is required")
 	}
-	if len(label) > MaxLabelSize {
-		return ErrLimit.Wrapf("cannot be longer than %d characters", MaxLabelSize)
-	}
 	if label != strings.TrimSpace(label) {
 		return ErrInvalid.Wrap("label must not start/end with whitespaces")
 	}
 	return nil
 }
 
-// ValidateSalt ensure salt constraints
+// ValidateSalt ensure salt constraints. The size limit is a param, see SizeLimitParams.
 func ValidateSalt(salt []byte) error {
-	switch n := len(salt); {
-	case n == 0:
+	if len(salt) == 0 {
 		return errorsmod.Wrap(ErrEmpty, "is required")
-	case n > MaxSaltSize:
-		return ErrLimit.Wrapf("cannot be longer than %d characters", MaxSaltSize)
 	}
 	return nil
 }